@@ -57,7 +57,7 @@ type ListAccessRequestsOptions ListOptions
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/access_requests.html#list-access-requests-for-a-group-or-project
 func (s *AccessRequestsService) ListProjectAccessRequests(pid interface{}, opt *ListAccessRequestsOptions, options ...RequestOptionFunc) ([]*AccessRequest, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -83,7 +83,7 @@ func (s *AccessRequestsService) ListProjectAccessRequests(pid interface{}, opt *
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/access_requests.html#list-access-requests-for-a-group-or-project
 func (s *AccessRequestsService) ListGroupAccessRequests(gid interface{}, opt *ListAccessRequestsOptions, options ...RequestOptionFunc) ([]*AccessRequest, *Response, error) {
-	group, err := parseID(gid)
+	group, err := parseGroupID(gid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -109,7 +109,7 @@ func (s *AccessRequestsService) ListGroupAccessRequests(gid interface{}, opt *Li
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/access_requests.html#request-access-to-a-group-or-project
 func (s *AccessRequestsService) RequestProjectAccess(pid interface{}, options ...RequestOptionFunc) (*AccessRequest, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -135,7 +135,7 @@ func (s *AccessRequestsService) RequestProjectAccess(pid interface{}, options ..
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/access_requests.html#request-access-to-a-group-or-project
 func (s *AccessRequestsService) RequestGroupAccess(gid interface{}, options ...RequestOptionFunc) (*AccessRequest, *Response, error) {
-	group, err := parseID(gid)
+	group, err := parseGroupID(gid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -169,7 +169,7 @@ type ApproveAccessRequestOptions struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/access_requests.html#approve-an-access-request
 func (s *AccessRequestsService) ApproveProjectAccessRequest(pid interface{}, user int, opt *ApproveAccessRequestOptions, options ...RequestOptionFunc) (*AccessRequest, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -194,7 +194,7 @@ func (s *AccessRequestsService) ApproveProjectAccessRequest(pid interface{}, use
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/access_requests.html#approve-an-access-request
 func (s *AccessRequestsService) ApproveGroupAccessRequest(gid interface{}, user int, opt *ApproveAccessRequestOptions, options ...RequestOptionFunc) (*AccessRequest, *Response, error) {
-	group, err := parseID(gid)
+	group, err := parseGroupID(gid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -219,7 +219,7 @@ func (s *AccessRequestsService) ApproveGroupAccessRequest(gid interface{}, user
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/access_requests.html#deny-an-access-request
 func (s *AccessRequestsService) DenyProjectAccessRequest(pid interface{}, user int, options ...RequestOptionFunc) (*Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, err
 	}
@@ -238,7 +238,7 @@ func (s *AccessRequestsService) DenyProjectAccessRequest(pid interface{}, user i
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/access_requests.html#deny-an-access-request
 func (s *AccessRequestsService) DenyGroupAccessRequest(gid interface{}, user int, options ...RequestOptionFunc) (*Response, error) {
-	group, err := parseID(gid)
+	group, err := parseGroupID(gid)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,471 @@
+package gitlab
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+)
+
+// AuditEventStreamingService handles communication with the audit event
+// streaming destination related methods of the GitLab GraphQL API.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/administration/audit_event_streaming/graphql_api.html
+type AuditEventStreamingService struct {
+	client *Client
+}
+
+// AuditEventStreamingDestination represents an HTTP destination audit events
+// are streamed to.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/administration/audit_event_streaming/graphql_api.html
+type AuditEventStreamingDestination struct {
+	ID                string   `json:"id"`
+	Name              string   `json:"name"`
+	DestinationURL    string   `json:"destinationUrl"`
+	VerificationToken string   `json:"verificationToken"`
+	EventTypeFilters  []string `json:"eventTypeFilters"`
+}
+
+func (d AuditEventStreamingDestination) String() string {
+	return Stringify(d)
+}
+
+const auditEventStreamingDestinationFields = `id name destinationUrl verificationToken eventTypeFilters`
+
+// mutationErrors converts the errors returned in the payload of a GraphQL
+// mutation into an error.
+func mutationErrors(errs []string) error {
+	if len(errs) == 0 {
+		return nil
+	}
+	gqlErrs := make(GraphQLErrors, 0, len(errs))
+	for _, msg := range errs {
+		gqlErrs = append(gqlErrs, &GraphQLError{Message: msg})
+	}
+	return gqlErrs
+}
+
+// ListGroupStreamingDestinations gets the streaming destinations of a group.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/administration/audit_event_streaming/graphql_api.html#list-streaming-destinations
+func (s *AuditEventStreamingService) ListGroupStreamingDestinations(gid interface{}, options ...RequestOptionFunc) ([]*AuditEventStreamingDestination, *Response, error) {
+	groupPath, err := s.client.groupFullPath(gid, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	q := GraphQLQuery{
+		Query: `query($fullPath: ID!) {
+			group(fullPath: $fullPath) {
+				externalAuditEventDestinations { nodes { ` + auditEventStreamingDestinationFields + ` } }
+			}
+		}`,
+		Variables: map[string]interface{}{"fullPath": groupPath},
+	}
+
+	var data struct {
+		Group *struct {
+			Destinations struct {
+				Nodes []*AuditEventStreamingDestination `json:"nodes"`
+			} `json:"externalAuditEventDestinations"`
+		} `json:"group"`
+	}
+	resp, err := s.client.GraphQL(q, &data, options...)
+	if err != nil {
+		return nil, resp, err
+	}
+	if data.Group == nil {
+		return nil, resp, ErrNotFound
+	}
+
+	return data.Group.Destinations.Nodes, resp, nil
+}
+
+// GetGroupStreamingDestination gets a single streaming destination of a
+// group, including its verification token.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/administration/audit_event_streaming/graphql_api.html#list-streaming-destinations
+func (s *AuditEventStreamingService) GetGroupStreamingDestination(gid interface{}, destination string, options ...RequestOptionFunc) (*AuditEventStreamingDestination, *Response, error) {
+	ds, resp, err := s.ListGroupStreamingDestinations(gid, options...)
+	if err != nil {
+		return nil, resp, err
+	}
+	for _, d := range ds {
+		if d.ID == destination {
+			return d, resp, nil
+		}
+	}
+	return nil, resp, ErrNotFound
+}
+
+// CreateGroupStreamingDestinationOptions represents the available
+// CreateGroupStreamingDestination() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/administration/audit_event_streaming/graphql_api.html#add-a-new-streaming-destination
+type CreateGroupStreamingDestinationOptions struct {
+	DestinationURL    *string `json:"destinationUrl"`
+	Name              *string `json:"name,omitempty"`
+	VerificationToken *string `json:"verificationToken,omitempty"`
+}
+
+func (o *CreateGroupStreamingDestinationOptions) input(groupPath string) map[string]interface{} {
+	input := map[string]interface{}{"groupPath": groupPath}
+	if o != nil {
+		if o.DestinationURL != nil {
+			input["destinationUrl"] = *o.DestinationURL
+		}
+		if o.Name != nil {
+			input["name"] = *o.Name
+		}
+		if o.VerificationToken != nil {
+			input["verificationToken"] = *o.VerificationToken
+		}
+	}
+	return input
+}
+
+// CreateGroupStreamingDestination adds a new streaming destination to a
+// group. If no verification token is given, GitLab generates one.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/administration/audit_event_streaming/graphql_api.html#add-a-new-streaming-destination
+func (s *AuditEventStreamingService) CreateGroupStreamingDestination(gid interface{}, opt *CreateGroupStreamingDestinationOptions, options ...RequestOptionFunc) (*AuditEventStreamingDestination, *Response, error) {
+	groupPath, err := s.client.groupFullPath(gid, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	q := GraphQLQuery{
+		Query: `mutation($input: ExternalAuditEventDestinationCreateInput!) {
+			externalAuditEventDestinationCreate(input: $input) {
+				errors
+				externalAuditEventDestination { ` + auditEventStreamingDestinationFields + ` }
+			}
+		}`,
+		Variables: map[string]interface{}{"input": opt.input(groupPath)},
+	}
+
+	var data struct {
+		Payload struct {
+			Errors      []string                        `json:"errors"`
+			Destination *AuditEventStreamingDestination `json:"externalAuditEventDestination"`
+		} `json:"externalAuditEventDestinationCreate"`
+	}
+	resp, err := s.client.GraphQL(q, &data, options...)
+	if err != nil {
+		return nil, resp, err
+	}
+	if err := mutationErrors(data.Payload.Errors); err != nil {
+		return nil, resp, err
+	}
+
+	return data.Payload.Destination, resp, nil
+}
+
+// UpdateStreamingDestinationOptions represents the available
+// UpdateGroupStreamingDestination() and UpdateInstanceStreamingDestination()
+// options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/administration/audit_event_streaming/graphql_api.html#update-streaming-destinations
+type UpdateStreamingDestinationOptions struct {
+	DestinationURL *string `json:"destinationUrl,omitempty"`
+	Name           *string `json:"name,omitempty"`
+}
+
+func (o *UpdateStreamingDestinationOptions) input(destination string) map[string]interface{} {
+	input := map[string]interface{}{"id": destination}
+	if o != nil {
+		if o.DestinationURL != nil {
+			input["destinationUrl"] = *o.DestinationURL
+		}
+		if o.Name != nil {
+			input["name"] = *o.Name
+		}
+	}
+	return input
+}
+
+// UpdateGroupStreamingDestination updates a streaming destination of a group.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/administration/audit_event_streaming/graphql_api.html#update-streaming-destinations
+func (s *AuditEventStreamingService) UpdateGroupStreamingDestination(destination string, opt *UpdateStreamingDestinationOptions, options ...RequestOptionFunc) (*AuditEventStreamingDestination, *Response, error) {
+	q := GraphQLQuery{
+		Query: `mutation($input: ExternalAuditEventDestinationUpdateInput!) {
+			externalAuditEventDestinationUpdate(input: $input) {
+				errors
+				externalAuditEventDestination { ` + auditEventStreamingDestinationFields + ` }
+			}
+		}`,
+		Variables: map[string]interface{}{"input": opt.input(destination)},
+	}
+
+	var data struct {
+		Payload struct {
+			Errors      []string                        `json:"errors"`
+			Destination *AuditEventStreamingDestination `json:"externalAuditEventDestination"`
+		} `json:"externalAuditEventDestinationUpdate"`
+	}
+	resp, err := s.client.GraphQL(q, &data, options...)
+	if err != nil {
+		return nil, resp, err
+	}
+	if err := mutationErrors(data.Payload.Errors); err != nil {
+		return nil, resp, err
+	}
+
+	return data.Payload.Destination, resp, nil
+}
+
+// DeleteGroupStreamingDestination deletes a streaming destination of a group.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/administration/audit_event_streaming/graphql_api.html#delete-streaming-destinations
+func (s *AuditEventStreamingService) DeleteGroupStreamingDestination(destination string, options ...RequestOptionFunc) (*Response, error) {
+	return s.deleteDestination("externalAuditEventDestinationDestroy", "ExternalAuditEventDestinationDestroyInput", destination, options)
+}
+
+// ListInstanceStreamingDestinations gets the streaming destinations of the
+// instance.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/administration/audit_event_streaming/graphql_api.html#list-streaming-destinations-1
+func (s *AuditEventStreamingService) ListInstanceStreamingDestinations(options ...RequestOptionFunc) ([]*AuditEventStreamingDestination, *Response, error) {
+	q := GraphQLQuery{
+		Query: `query {
+			instanceExternalAuditEventDestinations { nodes { ` + auditEventStreamingDestinationFields + ` } }
+		}`,
+	}
+
+	var data struct {
+		Destinations struct {
+			Nodes []*AuditEventStreamingDestination `json:"nodes"`
+		} `json:"instanceExternalAuditEventDestinations"`
+	}
+	resp, err := s.client.GraphQL(q, &data, options...)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return data.Destinations.Nodes, resp, nil
+}
+
+// CreateInstanceStreamingDestinationOptions represents the available
+// CreateInstanceStreamingDestination() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/administration/audit_event_streaming/graphql_api.html#add-a-new-streaming-destination-1
+type CreateInstanceStreamingDestinationOptions struct {
+	DestinationURL *string `json:"destinationUrl"`
+	Name           *string `json:"name,omitempty"`
+}
+
+func (o *CreateInstanceStreamingDestinationOptions) input() map[string]interface{} {
+	input := make(map[string]interface{})
+	if o != nil {
+		if o.DestinationURL != nil {
+			input["destinationUrl"] = *o.DestinationURL
+		}
+		if o.Name != nil {
+			input["name"] = *o.Name
+		}
+	}
+	return input
+}
+
+// CreateInstanceStreamingDestination adds a new streaming destination to the
+// instance.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/administration/audit_event_streaming/graphql_api.html#add-a-new-streaming-destination-1
+func (s *AuditEventStreamingService) CreateInstanceStreamingDestination(opt *CreateInstanceStreamingDestinationOptions, options ...RequestOptionFunc) (*AuditEventStreamingDestination, *Response, error) {
+	q := GraphQLQuery{
+		Query: `mutation($input: InstanceExternalAuditEventDestinationCreateInput!) {
+			instanceExternalAuditEventDestinationCreate(input: $input) {
+				errors
+				instanceExternalAuditEventDestination { ` + auditEventStreamingDestinationFields + ` }
+			}
+		}`,
+		Variables: map[string]interface{}{"input": opt.input()},
+	}
+
+	var data struct {
+		Payload struct {
+			Errors      []string                        `json:"errors"`
+			Destination *AuditEventStreamingDestination `json:"instanceExternalAuditEventDestination"`
+		} `json:"instanceExternalAuditEventDestinationCreate"`
+	}
+	resp, err := s.client.GraphQL(q, &data, options...)
+	if err != nil {
+		return nil, resp, err
+	}
+	if err := mutationErrors(data.Payload.Errors); err != nil {
+		return nil, resp, err
+	}
+
+	return data.Payload.Destination, resp, nil
+}
+
+// UpdateInstanceStreamingDestination updates a streaming destination of the
+// instance.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/administration/audit_event_streaming/graphql_api.html#update-streaming-destinations-1
+func (s *AuditEventStreamingService) UpdateInstanceStreamingDestination(destination string, opt *UpdateStreamingDestinationOptions, options ...RequestOptionFunc) (*AuditEventStreamingDestination, *Response, error) {
+	q := GraphQLQuery{
+		Query: `mutation($input: InstanceExternalAuditEventDestinationUpdateInput!) {
+			instanceExternalAuditEventDestinationUpdate(input: $input) {
+				errors
+				instanceExternalAuditEventDestination { ` + auditEventStreamingDestinationFields + ` }
+			}
+		}`,
+		Variables: map[string]interface{}{"input": opt.input(destination)},
+	}
+
+	var data struct {
+		Payload struct {
+			Errors      []string                        `json:"errors"`
+			Destination *AuditEventStreamingDestination `json:"instanceExternalAuditEventDestination"`
+		} `json:"instanceExternalAuditEventDestinationUpdate"`
+	}
+	resp, err := s.client.GraphQL(q, &data, options...)
+	if err != nil {
+		return nil, resp, err
+	}
+	if err := mutationErrors(data.Payload.Errors); err != nil {
+		return nil, resp, err
+	}
+
+	return data.Payload.Destination, resp, nil
+}
+
+// DeleteInstanceStreamingDestination deletes a streaming destination of the
+// instance.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/administration/audit_event_streaming/graphql_api.html#delete-streaming-destinations-1
+func (s *AuditEventStreamingService) DeleteInstanceStreamingDestination(destination string, options ...RequestOptionFunc) (*Response, error) {
+	return s.deleteDestination("instanceExternalAuditEventDestinationDestroy", "InstanceExternalAuditEventDestinationDestroyInput", destination, options)
+}
+
+func (s *AuditEventStreamingService) deleteDestination(mutation, inputType, destination string, options []RequestOptionFunc) (*Response, error) {
+	q := GraphQLQuery{
+		Query:     `mutation($input: ` + inputType + `!) { ` + mutation + `(input: $input) { errors } }`,
+		Variables: map[string]interface{}{"input": map[string]interface{}{"id": destination}},
+	}
+
+	var data map[string]struct {
+		Errors []string `json:"errors"`
+	}
+	resp, err := s.client.GraphQL(q, &data, options...)
+	if err != nil {
+		return resp, err
+	}
+
+	return resp, mutationErrors(data[mutation].Errors)
+}
+
+// AddGroupStreamingDestinationEventTypeFilters adds event type filters to a
+// streaming destination of a group. Only audit events of the given types are
+// streamed once a destination has filters.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/administration/audit_event_streaming/graphql_api.html#event-type-filters
+func (s *AuditEventStreamingService) AddGroupStreamingDestinationEventTypeFilters(destination string, eventTypes []string, options ...RequestOptionFunc) (*Response, error) {
+	return s.eventTypeFilters("auditEventsStreamingDestinationEventsAdd", "AuditEventsStreamingDestinationEventsAddInput", destination, eventTypes, options)
+}
+
+// RemoveGroupStreamingDestinationEventTypeFilters removes event type filters
+// from a streaming destination of a group.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/administration/audit_event_streaming/graphql_api.html#event-type-filters
+func (s *AuditEventStreamingService) RemoveGroupStreamingDestinationEventTypeFilters(destination string, eventTypes []string, options ...RequestOptionFunc) (*Response, error) {
+	return s.eventTypeFilters("auditEventsStreamingDestinationEventsRemove", "AuditEventsStreamingDestinationEventsRemoveInput", destination, eventTypes, options)
+}
+
+// AddInstanceStreamingDestinationEventTypeFilters adds event type filters to
+// a streaming destination of the instance.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/administration/audit_event_streaming/graphql_api.html#event-type-filters-1
+func (s *AuditEventStreamingService) AddInstanceStreamingDestinationEventTypeFilters(destination string, eventTypes []string, options ...RequestOptionFunc) (*Response, error) {
+	return s.eventTypeFilters("auditEventsStreamingDestinationInstanceEventsAdd", "AuditEventsStreamingDestinationInstanceEventsAddInput", destination, eventTypes, options)
+}
+
+// RemoveInstanceStreamingDestinationEventTypeFilters removes event type
+// filters from a streaming destination of the instance.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/administration/audit_event_streaming/graphql_api.html#event-type-filters-1
+func (s *AuditEventStreamingService) RemoveInstanceStreamingDestinationEventTypeFilters(destination string, eventTypes []string, options ...RequestOptionFunc) (*Response, error) {
+	return s.eventTypeFilters("auditEventsStreamingDestinationInstanceEventsRemove", "AuditEventsStreamingDestinationInstanceEventsRemoveInput", destination, eventTypes, options)
+}
+
+func (s *AuditEventStreamingService) eventTypeFilters(mutation, inputType, destination string, eventTypes []string, options []RequestOptionFunc) (*Response, error) {
+	q := GraphQLQuery{
+		Query: `mutation($input: ` + inputType + `!) { ` + mutation + `(input: $input) { errors } }`,
+		Variables: map[string]interface{}{"input": map[string]interface{}{
+			"destinationId":    destination,
+			"eventTypeFilters": eventTypes,
+		}},
+	}
+
+	var data map[string]struct {
+		Errors []string `json:"errors"`
+	}
+	resp, err := s.client.GraphQL(q, &data, options...)
+	if err != nil {
+		return resp, err
+	}
+
+	return resp, mutationErrors(data[mutation].Errors)
+}
+
+const auditEventStreamingTokenHeader = "X-Gitlab-Event-Streaming-Token"
+
+// ErrInvalidStreamingToken is returned when a streamed audit event does not
+// contain the expected verification token.
+var ErrInvalidStreamingToken = errors.New("invalid audit event streaming verification token")
+
+// AuditEventStreamingToken returns the verification token of a request made
+// by GitLab to stream an audit event.
+func AuditEventStreamingToken(r *http.Request) string {
+	return r.Header.Get(auditEventStreamingTokenHeader)
+}
+
+// VerifyAuditEventStreamingRequest reports whether a request made to a
+// streaming destination contains the given verification token.
+func VerifyAuditEventStreamingRequest(r *http.Request, verificationToken string) bool {
+	got := AuditEventStreamingToken(r)
+	return got != "" && subtle.ConstantTimeCompare([]byte(got), []byte(verificationToken)) == 1
+}
+
+// ParseAuditEventStreamingRequest verifies the verification token of a
+// request made to a streaming destination, and parses the streamed audit
+// event. ErrInvalidStreamingToken is returned if the token does not match.
+func ParseAuditEventStreamingRequest(r *http.Request, verificationToken string) (*AuditEvent, error) {
+	if !VerifyAuditEventStreamingRequest(r, verificationToken) {
+		return nil, ErrInvalidStreamingToken
+	}
+
+	payload, err := io.ReadAll(r.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	event := new(AuditEvent)
+	if err := json.Unmarshal(payload, event); err != nil {
+		return nil, err
+	}
+
+	return event, nil
+}
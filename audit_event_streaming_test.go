@@ -0,0 +1,183 @@
+package gitlab
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuditEventStreamingService_ListGroupStreamingDestinations(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/graphql", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+
+		var q GraphQLQuery
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&q))
+		require.Contains(t, q.Query, "externalAuditEventDestinations")
+		require.Equal(t, map[string]interface{}{"fullPath": "my-group"}, q.Variables)
+
+		fmt.Fprint(w, `{
+			"data": {
+				"group": {
+					"externalAuditEventDestinations": {
+						"nodes": [{
+							"id": "gid://gitlab/AuditEvents::ExternalAuditEventDestination/1",
+							"name": "SIEM",
+							"destinationUrl": "https://siem.example.com/events",
+							"verificationToken": "secret",
+							"eventTypeFilters": ["repository_git_operation"]
+						}]
+					}
+				}
+			}
+		}`)
+	})
+
+	want := []*AuditEventStreamingDestination{{
+		ID:                "gid://gitlab/AuditEvents::ExternalAuditEventDestination/1",
+		Name:              "SIEM",
+		DestinationURL:    "https://siem.example.com/events",
+		VerificationToken: "secret",
+		EventTypeFilters:  []string{"repository_git_operation"},
+	}}
+
+	ds, _, err := client.AuditEventStreaming.ListGroupStreamingDestinations("my-group")
+	require.NoError(t, err)
+	require.Equal(t, want, ds)
+
+	d, _, err := client.AuditEventStreaming.GetGroupStreamingDestination("my-group", want[0].ID)
+	require.NoError(t, err)
+	require.Equal(t, "secret", d.VerificationToken)
+
+	_, _, err = client.AuditEventStreaming.GetGroupStreamingDestination("my-group", "unknown")
+	require.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestAuditEventStreamingService_CreateGroupStreamingDestination(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/groups/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"id": 1, "full_path": "my-group"}`)
+	})
+	mux.HandleFunc("/api/graphql", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+
+		var q GraphQLQuery
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&q))
+		require.Contains(t, q.Query, "externalAuditEventDestinationCreate")
+		require.Equal(t, map[string]interface{}{
+			"groupPath":      "my-group",
+			"destinationUrl": "https://siem.example.com/events",
+		}, q.Variables["input"])
+
+		fmt.Fprint(w, `{
+			"data": {
+				"externalAuditEventDestinationCreate": {
+					"errors": [],
+					"externalAuditEventDestination": {
+						"id": "gid://gitlab/AuditEvents::ExternalAuditEventDestination/1",
+						"destinationUrl": "https://siem.example.com/events",
+						"verificationToken": "generated"
+					}
+				}
+			}
+		}`)
+	})
+
+	d, _, err := client.AuditEventStreaming.CreateGroupStreamingDestination(1, &CreateGroupStreamingDestinationOptions{
+		DestinationURL: Ptr("https://siem.example.com/events"),
+	})
+	require.NoError(t, err)
+	require.Equal(t, "generated", d.VerificationToken)
+}
+
+func TestAuditEventStreamingService_CreateInstanceStreamingDestination(t *testing.T) {
+	mux, client := setup(t)
+
+	var inputs []interface{}
+	mux.HandleFunc("/api/graphql", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+
+		var q GraphQLQuery
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&q))
+		inputs = append(inputs, q.Variables["input"])
+
+		fmt.Fprint(w, `{"data": {"instanceExternalAuditEventDestinationCreate": {"errors": [], "instanceExternalAuditEventDestination": {"id": "1"}}}}`)
+	})
+
+	_, _, err := client.AuditEventStreaming.CreateInstanceStreamingDestination(&CreateInstanceStreamingDestinationOptions{
+		DestinationURL: Ptr("https://siem.example.com/events"),
+	})
+	require.NoError(t, err)
+	_, _, err = client.AuditEventStreaming.CreateInstanceStreamingDestination(nil)
+	require.NoError(t, err)
+
+	require.Equal(t, []interface{}{
+		map[string]interface{}{"destinationUrl": "https://siem.example.com/events"},
+		map[string]interface{}{},
+	}, inputs)
+}
+
+func TestAuditEventStreamingService_MutationErrors(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/graphql", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{
+			"data": {
+				"auditEventsStreamingDestinationEventsAdd": {
+					"errors": ["Event type filters already exist"]
+				}
+			}
+		}`)
+	})
+
+	_, err := client.AuditEventStreaming.AddGroupStreamingDestinationEventTypeFilters("gid://gitlab/AuditEvents::ExternalAuditEventDestination/1", []string{"repository_git_operation"})
+
+	var gqlErrs GraphQLErrors
+	require.True(t, errors.As(err, &gqlErrs))
+	require.Equal(t, "graphql: Event type filters already exist", err.Error())
+}
+
+func TestGraphQL_Errors(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/graphql", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"data": null, "errors": [{"message": "Field 'foo' doesn't exist", "path": ["query", "foo"]}]}`)
+	})
+
+	_, err := client.GraphQL(GraphQLQuery{Query: "query { foo }"}, nil)
+
+	var gqlErrs GraphQLErrors
+	require.True(t, errors.As(err, &gqlErrs))
+	require.Len(t, gqlErrs, 1)
+	require.Equal(t, []interface{}{"query", "foo"}, gqlErrs[0].Path)
+}
+
+func TestParseAuditEventStreamingRequest(t *testing.T) {
+	body := `{"id": 1, "author_id": 1, "entity_id": 6, "entity_type": "Project", "event_type": "repository_git_operation"}`
+
+	r := httptest.NewRequest(http.MethodPost, "/events", strings.NewReader(body))
+	r.Header.Set("X-Gitlab-Event-Streaming-Token", "secret")
+
+	event, err := ParseAuditEventStreamingRequest(r, "secret")
+	require.NoError(t, err)
+	require.Equal(t, 6, event.EntityID)
+	require.Equal(t, "repository_git_operation", event.EventType)
+
+	r = httptest.NewRequest(http.MethodPost, "/events", strings.NewReader(body))
+	r.Header.Set("X-Gitlab-Event-Streaming-Token", "wrong")
+
+	_, err = ParseAuditEventStreamingRequest(r, "secret")
+	require.ErrorIs(t, err, ErrInvalidStreamingToken)
+
+	r = httptest.NewRequest(http.MethodPost, "/events", strings.NewReader(body))
+	require.False(t, VerifyAuditEventStreamingRequest(r, ""))
+}
@@ -109,7 +109,7 @@ func (s *AuditEventsService) GetInstanceAuditEvent(event int, options ...Request
 //
 // GitLab API docs: https://docs.gitlab.com/ee/api/audit_events.html#retrieve-all-group-audit-events
 func (s *AuditEventsService) ListGroupAuditEvents(gid interface{}, opt *ListAuditEventsOptions, options ...RequestOptionFunc) ([]*AuditEvent, *Response, error) {
-	group, err := parseID(gid)
+	group, err := parseGroupID(gid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -133,7 +133,7 @@ func (s *AuditEventsService) ListGroupAuditEvents(gid interface{}, opt *ListAudi
 //
 // GitLab API docs: https://docs.gitlab.com/ee/api/audit_events.html#retrieve-a-specific-group-audit-event
 func (s *AuditEventsService) GetGroupAuditEvent(gid interface{}, event int, options ...RequestOptionFunc) (*AuditEvent, *Response, error) {
-	group, err := parseID(gid)
+	group, err := parseGroupID(gid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -158,7 +158,7 @@ func (s *AuditEventsService) GetGroupAuditEvent(gid interface{}, event int, opti
 //
 // GitLab API docs: https://docs.gitlab.com/ee/api/audit_events.html#retrieve-all-project-audit-events
 func (s *AuditEventsService) ListProjectAuditEvents(pid interface{}, opt *ListAuditEventsOptions, options ...RequestOptionFunc) ([]*AuditEvent, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -183,7 +183,7 @@ func (s *AuditEventsService) ListProjectAuditEvents(pid interface{}, opt *ListAu
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/audit_events.html#retrieve-a-specific-project-audit-event
 func (s *AuditEventsService) GetProjectAuditEvent(pid interface{}, event int, options ...RequestOptionFunc) (*AuditEvent, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -202,3 +202,70 @@ func (s *AuditEventsService) GetProjectAuditEvent(pid interface{}, event int, op
 
 	return ae, resp, nil
 }
+
+// listAuditEventsFunc lists a single page of audit events.
+type listAuditEventsFunc func(opt *ListAuditEventsOptions, options ...RequestOptionFunc) ([]*AuditEvent, *Response, error)
+
+// exportAuditEvents calls fn for every audit event returned by list, using
+// keyset pagination to walk through all pages.
+func exportAuditEvents(list listAuditEventsFunc, opt *ListAuditEventsOptions, fn func(*AuditEvent) error, options []RequestOptionFunc) error {
+	var o ListAuditEventsOptions
+	if opt != nil {
+		o = *opt
+	}
+	o.Pagination = "keyset"
+	if o.PerPage == 0 {
+		o.PerPage = 100
+	}
+
+	reqOptions := options
+	for {
+		aes, resp, err := list(&o, reqOptions...)
+		if err != nil {
+			return err
+		}
+		for _, ae := range aes {
+			if err := fn(ae); err != nil {
+				return err
+			}
+		}
+		if resp.NextLink == "" {
+			return nil
+		}
+		reqOptions = append(options[:len(options):len(options)], WithKeysetPaginationParameters(resp.NextLink))
+	}
+}
+
+// ExportInstanceAuditEvents calls fn for every audit event of the instance
+// matching the options, using keyset pagination so large audit trails can be
+// exported without the offset pagination limits. Iteration stops at the first
+// error returned by fn. Authentication as Administrator is required.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/audit_events.html#retrieve-all-instance-audit-events
+func (s *AuditEventsService) ExportInstanceAuditEvents(opt *ListAuditEventsOptions, fn func(*AuditEvent) error, options ...RequestOptionFunc) error {
+	return exportAuditEvents(s.ListInstanceAuditEvents, opt, fn, options)
+}
+
+// ExportGroupAuditEvents calls fn for every audit event of the specified
+// group matching the options, using keyset pagination. Iteration stops at the
+// first error returned by fn.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/audit_events.html#retrieve-all-group-audit-events
+func (s *AuditEventsService) ExportGroupAuditEvents(gid interface{}, opt *ListAuditEventsOptions, fn func(*AuditEvent) error, options ...RequestOptionFunc) error {
+	list := func(opt *ListAuditEventsOptions, options ...RequestOptionFunc) ([]*AuditEvent, *Response, error) {
+		return s.ListGroupAuditEvents(gid, opt, options...)
+	}
+	return exportAuditEvents(list, opt, fn, options)
+}
+
+// ExportProjectAuditEvents calls fn for every audit event of the specified
+// project matching the options, using keyset pagination. Iteration stops at
+// the first error returned by fn.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/audit_events.html#retrieve-all-project-audit-events
+func (s *AuditEventsService) ExportProjectAuditEvents(pid interface{}, opt *ListAuditEventsOptions, fn func(*AuditEvent) error, options ...RequestOptionFunc) error {
+	list := func(opt *ListAuditEventsOptions, options ...RequestOptionFunc) ([]*AuditEvent, *Response, error) {
+		return s.ListProjectAuditEvents(pid, opt, options...)
+	}
+	return exportAuditEvents(list, opt, fn, options)
+}
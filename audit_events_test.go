@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 )
@@ -402,3 +403,32 @@ func TestAuditEventsService_GetProjectAuditEvent(t *testing.T) {
 	require.Nil(t, ae)
 	require.Equal(t, http.StatusNotFound, resp.StatusCode)
 }
+
+func TestAuditEventsService_ExportGroupAuditEvents(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/groups/6/audit_events", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		q := r.URL.Query()
+		require.Equal(t, "keyset", q.Get("pagination"))
+		require.Equal(t, "2024-01-01T00:00:00Z", q.Get("created_after"))
+
+		if q.Get("id_after") == "" {
+			w.Header().Set("Link", `<`+r.URL.Path+`?id_after=2&pagination=keyset&per_page=100&created_after=2024-01-01T00%3A00%3A00Z>; rel="next"`)
+			fmt.Fprint(w, `[{"id": 1}, {"id": 2}]`)
+			return
+		}
+		require.Equal(t, "2", q.Get("id_after"))
+		fmt.Fprint(w, `[{"id": 3}]`)
+	})
+
+	var ids []int
+	err := client.AuditEvents.ExportGroupAuditEvents(6, &ListAuditEventsOptions{
+		CreatedAfter: Ptr(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)),
+	}, func(ae *AuditEvent) error {
+		ids = append(ids, ae.ID)
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, []int{1, 2, 3}, ids)
+}
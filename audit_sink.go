@@ -0,0 +1,142 @@
+package gitlab
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	retryablehttp "github.com/hashicorp/go-retryablehttp"
+)
+
+// AuditRecord records a single request made by the client, for audit logs.
+// Secrets in the URL and the bodies are redacted like in request logs.
+type AuditRecord struct {
+	Time       time.Time
+	Method     string
+	URL        string
+	StatusCode int
+
+	// RequestBody is the redacted JSON body of the request.
+	RequestBody string
+
+	// ResponseBody is the redacted JSON body of the response. Bodies which
+	// are not JSON, or larger than the maximum size, are omitted.
+	ResponseBody string
+
+	// Truncated reports whether the response body was omitted because it
+	// exceeded the maximum size.
+	Truncated bool
+
+	// Err is the error of a request which failed without a response, like
+	// when the instance cannot be reached. The status code is zero then.
+	Err error
+}
+
+// AuditOptions represents the available WithAuditSink() options.
+type AuditOptions struct {
+	// MaxBodySize is the maximum size of response bodies which are
+	// recorded. Defaults to 64 KiB.
+	MaxBodySize int
+
+	// AllRequests records GET and HEAD requests as well. By default only
+	// requests which can change data are recorded.
+	AllRequests bool
+}
+
+// auditor tees the bodies of responses into audit records.
+type auditor struct {
+	sink func(*AuditRecord)
+	opt  AuditOptions
+}
+
+// audits reports whether requests using the given method are recorded.
+func (a *auditor) audits(method string) bool {
+	return a.opt.AllRequests || (method != http.MethodGet && method != http.MethodHead)
+}
+
+// record returns an audit record of the given request.
+func (a *auditor) record(req *retryablehttp.Request) *AuditRecord {
+	record := &AuditRecord{
+		Time:   time.Now(),
+		Method: req.Method,
+		URL:    redactURL(req.URL),
+	}
+	if isJSON(req.Header.Get("Content-Type")) {
+		if body, err := req.BodyBytes(); err == nil && len(body) > 0 {
+			record.RequestBody = redactJSON(body)
+		}
+	}
+	return record
+}
+
+// fail sends the audit record of a request which failed without a response
+// to the sink.
+func (a *auditor) fail(req *retryablehttp.Request, err error) {
+	record := a.record(req)
+	record.Err = err
+	a.sink(record)
+}
+
+// tee replaces the body of the response with one which records the body
+// while it is read, and sends the audit record to the sink once the body is
+// closed. Bodies are streamed, so large downloads are not buffered.
+func (a *auditor) tee(req *retryablehttp.Request, resp *http.Response) {
+	record := a.record(req)
+	record.StatusCode = resp.StatusCode
+
+	max := a.opt.MaxBodySize
+	if max <= 0 {
+		max = maxLoggedBodySize
+	}
+
+	resp.Body = &auditBody{
+		body:   resp.Body,
+		max:    max,
+		isJSON: isJSON(resp.Header.Get("Content-Type")),
+		emit: func(body []byte, truncated bool) {
+			record.Truncated = truncated
+			if !truncated && len(body) > 0 {
+				record.ResponseBody = redactJSON(body)
+			}
+			a.sink(record)
+		},
+	}
+}
+
+// auditBody copies up to max bytes of a body while it is read.
+type auditBody struct {
+	body      io.ReadCloser
+	max       int
+	isJSON    bool
+	buf       bytes.Buffer
+	truncated bool
+	emit      func(body []byte, truncated bool)
+	once      sync.Once
+}
+
+func (b *auditBody) Read(p []byte) (int, error) {
+	n, err := b.body.Read(p)
+	if n > 0 && b.isJSON && !b.truncated {
+		if b.buf.Len()+n > b.max {
+			b.truncated = true
+			b.buf.Reset()
+		} else {
+			b.buf.Write(p[:n])
+		}
+	}
+	return n, err
+}
+
+// Close closes the body, and emits the audit record.
+func (b *auditBody) Close() error {
+	b.once.Do(func() {
+		var body []byte
+		if b.isJSON {
+			body = b.buf.Bytes()
+		}
+		b.emit(body, b.truncated)
+	})
+	return b.body.Close()
+}
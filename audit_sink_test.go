@@ -0,0 +1,113 @@
+package gitlab
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithAuditSink(t *testing.T) {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	mux.HandleFunc("/api/v4/projects/1/variables", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method == http.MethodGet {
+			fmt.Fprint(w, `[]`)
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"key": "DEPLOY_TOKEN", "value": "s3cr3t", "protected": true}`)
+	})
+	mux.HandleFunc("/api/v4/projects/1/repository/archive", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/octet-stream")
+		fmt.Fprint(w, "archive")
+	})
+	mux.HandleFunc("/api/v4/projects/1/pipeline", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"id": 1, "status": "%s"}`, strings.Repeat("x", 100))
+	})
+
+	var mu sync.Mutex
+	var records []*AuditRecord
+	client, err := NewClient("secret",
+		WithBaseURL(server.URL),
+		WithAuditSink(func(r *AuditRecord) {
+			mu.Lock()
+			defer mu.Unlock()
+			records = append(records, r)
+		}, AuditOptions{MaxBodySize: 80}),
+	)
+	require.NoError(t, err)
+
+	_, _, err = client.ProjectVariables.ListVariables(1, nil)
+	require.NoError(t, err)
+	_, _, err = client.ProjectVariables.CreateVariable(1, &CreateProjectVariableOptions{
+		Key:   Ptr("DEPLOY_TOKEN"),
+		Value: Ptr("s3cr3t"),
+	})
+	require.NoError(t, err)
+	_, _, err = client.Pipelines.CreatePipeline(1, &CreatePipelineOptions{Ref: Ptr("main")})
+	require.NoError(t, err)
+
+	require.Len(t, records, 2)
+
+	r := records[0]
+	require.Equal(t, http.MethodPost, r.Method)
+	require.Equal(t, server.URL+"/api/v4/projects/1/variables", r.URL)
+	require.Equal(t, http.StatusCreated, r.StatusCode)
+	require.Equal(t, `{"key":"DEPLOY_TOKEN","value":"[REDACTED]"}`, r.RequestBody)
+	require.Equal(t, `{"key":"DEPLOY_TOKEN","protected":true,"value":"[REDACTED]"}`, r.ResponseBody)
+	require.False(t, r.Truncated)
+
+	require.True(t, records[1].Truncated)
+	require.Empty(t, records[1].ResponseBody)
+
+	records = nil
+	client, err = NewClient("secret",
+		WithBaseURL(server.URL),
+		WithAuditSink(func(r *AuditRecord) { records = append(records, r) }, AuditOptions{AllRequests: true}),
+	)
+	require.NoError(t, err)
+
+	var b bytes.Buffer
+	_, err = client.Repositories.StreamArchive(1, &b, nil)
+	require.NoError(t, err)
+	require.Equal(t, "archive", b.String())
+	require.Len(t, records, 1)
+	require.Equal(t, http.MethodGet, records[0].Method)
+	require.Empty(t, records[0].ResponseBody)
+}
+
+func TestWithAuditSinkRequestError(t *testing.T) {
+	server := httptest.NewServer(http.NotFoundHandler())
+	server.Close()
+
+	var records []*AuditRecord
+	client, err := NewClient("secret",
+		WithBaseURL(server.URL),
+		WithoutRetries(),
+		WithAuditSink(func(r *AuditRecord) { records = append(records, r) }, AuditOptions{}),
+	)
+	require.NoError(t, err)
+
+	_, _, err = client.ProjectVariables.CreateVariable(1, &CreateProjectVariableOptions{
+		Key:   Ptr("DEPLOY_TOKEN"),
+		Value: Ptr("s3cr3t"),
+	})
+	require.Error(t, err)
+
+	require.Len(t, records, 1)
+	require.Equal(t, http.MethodPost, records[0].Method)
+	require.Equal(t, server.URL+"/api/v4/projects/1/variables", records[0].URL)
+	require.Zero(t, records[0].StatusCode)
+	require.Equal(t, `{"key":"DEPLOY_TOKEN","value":"[REDACTED]"}`, records[0].RequestBody)
+	require.Error(t, records[0].Err)
+}
@@ -88,7 +88,7 @@ func (s *AwardEmojiService) ListSnippetAwardEmoji(pid interface{}, snippetID int
 }
 
 func (s *AwardEmojiService) listAwardEmoji(pid interface{}, resource string, resourceID int, opt *ListAwardEmojiOptions, options ...RequestOptionFunc) ([]*AwardEmoji, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -137,7 +137,7 @@ func (s *AwardEmojiService) GetSnippetAwardEmoji(pid interface{}, snippetID, awa
 }
 
 func (s *AwardEmojiService) getAwardEmoji(pid interface{}, resource string, resourceID, awardID int, options ...RequestOptionFunc) (*AwardEmoji, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -196,7 +196,7 @@ func (s *AwardEmojiService) CreateSnippetAwardEmoji(pid interface{}, snippetID i
 }
 
 func (s *AwardEmojiService) createAwardEmoji(pid interface{}, resource string, resourceID int, opt *CreateAwardEmojiOptions, options ...RequestOptionFunc) (*AwardEmoji, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -249,7 +249,7 @@ func (s *AwardEmojiService) DeleteSnippetAwardEmoji(pid interface{}, snippetID,
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/award_emoji.html#delete-an-award-emoji
 func (s *AwardEmojiService) deleteAwardEmoji(pid interface{}, resource string, resourceID, awardID int, options ...RequestOptionFunc) (*Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, err
 	}
@@ -291,7 +291,7 @@ func (s *AwardEmojiService) ListSnippetAwardEmojiOnNote(pid interface{}, snippet
 }
 
 func (s *AwardEmojiService) listAwardEmojiOnNote(pid interface{}, resources string, ressourceID, noteID int, opt *ListAwardEmojiOptions, options ...RequestOptionFunc) ([]*AwardEmoji, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -339,7 +339,7 @@ func (s *AwardEmojiService) GetSnippetAwardEmojiOnNote(pid interface{}, snippetI
 }
 
 func (s *AwardEmojiService) getSingleNoteAwardEmoji(pid interface{}, ressource string, resourceID, noteID, awardID int, options ...RequestOptionFunc) (*AwardEmoji, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -395,7 +395,7 @@ func (s *AwardEmojiService) CreateSnippetAwardEmojiOnNote(pid interface{}, snipp
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/award_emoji.html#award-a-new-emoji-on-a-comment
 func (s *AwardEmojiService) createAwardEmojiOnNote(pid interface{}, resource string, resourceID, noteID int, opt *CreateAwardEmojiOptions, options ...RequestOptionFunc) (*AwardEmoji, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -447,7 +447,7 @@ func (s *AwardEmojiService) DeleteSnippetAwardEmojiOnNote(pid interface{}, snipp
 }
 
 func (s *AwardEmojiService) deleteAwardEmojiOnNote(pid interface{}, resource string, resourceID, noteID, awardID int, options ...RequestOptionFunc) (*Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, err
 	}
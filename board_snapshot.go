@@ -0,0 +1,129 @@
+package gitlab
+
+import (
+	"sort"
+	"time"
+)
+
+// BoardSnapshot represents the state of an issue board at a point in time:
+// its lists with their limits, and the issues of every list in board order.
+// It can be serialized to JSON for backups and analytics.
+type BoardSnapshot struct {
+	ID      int                  `json:"id"`
+	Name    string               `json:"name"`
+	TakenAt time.Time            `json:"taken_at"`
+	Lists   []*BoardSnapshotList `json:"lists"`
+}
+
+// BoardSnapshotList represents a board list in a BoardSnapshot. The list
+// contains its work in progress limits, MaxIssueCount and MaxIssueWeight.
+type BoardSnapshotList struct {
+	List   *BoardList            `json:"list"`
+	Issues []*BoardSnapshotIssue `json:"issues"`
+}
+
+// BoardSnapshotIssue represents an issue of a board list in a BoardSnapshot.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/graphql/reference/#issue
+type BoardSnapshotIssue struct {
+	ID        string `json:"id"`
+	IID       string `json:"iid"`
+	Reference string `json:"reference"`
+	Title     string `json:"title"`
+	State     string `json:"state"`
+	Weight    *int   `json:"weight"`
+	WebURL    string `json:"webUrl"`
+}
+
+// SnapshotIssueBoard captures a snapshot of a project issue board. The
+// backlog and closed lists are not included.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/boards.html#show-a-single-issue-board
+// https://docs.gitlab.com/ee/api/graphql/reference/#queryboardlist
+func (s *IssueBoardsService) SnapshotIssueBoard(pid interface{}, board int, options ...RequestOptionFunc) (*BoardSnapshot, error) {
+	b, _, err := s.GetIssueBoard(pid, board, options...)
+	if err != nil {
+		return nil, err
+	}
+	return s.client.boardSnapshot(b.ID, b.Name, b.Lists, options)
+}
+
+// SnapshotGroupIssueBoard captures a snapshot of a group issue board. The
+// backlog and closed lists are not included.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/group_boards.html#single-group-issue-board
+// https://docs.gitlab.com/ee/api/graphql/reference/#queryboardlist
+func (s *GroupIssueBoardsService) SnapshotGroupIssueBoard(gid interface{}, board int, options ...RequestOptionFunc) (*BoardSnapshot, error) {
+	b, _, err := s.GetGroupIssueBoard(gid, board, options...)
+	if err != nil {
+		return nil, err
+	}
+	return s.client.boardSnapshot(b.ID, b.Name, b.Lists, options)
+}
+
+func (c *Client) boardSnapshot(id int, name string, lists []*BoardList, options []RequestOptionFunc) (*BoardSnapshot, error) {
+	snapshot := &BoardSnapshot{ID: id, Name: name, TakenAt: time.Now()}
+
+	lists = append([]*BoardList(nil), lists...)
+	sort.SliceStable(lists, func(i, j int) bool { return lists[i].Position < lists[j].Position })
+
+	for _, l := range lists {
+		issues, err := c.boardListIssues(l.ID, options)
+		if err != nil {
+			return nil, err
+		}
+		snapshot.Lists = append(snapshot.Lists, &BoardSnapshotList{List: l, Issues: issues})
+	}
+
+	return snapshot, nil
+}
+
+// boardListIssues gets the issues of a board list, in the order they are
+// shown on the board.
+func (c *Client) boardListIssues(list int, options []RequestOptionFunc) ([]*BoardSnapshotIssue, error) {
+	var issues []*BoardSnapshotIssue
+	var after interface{}
+
+	for {
+		q := GraphQLQuery{
+			Query: `query($id: ListID!, $after: String) {
+				boardList(id: $id) {
+					issues(first: 100, after: $after) {
+						nodes { id iid reference title state weight webUrl }
+						pageInfo { hasNextPage endCursor }
+					}
+				}
+			}`,
+			Variables: map[string]interface{}{
+				"id":    globalID("List", list),
+				"after": after,
+			},
+		}
+
+		var data struct {
+			List *struct {
+				Issues struct {
+					Nodes    []*BoardSnapshotIssue `json:"nodes"`
+					PageInfo struct {
+						HasNextPage bool   `json:"hasNextPage"`
+						EndCursor   string `json:"endCursor"`
+					} `json:"pageInfo"`
+				} `json:"issues"`
+			} `json:"boardList"`
+		}
+		if _, err := c.GraphQL(q, &data, options...); err != nil {
+			return nil, err
+		}
+		if data.List == nil {
+			return nil, ErrNotFound
+		}
+
+		issues = append(issues, data.List.Issues.Nodes...)
+		if !data.List.Issues.PageInfo.HasNextPage {
+			return issues, nil
+		}
+		after = data.List.Issues.PageInfo.EndCursor
+	}
+}
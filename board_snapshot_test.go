@@ -0,0 +1,60 @@
+package gitlab
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSnapshotIssueBoard(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/boards/2", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"id": 2, "name": "Development", "lists": [
+			{"id": 20, "position": 1, "label": {"name": "Review"}},
+			{"id": 10, "position": 0, "label": {"name": "Doing"}, "max_issue_count": 3}
+		]}`)
+	})
+	mux.HandleFunc("/api/graphql", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		var q GraphQLQuery
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&q))
+
+		switch {
+		case q.Variables["id"] == "gid://gitlab/List/10" && q.Variables["after"] == nil:
+			fmt.Fprint(w, `{"data": {"boardList": {"issues": {
+				"nodes": [{"id": "gid://gitlab/Issue/1", "iid": "1", "title": "First", "weight": 2}],
+				"pageInfo": {"hasNextPage": true, "endCursor": "c1"}
+			}}}}`)
+		case q.Variables["id"] == "gid://gitlab/List/10" && q.Variables["after"] == "c1":
+			fmt.Fprint(w, `{"data": {"boardList": {"issues": {
+				"nodes": [{"id": "gid://gitlab/Issue/2", "iid": "2", "title": "Second"}],
+				"pageInfo": {"hasNextPage": false}
+			}}}}`)
+		case q.Variables["id"] == "gid://gitlab/List/20":
+			fmt.Fprint(w, `{"data": {"boardList": {"issues": {"nodes": [], "pageInfo": {"hasNextPage": false}}}}}`)
+		default:
+			t.Errorf("unexpected variables %v", q.Variables)
+		}
+	})
+
+	snapshot, err := client.Boards.SnapshotIssueBoard(1, 2)
+	require.NoError(t, err)
+	require.Equal(t, 2, snapshot.ID)
+	require.Equal(t, "Development", snapshot.Name)
+	require.False(t, snapshot.TakenAt.IsZero())
+
+	require.Len(t, snapshot.Lists, 2)
+	require.Equal(t, 10, snapshot.Lists[0].List.ID)
+	require.Equal(t, 3, snapshot.Lists[0].List.MaxIssueCount)
+	require.Equal(t, []*BoardSnapshotIssue{
+		{ID: "gid://gitlab/Issue/1", IID: "1", Title: "First", Weight: Ptr(2)},
+		{ID: "gid://gitlab/Issue/2", IID: "2", Title: "Second"},
+	}, snapshot.Lists[0].Issues)
+	require.Equal(t, 20, snapshot.Lists[1].List.ID)
+	require.Empty(t, snapshot.Lists[1].Issues)
+}
@@ -87,7 +87,7 @@ type CreateIssueBoardOptions struct {
 //
 // GitLab API docs: https://docs.gitlab.com/ee/api/boards.html#create-an-issue-board
 func (s *IssueBoardsService) CreateIssueBoard(pid interface{}, opt *CreateIssueBoardOptions, options ...RequestOptionFunc) (*IssueBoard, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -122,7 +122,7 @@ type UpdateIssueBoardOptions struct {
 //
 // GitLab API docs: https://docs.gitlab.com/ee/api/boards.html#update-an-issue-board
 func (s *IssueBoardsService) UpdateIssueBoard(pid interface{}, board int, opt *UpdateIssueBoardOptions, options ...RequestOptionFunc) (*IssueBoard, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -146,7 +146,7 @@ func (s *IssueBoardsService) UpdateIssueBoard(pid interface{}, board int, opt *U
 //
 // GitLab API docs: https://docs.gitlab.com/ee/api/boards.html#delete-an-issue-board
 func (s *IssueBoardsService) DeleteIssueBoard(pid interface{}, board int, options ...RequestOptionFunc) (*Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, err
 	}
@@ -169,7 +169,7 @@ type ListIssueBoardsOptions ListOptions
 //
 // GitLab API docs: https://docs.gitlab.com/ee/api/boards.html#list-project-issue-boards
 func (s *IssueBoardsService) ListIssueBoards(pid interface{}, opt *ListIssueBoardsOptions, options ...RequestOptionFunc) ([]*IssueBoard, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -193,7 +193,7 @@ func (s *IssueBoardsService) ListIssueBoards(pid interface{}, opt *ListIssueBoar
 //
 // GitLab API docs: https://docs.gitlab.com/ee/api/boards.html#show-a-single-issue-board
 func (s *IssueBoardsService) GetIssueBoard(pid interface{}, board int, options ...RequestOptionFunc) (*IssueBoard, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -223,7 +223,7 @@ type GetIssueBoardListsOptions ListOptions
 //
 // GitLab API docs: https://docs.gitlab.com/ee/api/boards.html#list-board-lists-in-a-project-issue-board
 func (s *IssueBoardsService) GetIssueBoardLists(pid interface{}, board int, opt *GetIssueBoardListsOptions, options ...RequestOptionFunc) ([]*BoardList, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -247,7 +247,7 @@ func (s *IssueBoardsService) GetIssueBoardLists(pid interface{}, board int, opt
 //
 // GitLab API docs: https://docs.gitlab.com/ee/api/boards.html#show-a-single-board-list
 func (s *IssueBoardsService) GetIssueBoardList(pid interface{}, board, list int, options ...RequestOptionFunc) (*BoardList, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -286,7 +286,7 @@ type CreateIssueBoardListOptions struct {
 //
 // GitLab API docs: https://docs.gitlab.com/ee/api/boards.html#create-a-board-list
 func (s *IssueBoardsService) CreateIssueBoardList(pid interface{}, board int, opt *CreateIssueBoardListOptions, options ...RequestOptionFunc) (*BoardList, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -318,7 +318,7 @@ type UpdateIssueBoardListOptions struct {
 //
 // GitLab API docs: https://docs.gitlab.com/ee/api/boards.html#reorder-a-list-in-a-board
 func (s *IssueBoardsService) UpdateIssueBoardList(pid interface{}, board, list int, opt *UpdateIssueBoardListOptions, options ...RequestOptionFunc) (*BoardList, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -348,7 +348,7 @@ func (s *IssueBoardsService) UpdateIssueBoardList(pid interface{}, board, list i
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/boards.html#delete-a-board-list-from-a-board
 func (s *IssueBoardsService) DeleteIssueBoardList(pid interface{}, board, list int, options ...RequestOptionFunc) (*Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, err
 	}
@@ -65,7 +65,7 @@ type ListBranchesOptions struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/branches.html#list-repository-branches
 func (s *BranchesService) ListBranches(pid interface{}, opts *ListBranchesOptions, options ...RequestOptionFunc) ([]*Branch, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -90,7 +90,7 @@ func (s *BranchesService) ListBranches(pid interface{}, opts *ListBranchesOption
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/branches.html#get-single-repository-branch
 func (s *BranchesService) GetBranch(pid interface{}, branch string, options ...RequestOptionFunc) (*Branch, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -110,6 +110,21 @@ func (s *BranchesService) GetBranch(pid interface{}, branch string, options ...R
 	return b, resp, nil
 }
 
+// BranchExists reports whether a repository branch exists, without
+// retrieving the branch.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/branches.html#get-single-repository-branch
+func (s *BranchesService) BranchExists(pid interface{}, branch string, options ...RequestOptionFunc) (bool, *Response, error) {
+	project, err := parseProjectID(pid)
+	if err != nil {
+		return false, nil, err
+	}
+	u := fmt.Sprintf("projects/%s/repository/branches/%s", PathEscape(project), url.PathEscape(branch))
+
+	return s.client.exists(u, nil, options)
+}
+
 // ProtectBranchOptions represents the available ProtectBranch() options.
 //
 // GitLab API docs:
@@ -129,7 +144,7 @@ type ProtectBranchOptions struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/branches.html#protect-repository-branch
 func (s *BranchesService) ProtectBranch(pid interface{}, branch string, opts *ProtectBranchOptions, options ...RequestOptionFunc) (*Branch, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -159,7 +174,7 @@ func (s *BranchesService) ProtectBranch(pid interface{}, branch string, opts *Pr
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/branches.html#unprotect-repository-branch
 func (s *BranchesService) UnprotectBranch(pid interface{}, branch string, options ...RequestOptionFunc) (*Branch, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -193,7 +208,7 @@ type CreateBranchOptions struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/branches.html#create-repository-branch
 func (s *BranchesService) CreateBranch(pid interface{}, opt *CreateBranchOptions, options ...RequestOptionFunc) (*Branch, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -218,7 +233,7 @@ func (s *BranchesService) CreateBranch(pid interface{}, opt *CreateBranchOptions
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/branches.html#delete-repository-branch
 func (s *BranchesService) DeleteBranch(pid interface{}, branch string, options ...RequestOptionFunc) (*Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, err
 	}
@@ -237,7 +252,7 @@ func (s *BranchesService) DeleteBranch(pid interface{}, branch string, options .
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/branches.html#delete-merged-branches
 func (s *BranchesService) DeleteMergedBranches(pid interface{}, options ...RequestOptionFunc) (*Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, err
 	}
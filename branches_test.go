@@ -330,3 +330,27 @@ func TestBranchesService_DeleteMergedBranches(t *testing.T) {
 	require.Error(t, err)
 	require.Equal(t, http.StatusNotFound, resp.StatusCode)
 }
+
+func TestBranchesService_BranchExists(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/repository/branches/feature/head", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodHead)
+	})
+	mux.HandleFunc("/api/v4/projects/2/repository/branches/main", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+	})
+
+	exists, _, err := client.Branches.BranchExists(1, "feature/head")
+	require.NoError(t, err)
+	require.True(t, exists)
+
+	exists, resp, err := client.Branches.BranchExists(1, "missing")
+	require.NoError(t, err)
+	require.False(t, exists)
+	require.Equal(t, http.StatusNotFound, resp.StatusCode)
+
+	exists, _, err = client.Branches.BranchExists(2, "main")
+	require.Error(t, err)
+	require.False(t, exists)
+}
@@ -0,0 +1,327 @@
+package gitlab
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// BulkImportsService handles communication with the group and project
+// migration (direct transfer) related methods of the GitLab API.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/bulk_imports.html
+type BulkImportsService struct {
+	client *Client
+}
+
+// BulkImportStatusValue represents the status of a migration or of a single
+// migrated entity.
+type BulkImportStatusValue string
+
+// List of available bulk import status values.
+const (
+	BulkImportCreated  BulkImportStatusValue = "created"
+	BulkImportStarted  BulkImportStatusValue = "started"
+	BulkImportFinished BulkImportStatusValue = "finished"
+	BulkImportTimeout  BulkImportStatusValue = "timeout"
+	BulkImportFailed   BulkImportStatusValue = "failed"
+	BulkImportCanceled BulkImportStatusValue = "canceled"
+)
+
+// Done reports whether the status is final.
+func (v BulkImportStatusValue) Done() bool {
+	switch v {
+	case BulkImportFinished, BulkImportTimeout, BulkImportFailed, BulkImportCanceled:
+		return true
+	}
+	return false
+}
+
+// BulkImport represents a GitLab group or project migration.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/bulk_imports.html
+type BulkImport struct {
+	ID          int                   `json:"id"`
+	Status      BulkImportStatusValue `json:"status"`
+	SourceType  string                `json:"source_type"`
+	SourceURL   string                `json:"source_url"`
+	CreatedAt   *time.Time            `json:"created_at"`
+	UpdatedAt   *time.Time            `json:"updated_at"`
+	HasFailures bool                  `json:"has_failures"`
+}
+
+func (b BulkImport) String() string {
+	return Stringify(b)
+}
+
+// BulkImportEntity represents a single group or project of a migration.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/bulk_imports.html
+type BulkImportEntity struct {
+	ID                   int                        `json:"id"`
+	BulkImportID         int                        `json:"bulk_import_id"`
+	Status               BulkImportStatusValue      `json:"status"`
+	EntityType           string                     `json:"entity_type"`
+	SourceFullPath       string                     `json:"source_full_path"`
+	DestinationFullPath  string                     `json:"destination_full_path"`
+	DestinationName      string                     `json:"destination_name"`
+	DestinationSlug      string                     `json:"destination_slug"`
+	DestinationNamespace string                     `json:"destination_namespace"`
+	ParentID             int                        `json:"parent_id"`
+	NamespaceID          int                        `json:"namespace_id"`
+	ProjectID            int                        `json:"project_id"`
+	CreatedAt            *time.Time                 `json:"created_at"`
+	UpdatedAt            *time.Time                 `json:"updated_at"`
+	Failures             []*BulkImportEntityFailure `json:"failures"`
+	MigrateProjects      bool                       `json:"migrate_projects"`
+	MigrateMemberships   bool                       `json:"migrate_memberships"`
+	HasFailures          bool                       `json:"has_failures"`
+}
+
+func (b BulkImportEntity) String() string {
+	return Stringify(b)
+}
+
+// BulkImportEntityFailure represents a failure of a single migrated entity.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/bulk_imports.html#get-list-of-failed-import-records-for-group-or-project-migration-entity
+type BulkImportEntityFailure struct {
+	Relation           string     `json:"relation"`
+	Step               string     `json:"step"`
+	ExceptionMessage   string     `json:"exception_message"`
+	ExceptionClass     string     `json:"exception_class"`
+	CorrelationIDValue string     `json:"correlation_id_value"`
+	CreatedAt          *time.Time `json:"created_at"`
+	PipelineClass      string     `json:"pipeline_class"`
+	PipelineStep       string     `json:"pipeline_step"`
+	SourceURL          string     `json:"source_url"`
+	SourceTitle        string     `json:"source_title"`
+}
+
+// BulkImportConfigurationOptions represents the connection configuration of
+// the source GitLab instance of a migration.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/bulk_imports.html#start-a-new-group-or-project-migration
+type BulkImportConfigurationOptions struct {
+	URL         *string `url:"url,omitempty" json:"url,omitempty"`
+	AccessToken *string `url:"access_token,omitempty" json:"access_token,omitempty"`
+}
+
+// BulkImportEntityOptions represents a group or project to migrate.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/bulk_imports.html#start-a-new-group-or-project-migration
+type BulkImportEntityOptions struct {
+	SourceType           *string `url:"source_type,omitempty" json:"source_type,omitempty"`
+	SourceFullPath       *string `url:"source_full_path,omitempty" json:"source_full_path,omitempty"`
+	DestinationSlug      *string `url:"destination_slug,omitempty" json:"destination_slug,omitempty"`
+	DestinationNamespace *string `url:"destination_namespace,omitempty" json:"destination_namespace,omitempty"`
+	MigrateProjects      *bool   `url:"migrate_projects,omitempty" json:"migrate_projects,omitempty"`
+	MigrateMemberships   *bool   `url:"migrate_memberships,omitempty" json:"migrate_memberships,omitempty"`
+}
+
+// StartMigrationOptions represents the available StartMigration() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/bulk_imports.html#start-a-new-group-or-project-migration
+type StartMigrationOptions struct {
+	Configuration *BulkImportConfigurationOptions `url:"configuration,omitempty" json:"configuration,omitempty"`
+	Entities      []*BulkImportEntityOptions      `url:"entities,omitempty" json:"entities,omitempty"`
+}
+
+// StartMigration starts a new group or project migration.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/bulk_imports.html#start-a-new-group-or-project-migration
+func (s *BulkImportsService) StartMigration(opt *StartMigrationOptions, options ...RequestOptionFunc) (*BulkImport, *Response, error) {
+	req, err := s.client.NewRequest(http.MethodPost, "bulk_imports", opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	b := new(BulkImport)
+	resp, err := s.client.Do(req, b)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return b, resp, nil
+}
+
+// ListBulkImportsOptions represents the available ListBulkImports() and
+// ListBulkImportEntities() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/bulk_imports.html#list-all-group-or-project-migrations
+type ListBulkImportsOptions struct {
+	ListOptions
+	Sort   *string                `url:"sort,omitempty" json:"sort,omitempty"`
+	Status *BulkImportStatusValue `url:"status,omitempty" json:"status,omitempty"`
+}
+
+// ListBulkImports lists all group or project migrations.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/bulk_imports.html#list-all-group-or-project-migrations
+func (s *BulkImportsService) ListBulkImports(opt *ListBulkImportsOptions, options ...RequestOptionFunc) ([]*BulkImport, *Response, error) {
+	req, err := s.client.NewRequest(http.MethodGet, "bulk_imports", opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var bs []*BulkImport
+	resp, err := s.client.Do(req, &bs)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return bs, resp, nil
+}
+
+// GetBulkImport gets the details of a group or project migration.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/bulk_imports.html#get-group-or-project-migration-details
+func (s *BulkImportsService) GetBulkImport(id int, options ...RequestOptionFunc) (*BulkImport, *Response, error) {
+	u := fmt.Sprintf("bulk_imports/%d", id)
+
+	req, err := s.client.NewRequest(http.MethodGet, u, nil, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	b := new(BulkImport)
+	resp, err := s.client.Do(req, b)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return b, resp, nil
+}
+
+// CancelBulkImport cancels a group or project migration.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/bulk_imports.html#cancel-a-migration
+func (s *BulkImportsService) CancelBulkImport(id int, options ...RequestOptionFunc) (*BulkImport, *Response, error) {
+	u := fmt.Sprintf("bulk_imports/%d/cancel", id)
+
+	req, err := s.client.NewRequest(http.MethodPost, u, nil, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	b := new(BulkImport)
+	resp, err := s.client.Do(req, b)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return b, resp, nil
+}
+
+// ListBulkImportEntities lists the entities of all group or project
+// migrations.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/bulk_imports.html#list-all-group-or-project-migrations-entities
+func (s *BulkImportsService) ListBulkImportEntities(opt *ListBulkImportsOptions, options ...RequestOptionFunc) ([]*BulkImportEntity, *Response, error) {
+	req, err := s.client.NewRequest(http.MethodGet, "bulk_imports/entities", opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var es []*BulkImportEntity
+	resp, err := s.client.Do(req, &es)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return es, resp, nil
+}
+
+// ListBulkImportMigrationEntities lists the entities of a single group or
+// project migration.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/bulk_imports.html#list-group-or-project-migration-entities
+func (s *BulkImportsService) ListBulkImportMigrationEntities(id int, opt *ListBulkImportsOptions, options ...RequestOptionFunc) ([]*BulkImportEntity, *Response, error) {
+	u := fmt.Sprintf("bulk_imports/%d/entities", id)
+
+	req, err := s.client.NewRequest(http.MethodGet, u, opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var es []*BulkImportEntity
+	resp, err := s.client.Do(req, &es)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return es, resp, nil
+}
+
+// GetBulkImportEntity gets the details of a single entity of a group or
+// project migration.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/bulk_imports.html#get-group-or-project-migration-entity-details
+func (s *BulkImportsService) GetBulkImportEntity(id, entity int, options ...RequestOptionFunc) (*BulkImportEntity, *Response, error) {
+	u := fmt.Sprintf("bulk_imports/%d/entities/%d", id, entity)
+
+	req, err := s.client.NewRequest(http.MethodGet, u, nil, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	e := new(BulkImportEntity)
+	resp, err := s.client.Do(req, e)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return e, resp, nil
+}
+
+// ListBulkImportEntityFailures lists the failed import records of a single
+// entity of a group or project migration.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/bulk_imports.html#get-list-of-failed-import-records-for-group-or-project-migration-entity
+func (s *BulkImportsService) ListBulkImportEntityFailures(id, entity int, options ...RequestOptionFunc) ([]*BulkImportEntityFailure, *Response, error) {
+	u := fmt.Sprintf("bulk_imports/%d/entities/%d/failures", id, entity)
+
+	req, err := s.client.NewRequest(http.MethodGet, u, nil, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var fs []*BulkImportEntityFailure
+	resp, err := s.client.Do(req, &fs)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return fs, resp, nil
+}
+
+// WaitForBulkImport polls the status of a group or project migration using
+// the given interval until the migration is done or the context is done, and
+// returns the final state of the migration. A non-positive interval defaults
+// to five seconds.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/bulk_imports.html#get-group-or-project-migration-details
+func (s *BulkImportsService) WaitForBulkImport(ctx context.Context, id int, interval time.Duration, options ...RequestOptionFunc) (*BulkImport, *Response, error) {
+	get := func(options ...RequestOptionFunc) (*BulkImport, *Response, error) {
+		return s.GetBulkImport(id, options...)
+	}
+	done := func(b *BulkImport) bool {
+		return b.Status.Done()
+	}
+	return pollUntil(ctx, interval, get, done, options)
+}
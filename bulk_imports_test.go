@@ -0,0 +1,150 @@
+package gitlab
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBulkImportsService_StartMigration(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/bulk_imports", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testBody(t, r, `{"configuration":{"url":"https://source.example.com","access_token":"secret"},"entities":[{"source_type":"group_entity","source_full_path":"source/group","destination_slug":"group","destination_namespace":"target"}]}`)
+		fmt.Fprint(w, `{
+			"id": 1337,
+			"status": "created",
+			"source_type": "gitlab",
+			"source_url": "https://source.example.com",
+			"created_at": "2021-06-18T09:45:55.358Z",
+			"updated_at": "2021-06-18T09:46:27.003Z",
+			"has_failures": false
+		}`)
+	})
+
+	createdAt := time.Date(2021, time.June, 18, 9, 45, 55, 358000000, time.UTC)
+	updatedAt := time.Date(2021, time.June, 18, 9, 46, 27, 3000000, time.UTC)
+	want := &BulkImport{
+		ID:         1337,
+		Status:     BulkImportCreated,
+		SourceType: "gitlab",
+		SourceURL:  "https://source.example.com",
+		CreatedAt:  &createdAt,
+		UpdatedAt:  &updatedAt,
+	}
+
+	b, resp, err := client.BulkImports.StartMigration(&StartMigrationOptions{
+		Configuration: &BulkImportConfigurationOptions{
+			URL:         Ptr("https://source.example.com"),
+			AccessToken: Ptr("secret"),
+		},
+		Entities: []*BulkImportEntityOptions{
+			{
+				SourceType:           Ptr("group_entity"),
+				SourceFullPath:       Ptr("source/group"),
+				DestinationSlug:      Ptr("group"),
+				DestinationNamespace: Ptr("target"),
+			},
+		},
+	})
+	require.NoError(t, err)
+	require.NotNil(t, resp)
+	require.Equal(t, want, b)
+}
+
+func TestBulkImportsService_ListBulkImportMigrationEntities(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/bulk_imports/1/entities", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testParams(t, r, "status=failed")
+		fmt.Fprint(w, `[{
+			"id": 2,
+			"bulk_import_id": 1,
+			"status": "failed",
+			"entity_type": "project",
+			"source_full_path": "source/project",
+			"destination_full_path": "target/project",
+			"failures": [{"relation": "issues", "exception_message": "boom"}],
+			"has_failures": true
+		}]`)
+	})
+
+	want := []*BulkImportEntity{{
+		ID:                  2,
+		BulkImportID:        1,
+		Status:              BulkImportFailed,
+		EntityType:          "project",
+		SourceFullPath:      "source/project",
+		DestinationFullPath: "target/project",
+		Failures:            []*BulkImportEntityFailure{{Relation: "issues", ExceptionMessage: "boom"}},
+		HasFailures:         true,
+	}}
+
+	es, resp, err := client.BulkImports.ListBulkImportMigrationEntities(1, &ListBulkImportsOptions{
+		Status: Ptr(BulkImportFailed),
+	})
+	require.NoError(t, err)
+	require.NotNil(t, resp)
+	require.Equal(t, want, es)
+}
+
+func TestBulkImportsService_CancelBulkImport(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/bulk_imports/1/cancel", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		fmt.Fprint(w, `{"id": 1, "status": "canceled"}`)
+	})
+
+	b, resp, err := client.BulkImports.CancelBulkImport(1)
+	require.NoError(t, err)
+	require.NotNil(t, resp)
+	require.Equal(t, &BulkImport{ID: 1, Status: BulkImportCanceled}, b)
+}
+
+func TestBulkImportsService_WaitForBulkImport(t *testing.T) {
+	mux, client := setup(t)
+
+	calls := 0
+	mux.HandleFunc("/api/v4/bulk_imports/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		calls++
+		if calls < 3 {
+			fmt.Fprint(w, `{"id": 1, "status": "started"}`)
+			return
+		}
+		fmt.Fprint(w, `{"id": 1, "status": "finished"}`)
+	})
+
+	b, resp, err := client.BulkImports.WaitForBulkImport(context.Background(), 1, time.Millisecond)
+	require.NoError(t, err)
+	require.NotNil(t, resp)
+	require.Equal(t, BulkImportFinished, b.Status)
+	require.Equal(t, 3, calls)
+
+	_, resp, err = client.BulkImports.WaitForBulkImport(context.Background(), 2, time.Millisecond)
+	require.ErrorIs(t, err, ErrNotFound)
+	require.Equal(t, http.StatusNotFound, resp.StatusCode)
+}
+
+func TestBulkImportsService_WaitForBulkImportCanceled(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/bulk_imports/1", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"id": 1, "status": "started"}`)
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	_, _, err := client.BulkImports.WaitForBulkImport(ctx, 1, time.Hour)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	require.Less(t, time.Since(start), time.Minute)
+}
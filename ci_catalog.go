@@ -0,0 +1,231 @@
+package gitlab
+
+import "time"
+
+// CICatalogService handles communication with the CI/CD catalog related
+// methods of the GitLab GraphQL API.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/ci/components/
+type CICatalogService struct {
+	client *Client
+}
+
+// CICatalogResource represents a project published in the CI/CD catalog.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/graphql/reference/#cicatalogresource
+type CICatalogResource struct {
+	ID               string     `json:"id"`
+	Name             string     `json:"name"`
+	Description      string     `json:"description"`
+	FullPath         string     `json:"fullPath"`
+	WebPath          string     `json:"webPath"`
+	Icon             string     `json:"icon"`
+	StarCount        int        `json:"starCount"`
+	LatestReleasedAt *time.Time `json:"latestReleasedAt"`
+}
+
+func (r CICatalogResource) String() string {
+	return Stringify(r)
+}
+
+// CICatalogResourceVersion represents a released version of a CI/CD catalog
+// resource.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/graphql/reference/#cicatalogresourceversion
+type CICatalogResourceVersion struct {
+	ID         string                `json:"id"`
+	Name       string                `json:"name"`
+	ReleasedAt *time.Time            `json:"releasedAt"`
+	Components []*CICatalogComponent `json:"components"`
+}
+
+// CICatalogComponent represents a component of a CI/CD catalog resource
+// version.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/graphql/reference/#cicatalogresourcecomponent
+type CICatalogComponent struct {
+	ID          string                     `json:"id"`
+	Name        string                     `json:"name"`
+	IncludePath string                     `json:"includePath"`
+	Inputs      []*CICatalogComponentInput `json:"inputs"`
+}
+
+// CICatalogComponentInput represents an input of a CI/CD catalog component.
+type CICatalogComponentInput struct {
+	Name        string      `json:"name"`
+	Description string      `json:"description"`
+	Type        string      `json:"type"`
+	Required    bool        `json:"required"`
+	Default     interface{} `json:"default"`
+}
+
+const ciCatalogResourceFields = `id name description fullPath webPath icon starCount latestReleasedAt`
+
+// ListCatalogResourcesOptions represents the available
+// ListCatalogResources() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/graphql/reference/#queryci_catalog_resources
+type ListCatalogResourcesOptions struct {
+	// Search filters the resources by name and description.
+	Search *string `json:"search,omitempty"`
+
+	// Scope is "ALL" to list all resources visible to the user, or
+	// "NAMESPACES" to only list the resources of the user's namespaces.
+	Scope *string `json:"scope,omitempty"`
+
+	// Sort is the sort order, like "NAME_ASC" or "LATEST_RELEASED_AT_DESC".
+	Sort *string `json:"sort,omitempty"`
+}
+
+// ListCatalogResources gets the resources of the CI/CD catalog.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/graphql/reference/#queryci_catalog_resources
+func (s *CICatalogService) ListCatalogResources(opt *ListCatalogResourcesOptions, options ...RequestOptionFunc) ([]*CICatalogResource, *Response, error) {
+	if opt == nil {
+		opt = new(ListCatalogResourcesOptions)
+	}
+
+	var resources []*CICatalogResource
+	var after interface{}
+
+	for {
+		q := GraphQLQuery{
+			Query: `query($search: String, $scope: CiCatalogResourceScope, $sort: CiCatalogResourceSort, $after: String) {
+				ciCatalogResources(search: $search, scope: $scope, sort: $sort, after: $after) {
+					nodes { ` + ciCatalogResourceFields + ` }
+					pageInfo { hasNextPage endCursor }
+				}
+			}`,
+			Variables: map[string]interface{}{
+				"search": opt.Search,
+				"scope":  opt.Scope,
+				"sort":   opt.Sort,
+				"after":  after,
+			},
+		}
+
+		var data struct {
+			Resources struct {
+				Nodes    []*CICatalogResource `json:"nodes"`
+				PageInfo struct {
+					HasNextPage bool   `json:"hasNextPage"`
+					EndCursor   string `json:"endCursor"`
+				} `json:"pageInfo"`
+			} `json:"ciCatalogResources"`
+		}
+		resp, err := s.client.GraphQL(q, &data, options...)
+		if err != nil {
+			return nil, resp, err
+		}
+
+		resources = append(resources, data.Resources.Nodes...)
+		if !data.Resources.PageInfo.HasNextPage {
+			return resources, resp, nil
+		}
+		after = data.Resources.PageInfo.EndCursor
+	}
+}
+
+// GetCatalogResource gets the CI/CD catalog resource of a project.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/graphql/reference/#queryci_catalog_resource
+func (s *CICatalogService) GetCatalogResource(pid interface{}, options ...RequestOptionFunc) (*CICatalogResource, *Response, error) {
+	fullPath, err := s.client.projectFullPath(pid, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	q := GraphQLQuery{
+		Query: `query($fullPath: ID!) {
+			ciCatalogResource(fullPath: $fullPath) { ` + ciCatalogResourceFields + ` }
+		}`,
+		Variables: map[string]interface{}{"fullPath": fullPath},
+	}
+
+	var data struct {
+		Resource *CICatalogResource `json:"ciCatalogResource"`
+	}
+	resp, err := s.client.GraphQL(q, &data, options...)
+	if err != nil {
+		return nil, resp, err
+	}
+	if data.Resource == nil {
+		return nil, resp, ErrNotFound
+	}
+
+	return data.Resource, resp, nil
+}
+
+// ListCatalogResourceVersions gets the released versions of the CI/CD catalog
+// resource of a project, including their components, newest first.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/graphql/reference/#cicatalogresourceversions
+func (s *CICatalogService) ListCatalogResourceVersions(pid interface{}, options ...RequestOptionFunc) ([]*CICatalogResourceVersion, *Response, error) {
+	fullPath, err := s.client.projectFullPath(pid, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	type versionNode struct {
+		CICatalogResourceVersion
+		Components struct {
+			Nodes []*CICatalogComponent `json:"nodes"`
+		} `json:"components"`
+	}
+
+	var versions []*CICatalogResourceVersion
+	var after interface{}
+
+	for {
+		q := GraphQLQuery{
+			Query: `query($fullPath: ID!, $after: String) {
+				ciCatalogResource(fullPath: $fullPath) {
+					versions(after: $after) {
+						nodes {
+							id name releasedAt
+							components { nodes { id name includePath inputs { name description type required default } } }
+						}
+						pageInfo { hasNextPage endCursor }
+					}
+				}
+			}`,
+			Variables: map[string]interface{}{"fullPath": fullPath, "after": after},
+		}
+
+		var data struct {
+			Resource *struct {
+				Versions struct {
+					Nodes    []*versionNode `json:"nodes"`
+					PageInfo struct {
+						HasNextPage bool   `json:"hasNextPage"`
+						EndCursor   string `json:"endCursor"`
+					} `json:"pageInfo"`
+				} `json:"versions"`
+			} `json:"ciCatalogResource"`
+		}
+		resp, err := s.client.GraphQL(q, &data, options...)
+		if err != nil {
+			return nil, resp, err
+		}
+		if data.Resource == nil {
+			return nil, resp, ErrNotFound
+		}
+
+		for _, n := range data.Resource.Versions.Nodes {
+			v := n.CICatalogResourceVersion
+			v.Components = n.Components.Nodes
+			versions = append(versions, &v)
+		}
+		if !data.Resource.Versions.PageInfo.HasNextPage {
+			return versions, resp, nil
+		}
+		after = data.Resource.Versions.PageInfo.EndCursor
+	}
+}
@@ -0,0 +1,88 @@
+package gitlab
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCICatalogService_ListCatalogResources(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/graphql", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+
+		var q GraphQLQuery
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&q))
+		require.Equal(t, "deploy", q.Variables["search"])
+
+		if q.Variables["after"] == nil {
+			fmt.Fprint(w, `{"data": {"ciCatalogResources": {
+				"nodes": [{"id": "gid://gitlab/Ci::Catalog::Resource/1", "name": "deploy", "fullPath": "components/deploy", "starCount": 3}],
+				"pageInfo": {"hasNextPage": true, "endCursor": "abc"}
+			}}}`)
+			return
+		}
+
+		fmt.Fprint(w, `{"data": {"ciCatalogResources": {
+			"nodes": [{"id": "gid://gitlab/Ci::Catalog::Resource/2", "name": "deploy-k8s", "fullPath": "components/deploy-k8s"}],
+			"pageInfo": {"hasNextPage": false}
+		}}}`)
+	})
+
+	resources, _, err := client.CICatalog.ListCatalogResources(&ListCatalogResourcesOptions{Search: Ptr("deploy")})
+	require.NoError(t, err)
+	require.Len(t, resources, 2)
+	require.Equal(t, "components/deploy", resources[0].FullPath)
+	require.Equal(t, 3, resources[0].StarCount)
+	require.Equal(t, "deploy-k8s", resources[1].Name)
+}
+
+func TestCICatalogService_ListCatalogResourceVersions(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"id": 1, "path_with_namespace": "components/deploy"}`)
+	})
+	mux.HandleFunc("/api/graphql", func(w http.ResponseWriter, r *http.Request) {
+		var q GraphQLQuery
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&q))
+		require.Equal(t, "components/deploy", q.Variables["fullPath"])
+
+		fmt.Fprint(w, `{"data": {"ciCatalogResource": {"versions": {
+			"nodes": [{
+				"id": "gid://gitlab/Ci::Catalog::Resources::Version/1",
+				"name": "1.0.0",
+				"components": {"nodes": [{
+					"name": "deploy",
+					"includePath": "gitlab.example.com/components/deploy/deploy@1.0.0",
+					"inputs": [{"name": "stage", "type": "STRING", "required": false, "default": "deploy"}]
+				}]}
+			}],
+			"pageInfo": {"hasNextPage": false}
+		}}}}`)
+	})
+
+	versions, _, err := client.CICatalog.ListCatalogResourceVersions(1)
+	require.NoError(t, err)
+	require.Len(t, versions, 1)
+	require.Equal(t, "1.0.0", versions[0].Name)
+	require.Len(t, versions[0].Components, 1)
+	require.Equal(t, "gitlab.example.com/components/deploy/deploy@1.0.0", versions[0].Components[0].IncludePath)
+	require.Equal(t, "deploy", versions[0].Components[0].Inputs[0].Default)
+}
+
+func TestCICatalogService_GetCatalogResourceNotFound(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/graphql", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"data": {"ciCatalogResource": null}}`)
+	})
+
+	_, _, err := client.CICatalog.GetCatalogResource("components/unknown")
+	require.ErrorIs(t, err, ErrNotFound)
+}
@@ -0,0 +1,70 @@
+package gitlab
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+
+	"github.com/hashicorp/go-cleanhttp"
+)
+
+// NewClientFromCI returns a new GitLab API client for use inside GitLab
+// CI/CD jobs, configured using the predefined CI/CD variables. It connects
+// to CI_API_V4_URL, or CI_SERVER_URL if that is not set, authenticates with
+// the CI_JOB_TOKEN of the job, and trusts the certificates in
+// CI_SERVER_TLS_CA_FILE if it is set. Proxies configured using the
+// HTTPS_PROXY, HTTP_PROXY and NO_PROXY variables are used. The given options
+// are applied afterwards, so they can override this configuration.
+//
+// GitLab docs: https://docs.gitlab.com/ee/ci/variables/predefined_variables.html
+func NewClientFromCI(options ...ClientOptionFunc) (*Client, error) {
+	baseURL := os.Getenv("CI_API_V4_URL")
+	if baseURL == "" {
+		baseURL = os.Getenv("CI_SERVER_URL")
+	}
+	if baseURL == "" {
+		return nil, errors.New("neither CI_API_V4_URL nor CI_SERVER_URL is set, is this running in a CI/CD job?")
+	}
+
+	token := os.Getenv("CI_JOB_TOKEN")
+	if token == "" {
+		return nil, errors.New("CI_JOB_TOKEN is not set")
+	}
+
+	ciOptions := []ClientOptionFunc{WithBaseURL(baseURL)}
+
+	if caFile := os.Getenv("CI_SERVER_TLS_CA_FILE"); caFile != "" {
+		httpClient, err := httpClientWithCAFile(caFile)
+		if err != nil {
+			return nil, err
+		}
+		ciOptions = append(ciOptions, WithHTTPClient(httpClient))
+	}
+
+	return NewJobClient(token, append(ciOptions, options...)...)
+}
+
+// httpClientWithCAFile returns a pooled HTTP client which trusts the
+// certificates in the given PEM file in addition to the system roots.
+func httpClientWithCAFile(caFile string) (*http.Client, error) {
+	pem, err := os.ReadFile(caFile)
+	if err != nil {
+		return nil, fmt.Errorf("reading CI_SERVER_TLS_CA_FILE: %w", err)
+	}
+
+	pool, err := x509.SystemCertPool()
+	if err != nil || pool == nil {
+		pool = x509.NewCertPool()
+	}
+	if !pool.AppendCertsFromPEM(pem) {
+		return nil, fmt.Errorf("no certificates found in CI_SERVER_TLS_CA_FILE %s", caFile)
+	}
+
+	transport := cleanhttp.DefaultPooledTransport()
+	transport.TLSClientConfig = &tls.Config{RootCAs: pool, MinVersion: tls.VersionTLS12}
+
+	return &http.Client{Transport: transport}, nil
+}
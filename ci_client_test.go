@@ -0,0 +1,64 @@
+package gitlab
+
+import (
+	"encoding/pem"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewClientFromCI(t *testing.T) {
+	mux := http.NewServeMux()
+	server := httptest.NewTLSServer(mux)
+	t.Cleanup(server.Close)
+
+	mux.HandleFunc("/api/v4/job", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "job-secret", r.Header.Get("JOB-TOKEN"))
+		fmt.Fprint(w, `{"id": 7}`)
+	})
+
+	caFile := filepath.Join(t.TempDir(), "ca.crt")
+	cert := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+	require.NoError(t, os.WriteFile(caFile, cert, 0o600))
+
+	t.Setenv("CI_API_V4_URL", server.URL+"/api/v4")
+	t.Setenv("CI_SERVER_URL", "https://ignored.example.com")
+	t.Setenv("CI_JOB_TOKEN", "job-secret")
+	t.Setenv("CI_SERVER_TLS_CA_FILE", caFile)
+
+	client, err := NewClientFromCI()
+	require.NoError(t, err)
+	require.Equal(t, server.URL+"/api/v4/", client.BaseURL().String())
+
+	job, _, err := client.Jobs.GetJobTokensJob(&GetJobTokensJobOptions{JobToken: Ptr("job-secret")})
+	require.NoError(t, err)
+	require.Equal(t, 7, job.ID)
+}
+
+func TestNewClientFromCIErrors(t *testing.T) {
+	t.Setenv("CI_API_V4_URL", "")
+	t.Setenv("CI_SERVER_URL", "")
+	t.Setenv("CI_JOB_TOKEN", "job-secret")
+	t.Setenv("CI_SERVER_TLS_CA_FILE", "")
+
+	_, err := NewClientFromCI()
+	require.ErrorContains(t, err, "CI_SERVER_URL")
+
+	t.Setenv("CI_SERVER_URL", "https://gitlab.example.com")
+	client, err := NewClientFromCI(WithBaseURL("https://override.example.com"))
+	require.NoError(t, err)
+	require.Equal(t, "https://override.example.com/api/v4/", client.BaseURL().String())
+
+	t.Setenv("CI_SERVER_TLS_CA_FILE", filepath.Join(t.TempDir(), "missing.crt"))
+	_, err = NewClientFromCI()
+	require.ErrorContains(t, err, "CI_SERVER_TLS_CA_FILE")
+
+	t.Setenv("CI_JOB_TOKEN", "")
+	_, err = NewClientFromCI()
+	require.EqualError(t, err, "CI_JOB_TOKEN is not set")
+}
@@ -0,0 +1,91 @@
+package gitlab
+
+// CIMinutesProject represents a project in the compute usage of a namespace.
+type CIMinutesProject struct {
+	ID       string `json:"id"`
+	Name     string `json:"name"`
+	FullPath string `json:"fullPath"`
+}
+
+// CIMinutesProjectUsage represents the compute usage of a single project in a
+// month.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/graphql/reference/#ciminutesprojectmonthlyusage
+type CIMinutesProjectUsage struct {
+	Project *CIMinutesProject `json:"project"`
+
+	// Minutes is the number of compute minutes used, after applying the cost
+	// factors of the used runners.
+	Minutes int `json:"minutes"`
+
+	// SharedRunnersDuration is the total duration in seconds of the jobs
+	// which ran on shared runners.
+	SharedRunnersDuration int `json:"sharedRunnersDuration"`
+}
+
+// CIMinutesMonthlyUsage represents the compute usage of a namespace in a
+// month.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/graphql/reference/#ciminutesnamespacemonthlyusage
+type CIMinutesMonthlyUsage struct {
+	Month                 string                   `json:"month"`
+	MonthISO8601          string                   `json:"monthIso8601"`
+	Minutes               int                      `json:"minutes"`
+	SharedRunnersDuration int                      `json:"sharedRunnersDuration"`
+	Projects              []*CIMinutesProjectUsage `json:"projects"`
+}
+
+func (u CIMinutesMonthlyUsage) String() string {
+	return Stringify(u)
+}
+
+// GetCIMinutesUsage gets the monthly compute usage of a namespace, including
+// the usage of each of its projects. A namespace ID of 0 gets the usage of
+// the whole instance, which requires administrator access.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/graphql/reference/#queryciminutesusage
+func (s *NamespacesService) GetCIMinutesUsage(namespace int, options ...RequestOptionFunc) ([]*CIMinutesMonthlyUsage, *Response, error) {
+	var namespaceID interface{}
+	if namespace != 0 {
+		namespaceID = globalID("Namespace", namespace)
+	}
+
+	q := GraphQLQuery{
+		Query: `query($namespaceId: NamespaceID) {
+			ciMinutesUsage(namespaceId: $namespaceId) {
+				nodes {
+					month monthIso8601 minutes sharedRunnersDuration
+					projects { nodes { minutes sharedRunnersDuration project { id name fullPath } } }
+				}
+			}
+		}`,
+		Variables: map[string]interface{}{"namespaceId": namespaceID},
+	}
+
+	var data struct {
+		Usage struct {
+			Nodes []*struct {
+				CIMinutesMonthlyUsage
+				Projects struct {
+					Nodes []*CIMinutesProjectUsage `json:"nodes"`
+				} `json:"projects"`
+			} `json:"nodes"`
+		} `json:"ciMinutesUsage"`
+	}
+	resp, err := s.client.GraphQL(q, &data, options...)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	usage := make([]*CIMinutesMonthlyUsage, 0, len(data.Usage.Nodes))
+	for _, n := range data.Usage.Nodes {
+		u := n.CIMinutesMonthlyUsage
+		u.Projects = n.Projects.Nodes
+		usage = append(usage, &u)
+	}
+
+	return usage, resp, nil
+}
@@ -0,0 +1,66 @@
+package gitlab
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNamespacesService_GetCIMinutesUsage(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/graphql", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+
+		var q GraphQLQuery
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&q))
+		require.Equal(t, "gid://gitlab/Namespace/2", q.Variables["namespaceId"])
+
+		fmt.Fprint(w, `{"data": {"ciMinutesUsage": {"nodes": [{
+			"month": "January",
+			"monthIso8601": "2024-01-01",
+			"minutes": 120,
+			"sharedRunnersDuration": 7200,
+			"projects": {"nodes": [{
+				"minutes": 120,
+				"sharedRunnersDuration": 7200,
+				"project": {"id": "gid://gitlab/Project/3", "name": "app", "fullPath": "group1/app"}
+			}]}
+		}]}}}`)
+	})
+
+	usage, _, err := client.Namespaces.GetCIMinutesUsage(2)
+	require.NoError(t, err)
+
+	want := []*CIMinutesMonthlyUsage{{
+		Month:                 "January",
+		MonthISO8601:          "2024-01-01",
+		Minutes:               120,
+		SharedRunnersDuration: 7200,
+		Projects: []*CIMinutesProjectUsage{{
+			Project:               &CIMinutesProject{ID: "gid://gitlab/Project/3", Name: "app", FullPath: "group1/app"},
+			Minutes:               120,
+			SharedRunnersDuration: 7200,
+		}},
+	}}
+	require.Equal(t, want, usage)
+}
+
+func TestNamespacesService_GetCIMinutesUsageInstance(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/graphql", func(w http.ResponseWriter, r *http.Request) {
+		var q GraphQLQuery
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&q))
+		require.Nil(t, q.Variables["namespaceId"])
+
+		fmt.Fprint(w, `{"data": {"ciMinutesUsage": {"nodes": []}}}`)
+	})
+
+	usage, _, err := client.Namespaces.GetCIMinutesUsage(0)
+	require.NoError(t, err)
+	require.Empty(t, usage)
+}
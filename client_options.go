@@ -17,6 +17,7 @@
 package gitlab
 
 import (
+	"log/slog"
 	"net/http"
 	"time"
 
@@ -101,6 +102,16 @@ func WithErrorHandler(handler retryablehttp.ErrorHandler) ClientOptionFunc {
 	}
 }
 
+// WithFaultInjector calls the given injector for every HTTP request, to
+// simulate failures and latency in tests. See FaultPlan for an injector
+// which applies a fixed list of faults.
+func WithFaultInjector(injector FaultInjector) ClientOptionFunc {
+	return func(c *Client) error {
+		c.faultInjector = injector
+		return nil
+	}
+}
+
 // WithHTTPClient can be used to configure a custom HTTP client.
 func WithHTTPClient(httpClient *http.Client) ClientOptionFunc {
 	return func(c *Client) error {
@@ -109,6 +120,88 @@ func WithHTTPClient(httpClient *http.Client) ClientOptionFunc {
 	}
 }
 
+// WithLogger can be used to log all API requests at the debug level. Request
+// secrets like tokens are always redacted.
+func WithLogger(logger *slog.Logger) ClientOptionFunc {
+	return func(c *Client) error {
+		c.logger = logger
+		return nil
+	}
+}
+
+// WithLogBodies enables logging of the request and response headers and JSON
+// bodies when a logger is configured using WithLogger. Headers and fields
+// known to contain secrets, like tokens and CI/CD variable values, are
+// redacted.
+func WithLogBodies() ClientOptionFunc {
+	return func(c *Client) error {
+		c.logBodies = true
+		return nil
+	}
+}
+
+// WithAuditSink sends an audit record of every request which can change
+// data to the given sink, including the request and response bodies, for
+// environments which must record all changes made using automation. Secrets
+// are redacted like in request logs. Response bodies are recorded while
+// they are read, and the record is sent once the response is handled.
+// Requests which fail without a response are recorded with their error.
+func WithAuditSink(sink func(*AuditRecord), opt AuditOptions) ClientOptionFunc {
+	return func(c *Client) error {
+		c.auditor = &auditor{sink: sink, opt: opt}
+		return nil
+	}
+}
+
+// WithStrictDecoding makes requests fail with a *DecodingError when GitLab
+// returns fields which are not present in the type a response is decoded
+// into, or omits fields which are not tagged with omitempty. This is meant
+// to detect API changes, and should not be used in production.
+func WithStrictDecoding() ClientOptionFunc {
+	return func(c *Client) error {
+		c.strictDecoding = true
+		return nil
+	}
+}
+
+// WithDecodingReports calls the given function for every response which does
+// not match the type it is decoded into, without failing the request. The
+// report is also available as Response.DecodingReport.
+func WithDecodingReports(fn func(*DecodingReport)) ClientOptionFunc {
+	return func(c *Client) error {
+		c.decodingReportHandler = fn
+		return nil
+	}
+}
+
+// WithListDefaults registers default options for the list calls using
+// options of the same type, like &ListProjectMergeRequestsOptions{OrderBy:
+// Ptr("updated_at")} for ListProjectMergeRequests. Register the options of
+// every list call of a service to set defaults for the whole service. Use
+// &ListOptions{...} to register defaults for all list calls, like
+// &ListOptions{PerPage: 100}. Defaults are only used for parameters which
+// are not set by the options of the call.
+func WithListDefaults(defaults interface{}) ClientOptionFunc {
+	return func(c *Client) error {
+		if c.listDefaults == nil {
+			c.listDefaults = make(listDefaults)
+		}
+		return c.listDefaults.add(defaults)
+	}
+}
+
+// WithPerPageAutoTune requests the maximum number of items per page for
+// every list call which does not set it, to reduce the number of requests
+// needed to list resources. When GitLab returns fewer items per page than
+// requested for an endpoint, later requests to that endpoint ask for the
+// clamped number. Statistics are available using Client.PaginationStats.
+func WithPerPageAutoTune() ClientOptionFunc {
+	return func(c *Client) error {
+		c.perPageTuner = new(perPageTuner)
+		return nil
+	}
+}
+
 // WithRequestLogHook can be used to configure a custom request log hook.
 func WithRequestLogHook(hook retryablehttp.RequestLogHook) ClientOptionFunc {
 	return func(c *Client) error {
@@ -125,6 +218,36 @@ func WithResponseLogHook(hook retryablehttp.ResponseLogHook) ClientOptionFunc {
 	}
 }
 
+// WithRetryPolicy can be used to configure when and how often requests are
+// retried. The policy can be overridden per request using WithRequestRetry,
+// but a per request policy cannot make more attempts than configured here.
+func WithRetryPolicy(policy RetryPolicy) ClientOptionFunc {
+	return func(c *Client) error {
+		if policy.MaxAttempts > 0 {
+			c.client.RetryMax = policy.MaxAttempts - 1
+		}
+		if policy.WaitMin > 0 {
+			c.client.RetryWaitMin = policy.WaitMin
+		}
+		if policy.WaitMax > 0 {
+			c.client.RetryWaitMax = policy.WaitMax
+		}
+		c.retryPolicy = &policy
+		return nil
+	}
+}
+
+// WithTokenPrescan makes NewClient verify the personal access token before
+// returning the client, so a revoked, expired or insufficiently scoped token
+// is reported right away instead of failing requests later on. It has no
+// effect on clients created using other constructors.
+func WithTokenPrescan(prescan TokenPrescan) ClientOptionFunc {
+	return func(c *Client) error {
+		c.tokenPrescan = &prescan
+		return nil
+	}
+}
+
 // WithoutRetries disables the default retry logic.
 func WithoutRetries() ClientOptionFunc {
 	return func(c *Client) error {
@@ -86,7 +86,7 @@ type ListAgentsOptions ListOptions
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/cluster_agents.html#list-the-agents-for-a-project
 func (s *ClusterAgentsService) ListAgents(pid interface{}, opt *ListAgentsOptions, options ...RequestOptionFunc) ([]*Agent, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -111,7 +111,7 @@ func (s *ClusterAgentsService) ListAgents(pid interface{}, opt *ListAgentsOption
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/cluster_agents.html#get-details-about-an-agent
 func (s *ClusterAgentsService) GetAgent(pid interface{}, id int, options ...RequestOptionFunc) (*Agent, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -145,7 +145,7 @@ type RegisterAgentOptions struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/cluster_agents.html#register-an-agent-with-a-project
 func (s *ClusterAgentsService) RegisterAgent(pid interface{}, opt *RegisterAgentOptions, options ...RequestOptionFunc) (*Agent, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -170,7 +170,7 @@ func (s *ClusterAgentsService) RegisterAgent(pid interface{}, opt *RegisterAgent
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/cluster_agents.html#delete-a-registered-agent
 func (s *ClusterAgentsService) DeleteAgent(pid interface{}, id int, options ...RequestOptionFunc) (*Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, err
 	}
@@ -195,7 +195,7 @@ type ListAgentTokensOptions ListOptions
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/cluster_agents.html#list-tokens-for-an-agent
 func (s *ClusterAgentsService) ListAgentTokens(pid interface{}, aid int, opt *ListAgentTokensOptions, options ...RequestOptionFunc) ([]*AgentToken, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -220,7 +220,7 @@ func (s *ClusterAgentsService) ListAgentTokens(pid interface{}, aid int, opt *Li
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/cluster_agents.html#get-a-single-agent-token
 func (s *ClusterAgentsService) GetAgentToken(pid interface{}, aid int, id int, options ...RequestOptionFunc) (*AgentToken, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -254,7 +254,7 @@ type CreateAgentTokenOptions struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/cluster_agents.html#create-an-agent-token
 func (s *ClusterAgentsService) CreateAgentToken(pid interface{}, aid int, opt *CreateAgentTokenOptions, options ...RequestOptionFunc) (*AgentToken, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -279,7 +279,7 @@ func (s *ClusterAgentsService) CreateAgentToken(pid interface{}, aid int, opt *C
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/cluster_agents.html#revoke-an-agent-token
 func (s *ClusterAgentsService) RevokeAgentToken(pid interface{}, aid int, id int, options ...RequestOptionFunc) (*Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, err
 	}
@@ -17,6 +17,8 @@
 package gitlab
 
 import (
+	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"net/url"
@@ -89,7 +91,7 @@ type ListCommitsOptions struct {
 //
 // GitLab API docs: https://docs.gitlab.com/ee/api/commits.html#list-repository-commits
 func (s *CommitsService) ListCommits(pid interface{}, opt *ListCommitsOptions, options ...RequestOptionFunc) ([]*Commit, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -132,7 +134,7 @@ type GetCommitRefsOptions struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/commits.html#get-references-a-commit-is-pushed-to
 func (s *CommitsService) GetCommitRefs(pid interface{}, sha string, opt *GetCommitRefsOptions, options ...RequestOptionFunc) ([]*CommitRef, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -165,7 +167,7 @@ type GetCommitOptions struct {
 //
 // GitLab API docs: https://docs.gitlab.com/ee/api/commits.html#get-a-single-commit
 func (s *CommitsService) GetCommit(pid interface{}, sha string, opt *GetCommitOptions, options ...RequestOptionFunc) (*Commit, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -222,7 +224,7 @@ type CommitActionOptions struct {
 //
 // GitLab API docs: https://docs.gitlab.com/ee/api/commits.html#create-a-commit-with-multiple-files-and-actions
 func (s *CommitsService) CreateCommit(pid interface{}, opt *CreateCommitOptions, options ...RequestOptionFunc) (*Commit, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -274,7 +276,7 @@ type GetCommitDiffOptions struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/commits.html#get-the-diff-of-a-commit
 func (s *CommitsService) GetCommitDiff(pid interface{}, sha string, opt *GetCommitDiffOptions, options ...RequestOptionFunc) ([]*Diff, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -331,7 +333,7 @@ type GetCommitCommentsOptions ListOptions
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/commits.html#get-the-comments-of-a-commit
 func (s *CommitsService) GetCommitComments(pid interface{}, sha string, opt *GetCommitCommentsOptions, options ...RequestOptionFunc) ([]*CommitComment, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -370,7 +372,7 @@ type PostCommitCommentOptions struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/commits.html#post-comment-to-commit
 func (s *CommitsService) PostCommitComment(pid interface{}, sha string, opt *PostCommitCommentOptions, options ...RequestOptionFunc) (*CommitComment, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -425,7 +427,7 @@ type CommitStatus struct {
 //
 // GitLab API docs: https://docs.gitlab.com/ee/api/commits.html#list-the-statuses-of-a-commit
 func (s *CommitsService) GetCommitStatuses(pid interface{}, sha string, opt *GetCommitStatusesOptions, options ...RequestOptionFunc) ([]*CommitStatus, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -463,7 +465,7 @@ type SetCommitStatusOptions struct {
 //
 // GitLab API docs: https://docs.gitlab.com/ee/api/commits.html#set-the-pipeline-status-of-a-commit
 func (s *CommitsService) SetCommitStatus(pid interface{}, sha string, opt *SetCommitStatusOptions, options ...RequestOptionFunc) (*CommitStatus, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -488,7 +490,7 @@ func (s *CommitsService) SetCommitStatus(pid interface{}, sha string, opt *SetCo
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/commits.html#list-merge-requests-associated-with-a-commit
 func (s *CommitsService) ListMergeRequestsByCommit(pid interface{}, sha string, options ...RequestOptionFunc) ([]*MergeRequest, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -517,11 +519,13 @@ type CherryPickCommitOptions struct {
 	Message *string `url:"message,omitempty" json:"message,omitempty"`
 }
 
-// CherryPickCommit cherry picks a commit to a given branch.
+// CherryPickCommit cherry picks a commit to a given branch. If the commit
+// cannot be applied, the error is a *CommitApplyError. With DryRun, nothing
+// is committed and the returned commit is empty.
 //
 // GitLab API docs: https://docs.gitlab.com/ee/api/commits.html#cherry-pick-a-commit
 func (s *CommitsService) CherryPickCommit(pid interface{}, sha string, opt *CherryPickCommitOptions, options ...RequestOptionFunc) (*Commit, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -535,7 +539,7 @@ func (s *CommitsService) CherryPickCommit(pid interface{}, sha string, opt *Cher
 	c := new(Commit)
 	resp, err := s.client.Do(req, &c)
 	if err != nil {
-		return nil, resp, err
+		return nil, resp, newCommitApplyError(err)
 	}
 
 	return c, resp, nil
@@ -546,13 +550,16 @@ func (s *CommitsService) CherryPickCommit(pid interface{}, sha string, opt *Cher
 // GitLab API docs: https://docs.gitlab.com/ee/api/commits.html#revert-a-commit
 type RevertCommitOptions struct {
 	Branch *string `url:"branch,omitempty" json:"branch,omitempty"`
+	DryRun *bool   `url:"dry_run,omitempty" json:"dry_run,omitempty"`
 }
 
-// RevertCommit reverts a commit in a given branch.
+// RevertCommit reverts a commit in a given branch. If the commit cannot be
+// reverted, the error is a *CommitApplyError. With DryRun, nothing is
+// committed and the returned commit is empty.
 //
 // GitLab API docs: https://docs.gitlab.com/ee/api/commits.html#revert-a-commit
 func (s *CommitsService) RevertCommit(pid interface{}, sha string, opt *RevertCommitOptions, options ...RequestOptionFunc) (*Commit, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -566,12 +573,63 @@ func (s *CommitsService) RevertCommit(pid interface{}, sha string, opt *RevertCo
 	c := new(Commit)
 	resp, err := s.client.Do(req, &c)
 	if err != nil {
-		return nil, resp, err
+		return nil, resp, newCommitApplyError(err)
 	}
 
 	return c, resp, nil
 }
 
+// CommitApplyErrorCode describes why a commit cannot be cherry-picked or
+// reverted.
+type CommitApplyErrorCode string
+
+// These constants represent the reasons a commit cannot be applied.
+const (
+	// CommitApplyConflict means the changes of the commit conflict with the
+	// branch.
+	CommitApplyConflict CommitApplyErrorCode = "conflict"
+
+	// CommitApplyEmpty means applying the commit results in no changes,
+	// for example because it was already cherry-picked.
+	CommitApplyEmpty CommitApplyErrorCode = "empty"
+)
+
+// CommitApplyError is returned by CherryPickCommit and RevertCommit when
+// GitLab cannot apply the commit to the branch.
+type CommitApplyError struct {
+	Code    CommitApplyErrorCode
+	Message string
+	Err     *ErrorResponse
+}
+
+func (e *CommitApplyError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Code, e.Err.Error())
+}
+
+// Unwrap returns the underlying ErrorResponse.
+func (e *CommitApplyError) Unwrap() error {
+	return e.Err
+}
+
+// newCommitApplyError wraps an error response which reports why a commit
+// cannot be applied in a CommitApplyError. Other errors are returned
+// unchanged.
+func newCommitApplyError(err error) error {
+	var errResp *ErrorResponse
+	if !errors.As(err, &errResp) || errResp.Response.StatusCode != http.StatusBadRequest {
+		return err
+	}
+
+	var body struct {
+		ErrorCode CommitApplyErrorCode `json:"error_code"`
+	}
+	if json.Unmarshal(errResp.Body, &body) != nil || body.ErrorCode == "" {
+		return err
+	}
+
+	return &CommitApplyError{Code: body.ErrorCode, Message: errResp.Message, Err: errResp}
+}
+
 // GPGSignature represents a Gitlab commit's GPG Signature.
 //
 // GitLab API docs:
@@ -589,7 +647,7 @@ type GPGSignature struct {
 //
 // GitLab API docs: https://docs.gitlab.com/ee/api/commits.html#get-gpg-signature-of-a-commit
 func (s *CommitsService) GetGPGSignature(pid interface{}, sha string, options ...RequestOptionFunc) (*GPGSignature, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -845,6 +845,62 @@ func TestCommitsService_ListMergeRequestsByCommit(t *testing.T) {
 	require.Equal(t, http.StatusNotFound, resp.StatusCode)
 }
 
+func TestCommitsService_CherryPickCommitDryRunConflict(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/repository/commits/master/cherry_pick", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testBody(t, r, `{"branch":"stable","dry_run":true}`)
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, `{
+			"message": "Sorry, we cannot cherry-pick this commit automatically.",
+			"error_code": "conflict",
+			"dry_run": "error"
+		}`)
+	})
+
+	_, resp, err := client.Commits.CherryPickCommit(1, "master", &CherryPickCommitOptions{
+		Branch: Ptr("stable"),
+		DryRun: Ptr(true),
+	})
+	require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+
+	var applyErr *CommitApplyError
+	require.ErrorAs(t, err, &applyErr)
+	require.Equal(t, CommitApplyConflict, applyErr.Code)
+	require.Contains(t, applyErr.Message, "cannot cherry-pick")
+
+	var errResp *ErrorResponse
+	require.ErrorAs(t, err, &errResp)
+}
+
+func TestCommitsService_RevertCommitDryRun(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/repository/commits/b8d3/revert", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testBody(t, r, `{"branch":"main","dry_run":true}`)
+		fmt.Fprint(w, `{"dry_run": "success"}`)
+	})
+
+	c, _, err := client.Commits.RevertCommit(1, "b8d3", &RevertCommitOptions{
+		Branch: Ptr("main"),
+		DryRun: Ptr(true),
+	})
+	require.NoError(t, err)
+	require.Equal(t, &Commit{}, c)
+
+	mux.HandleFunc("/api/v4/projects/1/repository/commits/c9e4/revert", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, `{"message": "Sorry, we cannot revert this commit automatically.", "error_code": "empty"}`)
+	})
+
+	_, _, err = client.Commits.RevertCommit(1, "c9e4", &RevertCommitOptions{Branch: Ptr("main")})
+	var applyErr *CommitApplyError
+	require.ErrorAs(t, err, &applyErr)
+	require.Equal(t, CommitApplyEmpty, applyErr.Code)
+}
+
 func TestCommitsService_CherryPickCommit(t *testing.T) {
 	mux, client := setup(t)
 
@@ -0,0 +1,222 @@
+package gitlab
+
+// ComplianceFrameworksService handles communication with the compliance
+// framework related methods of the GitLab GraphQL API.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/user/group/compliance_frameworks.html
+type ComplianceFrameworksService struct {
+	client *Client
+}
+
+// ComplianceFramework represents a compliance framework of a group.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/graphql/reference/#complianceframework
+type ComplianceFramework struct {
+	ID                            string `json:"id"`
+	Name                          string `json:"name"`
+	Description                   string `json:"description"`
+	Color                         string `json:"color"`
+	Default                       bool   `json:"default"`
+	PipelineConfigurationFullPath string `json:"pipelineConfigurationFullPath"`
+}
+
+func (f ComplianceFramework) String() string {
+	return Stringify(f)
+}
+
+const complianceFrameworkFields = `id name description color default pipelineConfigurationFullPath`
+
+// ListComplianceFrameworks gets the compliance frameworks of a top-level
+// group.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/graphql/reference/#groupcomplianceframeworks
+func (s *ComplianceFrameworksService) ListComplianceFrameworks(gid interface{}, options ...RequestOptionFunc) ([]*ComplianceFramework, *Response, error) {
+	groupPath, err := s.client.groupFullPath(gid, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	q := GraphQLQuery{
+		Query: `query($fullPath: ID!) {
+			namespace(fullPath: $fullPath) {
+				complianceFrameworks { nodes { ` + complianceFrameworkFields + ` } }
+			}
+		}`,
+		Variables: map[string]interface{}{"fullPath": groupPath},
+	}
+
+	var data struct {
+		Namespace *struct {
+			Frameworks struct {
+				Nodes []*ComplianceFramework `json:"nodes"`
+			} `json:"complianceFrameworks"`
+		} `json:"namespace"`
+	}
+	resp, err := s.client.GraphQL(q, &data, options...)
+	if err != nil {
+		return nil, resp, err
+	}
+	if data.Namespace == nil {
+		return nil, resp, ErrNotFound
+	}
+
+	return data.Namespace.Frameworks.Nodes, resp, nil
+}
+
+// ComplianceFrameworkOptions represents the available
+// CreateComplianceFramework() and UpdateComplianceFramework() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/graphql/reference/#complianceframeworkinput
+type ComplianceFrameworkOptions struct {
+	Name                          *string `json:"name,omitempty"`
+	Description                   *string `json:"description,omitempty"`
+	Color                         *string `json:"color,omitempty"`
+	Default                       *bool   `json:"default,omitempty"`
+	PipelineConfigurationFullPath *string `json:"pipelineConfigurationFullPath,omitempty"`
+}
+
+// CreateComplianceFramework creates a compliance framework for a top-level
+// group.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/graphql/reference/#mutationcreatecomplianceframework
+func (s *ComplianceFrameworksService) CreateComplianceFramework(gid interface{}, opt *ComplianceFrameworkOptions, options ...RequestOptionFunc) (*ComplianceFramework, *Response, error) {
+	groupPath, err := s.client.groupFullPath(gid, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	q := GraphQLQuery{
+		Query: `mutation($input: CreateComplianceFrameworkInput!) {
+			createComplianceFramework(input: $input) {
+				errors
+				framework { ` + complianceFrameworkFields + ` }
+			}
+		}`,
+		Variables: map[string]interface{}{"input": map[string]interface{}{
+			"namespacePath": groupPath,
+			"params":        opt,
+		}},
+	}
+
+	var data struct {
+		Payload struct {
+			Errors    []string             `json:"errors"`
+			Framework *ComplianceFramework `json:"framework"`
+		} `json:"createComplianceFramework"`
+	}
+	resp, err := s.client.GraphQL(q, &data, options...)
+	if err != nil {
+		return nil, resp, err
+	}
+	if err := mutationErrors(data.Payload.Errors); err != nil {
+		return nil, resp, err
+	}
+
+	return data.Payload.Framework, resp, nil
+}
+
+// UpdateComplianceFramework updates a compliance framework.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/graphql/reference/#mutationupdatecomplianceframework
+func (s *ComplianceFrameworksService) UpdateComplianceFramework(framework string, opt *ComplianceFrameworkOptions, options ...RequestOptionFunc) (*ComplianceFramework, *Response, error) {
+	q := GraphQLQuery{
+		Query: `mutation($input: UpdateComplianceFrameworkInput!) {
+			updateComplianceFramework(input: $input) {
+				errors
+				complianceFramework { ` + complianceFrameworkFields + ` }
+			}
+		}`,
+		Variables: map[string]interface{}{"input": map[string]interface{}{
+			"id":     framework,
+			"params": opt,
+		}},
+	}
+
+	var data struct {
+		Payload struct {
+			Errors    []string             `json:"errors"`
+			Framework *ComplianceFramework `json:"complianceFramework"`
+		} `json:"updateComplianceFramework"`
+	}
+	resp, err := s.client.GraphQL(q, &data, options...)
+	if err != nil {
+		return nil, resp, err
+	}
+	if err := mutationErrors(data.Payload.Errors); err != nil {
+		return nil, resp, err
+	}
+
+	return data.Payload.Framework, resp, nil
+}
+
+// DeleteComplianceFramework deletes a compliance framework.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/graphql/reference/#mutationdestroycomplianceframework
+func (s *ComplianceFrameworksService) DeleteComplianceFramework(framework string, options ...RequestOptionFunc) (*Response, error) {
+	q := GraphQLQuery{
+		Query: `mutation($input: DestroyComplianceFrameworkInput!) {
+			destroyComplianceFramework(input: $input) { errors }
+		}`,
+		Variables: map[string]interface{}{"input": map[string]interface{}{"id": framework}},
+	}
+
+	var data struct {
+		Payload struct {
+			Errors []string `json:"errors"`
+		} `json:"destroyComplianceFramework"`
+	}
+	resp, err := s.client.GraphQL(q, &data, options...)
+	if err != nil {
+		return resp, err
+	}
+
+	return resp, mutationErrors(data.Payload.Errors)
+}
+
+// AssignComplianceFramework assigns a compliance framework to a project. An
+// empty framework removes the compliance framework from the project. The
+// project is identified by its numeric ID.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/graphql/reference/#mutationprojectsetcomplianceframework
+func (s *ComplianceFrameworksService) AssignComplianceFramework(project int, framework string, options ...RequestOptionFunc) (*Response, error) {
+	var frameworkID interface{}
+	if framework != "" {
+		frameworkID = framework
+	}
+
+	q := GraphQLQuery{
+		Query: `mutation($input: ProjectSetComplianceFrameworkInput!) {
+			projectSetComplianceFramework(input: $input) { errors }
+		}`,
+		Variables: map[string]interface{}{"input": map[string]interface{}{
+			"projectId":             ProjectGlobalID(project),
+			"complianceFrameworkId": frameworkID,
+		}},
+	}
+
+	var data struct {
+		Payload struct {
+			Errors []string `json:"errors"`
+		} `json:"projectSetComplianceFramework"`
+	}
+	resp, err := s.client.GraphQL(q, &data, options...)
+	if err != nil {
+		return resp, err
+	}
+
+	return resp, mutationErrors(data.Payload.Errors)
+}
+
+// ProjectGlobalID returns the GraphQL global ID of the project with the given
+// numeric ID.
+func ProjectGlobalID(project int) string {
+	return globalID("Project", project)
+}
@@ -0,0 +1,92 @@
+package gitlab
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestComplianceFrameworksService_ListComplianceFrameworks(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/groups/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"id": 1, "full_path": "my-group"}`)
+	})
+	mux.HandleFunc("/api/graphql", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		var q GraphQLQuery
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&q))
+		require.Equal(t, "my-group", q.Variables["fullPath"])
+		fmt.Fprint(w, `{
+			"data": {
+				"namespace": {
+					"complianceFrameworks": {
+						"nodes": [{
+							"id": "gid://gitlab/ComplianceManagement::Framework/1",
+							"name": "SOX",
+							"description": "Sarbanes-Oxley",
+							"color": "#1aaa55",
+							"default": true,
+							"pipelineConfigurationFullPath": ".sox.yml@compliance/sox"
+						}]
+					}
+				}
+			}
+		}`)
+	})
+
+	want := []*ComplianceFramework{{
+		ID:                            "gid://gitlab/ComplianceManagement::Framework/1",
+		Name:                          "SOX",
+		Description:                   "Sarbanes-Oxley",
+		Color:                         "#1aaa55",
+		Default:                       true,
+		PipelineConfigurationFullPath: ".sox.yml@compliance/sox",
+	}}
+
+	fs, _, err := client.ComplianceFrameworks.ListComplianceFrameworks(1)
+	require.NoError(t, err)
+	require.Equal(t, want, fs)
+}
+
+func TestComplianceFrameworksService_AssignComplianceFramework(t *testing.T) {
+	mux, client := setup(t)
+
+	var inputs []interface{}
+	mux.HandleFunc("/api/graphql", func(w http.ResponseWriter, r *http.Request) {
+		var q GraphQLQuery
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&q))
+		require.Contains(t, q.Query, "projectSetComplianceFramework")
+		inputs = append(inputs, q.Variables["input"])
+		fmt.Fprint(w, `{"data": {"projectSetComplianceFramework": {"errors": []}}}`)
+	})
+
+	_, err := client.ComplianceFrameworks.AssignComplianceFramework(5, "gid://gitlab/ComplianceManagement::Framework/1")
+	require.NoError(t, err)
+
+	_, err = client.ComplianceFrameworks.AssignComplianceFramework(5, "")
+	require.NoError(t, err)
+
+	require.Equal(t, []interface{}{
+		map[string]interface{}{"projectId": "gid://gitlab/Project/5", "complianceFrameworkId": "gid://gitlab/ComplianceManagement::Framework/1"},
+		map[string]interface{}{"projectId": "gid://gitlab/Project/5", "complianceFrameworkId": nil},
+	}, inputs)
+}
+
+func TestComplianceFrameworksService_CreateComplianceFrameworkErrors(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/graphql", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"data": {"createComplianceFramework": {"errors": ["Name has already been taken"], "framework": null}}}`)
+	})
+
+	_, _, err := client.ComplianceFrameworks.CreateComplianceFramework("my-group", &ComplianceFrameworkOptions{
+		Name:  Ptr("SOX"),
+		Color: Ptr("#1aaa55"),
+	})
+	require.EqualError(t, err, "graphql: Name has already been taken")
+}
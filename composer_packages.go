@@ -0,0 +1,101 @@
+package gitlab
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// ComposerPackagesService handles communication with the Composer package
+// registry related methods of the GitLab API.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/packages/composer.html
+type ComposerPackagesService struct {
+	client *Client
+}
+
+// ComposerPackageVersion represents a single version of a Composer package.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/packages/composer.html#v2-package-metadata
+type ComposerPackageVersion struct {
+	Name    string                 `json:"name"`
+	Version string                 `json:"version"`
+	Type    string                 `json:"type"`
+	License []string               `json:"license"`
+	Require map[string]string      `json:"require"`
+	Dist    *ComposerPackageSource `json:"dist"`
+	Source  *ComposerPackageSource `json:"source"`
+	UID     int                    `json:"uid"`
+}
+
+// ComposerPackageSource describes where a Composer package version can be
+// downloaded from.
+type ComposerPackageSource struct {
+	Type      string `json:"type"`
+	URL       string `json:"url"`
+	Reference string `json:"reference"`
+	Shasum    string `json:"shasum"`
+}
+
+func (v ComposerPackageVersion) String() string {
+	return Stringify(v)
+}
+
+// GetPackageMetadata gets the versions of a Composer package in the registry
+// of a group. The package name includes its vendor, for example
+// "my-vendor/my-package".
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/packages/composer.html#v2-package-metadata
+func (s *ComposerPackagesService) GetPackageMetadata(gid interface{}, packageName string, options ...RequestOptionFunc) ([]*ComposerPackageVersion, *Response, error) {
+	group, err := parseGroupID(gid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("group/%s/-/packages/composer/p2/%s.json", PathEscape(group), PathEscape(packageName))
+
+	req, err := s.client.NewRequest(http.MethodGet, u, nil, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var m struct {
+		Packages map[string][]*ComposerPackageVersion `json:"packages"`
+	}
+	resp, err := s.client.Do(req, &m)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return m.Packages[packageName], resp, nil
+}
+
+// PublishComposerPackageOptions represents the available PublishPackage()
+// options. Exactly one of Tag or Branch must be set.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/packages/composer.html#create-a-package
+type PublishComposerPackageOptions struct {
+	Tag    *string `url:"tag,omitempty" json:"tag,omitempty"`
+	Branch *string `url:"branch,omitempty" json:"branch,omitempty"`
+}
+
+// PublishPackage creates a Composer package from a tag or branch of a
+// project.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/packages/composer.html#create-a-package
+func (s *ComposerPackagesService) PublishPackage(pid interface{}, opt *PublishComposerPackageOptions, options ...RequestOptionFunc) (*Response, error) {
+	project, err := parseProjectID(pid)
+	if err != nil {
+		return nil, err
+	}
+	u := fmt.Sprintf("projects/%s/packages/composer", PathEscape(project))
+
+	req, err := s.client.NewRequest(http.MethodPost, u, opt, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, nil)
+}
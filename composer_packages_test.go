@@ -0,0 +1,41 @@
+package gitlab
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestComposerPackagesService_GetPackageMetadata(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/group/1/-/packages/composer/p2/my-vendor/my-package.json", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"packages": {"my-vendor/my-package": [{
+			"name": "my-vendor/my-package",
+			"version": "1.0.0",
+			"dist": {"type": "zip", "url": "https://example.com/archive.zip", "reference": "abc", "shasum": ""}
+		}]}}`)
+	})
+
+	versions, _, err := client.ComposerPackages.GetPackageMetadata(1, "my-vendor/my-package")
+	require.NoError(t, err)
+	require.Len(t, versions, 1)
+	require.Equal(t, "1.0.0", versions[0].Version)
+	require.Equal(t, "zip", versions[0].Dist.Type)
+}
+
+func TestComposerPackagesService_PublishPackage(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/packages/composer", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testBody(t, r, `{"tag":"v1.0.0"}`)
+		w.WriteHeader(http.StatusCreated)
+	})
+
+	_, err := client.ComposerPackages.PublishPackage(1, &PublishComposerPackageOptions{Tag: Ptr("v1.0.0")})
+	require.NoError(t, err)
+}
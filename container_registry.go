@@ -19,6 +19,9 @@ package gitlab
 import (
 	"fmt"
 	"net/http"
+	"net/url"
+	"regexp"
+	"sort"
 	"time"
 )
 
@@ -44,6 +47,7 @@ type RegistryRepository struct {
 	Status                 *ContainerRegistryStatus `json:"status"`
 	TagsCount              int                      `json:"tags_count"`
 	Tags                   []*RegistryRepositoryTag `json:"tags"`
+	Size                   int                      `json:"size"`
 }
 
 func (s RegistryRepository) String() string {
@@ -86,7 +90,7 @@ type ListRegistryRepositoriesOptions struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/container_registry.html#within-a-project
 func (s *ContainerRegistryService) ListProjectRegistryRepositories(pid interface{}, opt *ListRegistryRepositoriesOptions, options ...RequestOptionFunc) ([]*RegistryRepository, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -111,7 +115,7 @@ func (s *ContainerRegistryService) ListProjectRegistryRepositories(pid interface
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/container_registry.html#within-a-group
 func (s *ContainerRegistryService) ListGroupRegistryRepositories(gid interface{}, opt *ListRegistryRepositoriesOptions, options ...RequestOptionFunc) ([]*RegistryRepository, *Response, error) {
-	group, err := parseID(gid)
+	group, err := parseGroupID(gid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -139,6 +143,7 @@ func (s *ContainerRegistryService) ListGroupRegistryRepositories(gid interface{}
 type GetSingleRegistryRepositoryOptions struct {
 	Tags      *bool `url:"tags,omitempty" json:"tags,omitempty"`
 	TagsCount *bool `url:"tags_count,omitempty" json:"tags_count,omitempty"`
+	Size      *bool `url:"size,omitempty" json:"size,omitempty"`
 }
 
 // GetSingleRegistryRepository gets the details of single registry repository.
@@ -146,7 +151,7 @@ type GetSingleRegistryRepositoryOptions struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/container_registry.html#get-details-of-a-single-repository
 func (s *ContainerRegistryService) GetSingleRegistryRepository(pid interface{}, opt *GetSingleRegistryRepositoryOptions, options ...RequestOptionFunc) (*RegistryRepository, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -171,7 +176,7 @@ func (s *ContainerRegistryService) GetSingleRegistryRepository(pid interface{},
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/container_registry.html#delete-registry-repository
 func (s *ContainerRegistryService) DeleteRegistryRepository(pid interface{}, repository int, options ...RequestOptionFunc) (*Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, err
 	}
@@ -197,7 +202,7 @@ type ListRegistryRepositoryTagsOptions ListOptions
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/container_registry.html#list-registry-repository-tags
 func (s *ContainerRegistryService) ListRegistryRepositoryTags(pid interface{}, repository int, opt *ListRegistryRepositoryTagsOptions, options ...RequestOptionFunc) ([]*RegistryRepositoryTag, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -225,14 +230,14 @@ func (s *ContainerRegistryService) ListRegistryRepositoryTags(pid interface{}, r
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/container_registry.html#get-details-of-a-registry-repository-tag
 func (s *ContainerRegistryService) GetRegistryRepositoryTagDetail(pid interface{}, repository int, tagName string, options ...RequestOptionFunc) (*RegistryRepositoryTag, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
 	u := fmt.Sprintf("projects/%s/registry/repositories/%d/tags/%s",
 		PathEscape(project),
 		repository,
-		tagName,
+		url.PathEscape(tagName),
 	)
 
 	req, err := s.client.NewRequest(http.MethodGet, u, nil, options)
@@ -254,14 +259,14 @@ func (s *ContainerRegistryService) GetRegistryRepositoryTagDetail(pid interface{
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/container_registry.html#delete-a-registry-repository-tag
 func (s *ContainerRegistryService) DeleteRegistryRepositoryTag(pid interface{}, repository int, tagName string, options ...RequestOptionFunc) (*Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, err
 	}
 	u := fmt.Sprintf("projects/%s/registry/repositories/%d/tags/%s",
 		PathEscape(project),
 		repository,
-		tagName,
+		url.PathEscape(tagName),
 	)
 
 	req, err := s.client.NewRequest(http.MethodDelete, u, nil, options)
@@ -293,7 +298,7 @@ type DeleteRegistryRepositoryTagsOptions struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/container_registry.html#delete-registry-repository-tags-in-bulk
 func (s *ContainerRegistryService) DeleteRegistryRepositoryTags(pid interface{}, repository int, opt *DeleteRegistryRepositoryTagsOptions, options ...RequestOptionFunc) (*Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, err
 	}
@@ -309,3 +314,153 @@ func (s *ContainerRegistryService) DeleteRegistryRepositoryTags(pid interface{},
 
 	return s.client.Do(req, nil)
 }
+
+// CleanupRepositoryOptions represents the available CleanupRepository()
+// options.
+type CleanupRepositoryOptions struct {
+	// NameRegexpDelete selects the tags to delete. Tag names must fully
+	// match the expression.
+	NameRegexpDelete *regexp.Regexp
+
+	// NameRegexpKeep selects tags that are never deleted, even if they match
+	// NameRegexpDelete.
+	NameRegexpKeep *regexp.Regexp
+
+	// KeepN is the number of most recent matching tags to keep.
+	KeepN int
+
+	// OlderThan restricts deletion to tags created longer ago than the given
+	// duration.
+	OlderThan time.Duration
+
+	// DryRun only reports the tags that would be deleted.
+	DryRun bool
+
+	// Progress, if set, is called after each tag selected for deletion is
+	// processed.
+	Progress func(CleanupProgress)
+}
+
+// CleanupProgress describes the progress of a CleanupRepository() call. Err
+// is set if the tag could not be deleted.
+type CleanupProgress struct {
+	Tag       *RegistryRepositoryTag
+	Err       error
+	Processed int
+	Total     int
+}
+
+// CleanupRepositoryResult represents the result of a CleanupRepository() call.
+// Failed contains the tags that were selected for deletion, but could not be
+// deleted.
+type CleanupRepositoryResult struct {
+	Deleted []*RegistryRepositoryTag
+	Kept    []*RegistryRepositoryTag
+	Failed  []*BulkItemError[*RegistryRepositoryTag]
+}
+
+// CleanupRepository deletes the tags of a registry repository matching the
+// given criteria. Unlike DeleteRegistryRepositoryTags, which schedules the
+// deletion in the background, the tags are listed and deleted one by one so
+// the caller can follow the progress and knows exactly which tags are removed.
+// A failure to delete a single tag does not abort the cleanup, but is reported
+// in the result.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/container_registry.html#delete-a-registry-repository-tag
+func (s *ContainerRegistryService) CleanupRepository(pid interface{}, repository int, opt *CleanupRepositoryOptions, options ...RequestOptionFunc) (*CleanupRepositoryResult, error) {
+	if opt == nil || opt.NameRegexpDelete == nil {
+		return nil, fmt.Errorf("NameRegexpDelete is required")
+	}
+	deleteRe := anchorRegexp(opt.NameRegexpDelete)
+
+	var keepRe *regexp.Regexp
+	if opt.NameRegexpKeep != nil {
+		keepRe = anchorRegexp(opt.NameRegexpKeep)
+	}
+
+	result := new(CleanupRepositoryResult)
+
+	var candidates []*RegistryRepositoryTag
+	listOpt := &ListRegistryRepositoryTagsOptions{PerPage: 100, Page: 1}
+	for {
+		tags, resp, err := s.ListRegistryRepositoryTags(pid, repository, listOpt, options...)
+		if err != nil {
+			return result, err
+		}
+
+		for _, tag := range tags {
+			if !deleteRe.MatchString(tag.Name) || (keepRe != nil && keepRe.MatchString(tag.Name)) {
+				result.Kept = append(result.Kept, tag)
+				continue
+			}
+			candidates = append(candidates, tag)
+		}
+
+		if resp.NextPage == 0 {
+			break
+		}
+		listOpt.Page = resp.NextPage
+	}
+
+	// The tags list does not contain the creation date, so retrieve the tag
+	// details when the selection depends on it.
+	if opt.KeepN > 0 || opt.OlderThan > 0 {
+		for i, tag := range candidates {
+			detail, _, err := s.GetRegistryRepositoryTagDetail(pid, repository, tag.Name, options...)
+			if err != nil {
+				return result, err
+			}
+			candidates[i] = detail
+		}
+
+		// Sort the tags from newest to oldest.
+		sort.SliceStable(candidates, func(i, j int) bool {
+			return tagCreatedAt(candidates[i]).After(tagCreatedAt(candidates[j]))
+		})
+	}
+
+	var toDelete []*RegistryRepositoryTag
+	for i, tag := range candidates {
+		if i < opt.KeepN {
+			result.Kept = append(result.Kept, tag)
+			continue
+		}
+		if opt.OlderThan > 0 && time.Since(tagCreatedAt(tag)) < opt.OlderThan {
+			result.Kept = append(result.Kept, tag)
+			continue
+		}
+		toDelete = append(toDelete, tag)
+	}
+
+	for i, tag := range toDelete {
+		var err error
+		if !opt.DryRun {
+			_, err = s.DeleteRegistryRepositoryTag(pid, repository, tag.Name, options...)
+		}
+		if err != nil {
+			result.Failed = append(result.Failed, &BulkItemError[*RegistryRepositoryTag]{Item: tag, Err: err})
+		} else {
+			result.Deleted = append(result.Deleted, tag)
+		}
+
+		if opt.Progress != nil {
+			opt.Progress(CleanupProgress{Tag: tag, Err: err, Processed: i + 1, Total: len(toDelete)})
+		}
+	}
+
+	return result, nil
+}
+
+// anchorRegexp makes sure the expression needs to match the full input, like
+// the name_regex_delete and name_regex_keep attributes of the API.
+func anchorRegexp(re *regexp.Regexp) *regexp.Regexp {
+	return regexp.MustCompile(`^(?:` + re.String() + `)$`)
+}
+
+func tagCreatedAt(tag *RegistryRepositoryTag) time.Time {
+	if tag.CreatedAt == nil {
+		return time.Time{}
+	}
+	return *tag.CreatedAt
+}
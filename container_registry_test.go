@@ -17,9 +17,11 @@
 package gitlab
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
 	"reflect"
+	"regexp"
 	"testing"
 	"time"
 )
@@ -390,3 +392,103 @@ func TestDeleteRegistryRepositoryTags(t *testing.T) {
 		})
 	}
 }
+
+func TestCleanupRepository(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/5/registry/repositories/2/tags", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		if r.URL.Query().Get("page") == "1" {
+			w.Header().Set("X-Next-Page", "2")
+			fmt.Fprint(w, `[{"name": "latest"}, {"name": "v1.0.0"}, {"name": "v1.1.0"}]`)
+			return
+		}
+		fmt.Fprint(w, `[{"name": "v1.2.0"}, {"name": "v2.0.0"}]`)
+	})
+
+	created := map[string]string{
+		"v1.0.0": "2020-01-01T00:00:00Z",
+		"v1.1.0": "2020-02-01T00:00:00Z",
+		"v1.2.0": "2020-03-01T00:00:00Z",
+	}
+	var deleted []string
+	for name, createdAt := range created {
+		name, createdAt := name, createdAt
+		mux.HandleFunc("/api/v4/projects/5/registry/repositories/2/tags/"+name, func(w http.ResponseWriter, r *http.Request) {
+			switch r.Method {
+			case http.MethodGet:
+				fmt.Fprintf(w, `{"name": %q, "created_at": %q}`, name, createdAt)
+			case http.MethodDelete:
+				deleted = append(deleted, name)
+			default:
+				t.Errorf("Unexpected request method: %s", r.Method)
+			}
+		})
+	}
+
+	var progress []CleanupProgress
+	result, err := client.ContainerRegistry.CleanupRepository(5, 2, &CleanupRepositoryOptions{
+		NameRegexpDelete: regexp.MustCompile(`v.*`),
+		NameRegexpKeep:   regexp.MustCompile(`v2\..*`),
+		KeepN:            1,
+		Progress: func(p CleanupProgress) {
+			progress = append(progress, p)
+		},
+	})
+	if err != nil {
+		t.Fatalf("ContainerRegistry.CleanupRepository returned error: %v", err)
+	}
+
+	wantDeleted := []string{"v1.1.0", "v1.0.0"}
+	if !reflect.DeepEqual(wantDeleted, deleted) {
+		t.Errorf("ContainerRegistry.CleanupRepository deleted %v, want %v", deleted, wantDeleted)
+	}
+
+	var gotDeleted []string
+	for _, tag := range result.Deleted {
+		gotDeleted = append(gotDeleted, tag.Name)
+	}
+	if !reflect.DeepEqual(wantDeleted, gotDeleted) {
+		t.Errorf("ContainerRegistry.CleanupRepository returned deleted %v, want %v", gotDeleted, wantDeleted)
+	}
+	if len(result.Kept) != 3 {
+		t.Errorf("ContainerRegistry.CleanupRepository returned %d kept tags, want 3", len(result.Kept))
+	}
+
+	if len(progress) != 2 || progress[1].Processed != 2 || progress[1].Total != 2 {
+		t.Errorf("ContainerRegistry.CleanupRepository reported progress %+v", progress)
+	}
+}
+
+func TestCleanupRepositoryPartialFailure(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/5/registry/repositories/2/tags", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `[{"name": "a"}, {"name": "b"}]`)
+	})
+	mux.HandleFunc("/api/v4/projects/5/registry/repositories/2/tags/a", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodDelete)
+		w.WriteHeader(http.StatusForbidden)
+	})
+	mux.HandleFunc("/api/v4/projects/5/registry/repositories/2/tags/b", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodDelete)
+	})
+
+	result, err := client.ContainerRegistry.CleanupRepository(5, 2, &CleanupRepositoryOptions{
+		NameRegexpDelete: regexp.MustCompile(`.*`),
+	})
+	if err != nil {
+		t.Fatalf("ContainerRegistry.CleanupRepository returned error: %v", err)
+	}
+
+	if len(result.Deleted) != 1 || result.Deleted[0].Name != "b" {
+		t.Errorf("ContainerRegistry.CleanupRepository returned deleted %v, want [b]", result.Deleted)
+	}
+	if len(result.Failed) != 1 || result.Failed[0].Item.Name != "a" {
+		t.Fatalf("ContainerRegistry.CleanupRepository returned failed %v, want [a]", result.Failed)
+	}
+	if !errors.Is(result.Failed[0], ErrForbidden) {
+		t.Errorf("ContainerRegistry.CleanupRepository returned error %v, want %v", result.Failed[0].Err, ErrForbidden)
+	}
+}
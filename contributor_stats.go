@@ -0,0 +1,161 @@
+package gitlab
+
+import (
+	"bufio"
+	"sort"
+	"strings"
+	"time"
+)
+
+// ContributorIdentityFunc maps the name and email address of a commit author
+// to the canonical identity of the contributor, like a .mailmap file does.
+type ContributorIdentityFunc func(name, email string) (canonicalName, canonicalEmail string)
+
+// MailmapIdentities returns a ContributorIdentityFunc which maps identities
+// using the given mailmap, in the format of a Git .mailmap file. Authors not
+// in the mailmap keep their identity.
+//
+// Git docs: https://git-scm.com/docs/gitmailmap
+func MailmapIdentities(mailmap string) ContributorIdentityFunc {
+	type entry struct{ name, email string }
+	byEmail := make(map[string]entry)
+	byNameEmail := make(map[string]entry)
+
+	sc := bufio.NewScanner(strings.NewReader(mailmap))
+	for sc.Scan() {
+		line := sc.Text()
+		if i := strings.Index(line, "#"); i >= 0 {
+			line = line[:i]
+		}
+
+		// A line contains one or two "Name <email>" pairs, of which the
+		// names are optional: the proper identity, followed by the commit
+		// identity if it differs in more than the name.
+		var names, emails []string
+		for {
+			open := strings.Index(line, "<")
+			end := strings.Index(line, ">")
+			if open < 0 || end < open {
+				break
+			}
+			names = append(names, strings.TrimSpace(line[:open]))
+			emails = append(emails, strings.ToLower(strings.TrimSpace(line[open+1:end])))
+			line = line[end+1:]
+		}
+
+		switch len(emails) {
+		case 1:
+			e := byEmail[emails[0]]
+			e.name = names[0]
+			byEmail[emails[0]] = e
+		case 2:
+			e := entry{name: names[0], email: emails[0]}
+			if names[1] != "" {
+				byNameEmail[strings.ToLower(names[1])+"\x00"+emails[1]] = e
+			} else {
+				byEmail[emails[1]] = e
+			}
+		}
+	}
+
+	return func(name, email string) (string, string) {
+		key := strings.ToLower(email)
+		e, ok := byNameEmail[strings.ToLower(name)+"\x00"+key]
+		if !ok {
+			e = byEmail[key]
+		}
+		if e.name != "" {
+			name = e.name
+		}
+		if e.email != "" {
+			email = e.email
+		}
+		return name, email
+	}
+}
+
+// GetContributorStatsOptions represents the available GetContributorStats()
+// options.
+type GetContributorStatsOptions struct {
+	// RefName is the branch or tag to get the contributors of. Defaults to
+	// the default branch.
+	RefName *string
+
+	// Since and Until limit the stats to the commits committed in a time
+	// range.
+	Since *time.Time
+	Until *time.Time
+
+	// Identity maps the authors of commits to contributors. Authors with the
+	// same canonical email address, ignoring case, are counted as one
+	// contributor. See MailmapIdentities.
+	Identity ContributorIdentityFunc
+}
+
+// GetContributorStats gets the commit, addition and deletion counts of the
+// contributors of a repository, sorted by number of commits. Unlike
+// Contributors, the stats can be limited to a time range and authors can be
+// mapped to contributors. All commits in the range are listed, so this can
+// take a while for large repositories.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/commits.html#list-repository-commits
+func (s *RepositoriesService) GetContributorStats(pid interface{}, opt *GetContributorStatsOptions, options ...RequestOptionFunc) ([]*Contributor, error) {
+	if opt == nil {
+		opt = new(GetContributorStatsOptions)
+	}
+
+	copt := &ListCommitsOptions{
+		ListOptions: ListOptions{PerPage: 100},
+		RefName:     opt.RefName,
+		Since:       opt.Since,
+		Until:       opt.Until,
+		WithStats:   Ptr(true),
+	}
+
+	var contributors []*Contributor
+	byKey := make(map[string]*Contributor)
+
+	for {
+		commits, resp, err := s.client.Commits.ListCommits(pid, copt, options...)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, c := range commits {
+			name, email := c.AuthorName, c.AuthorEmail
+			if opt.Identity != nil {
+				name, email = opt.Identity(name, email)
+			}
+			key := strings.ToLower(email)
+			if key == "" {
+				key = name
+			}
+
+			// Commits are listed newest first, so contributors keep the
+			// identity of their latest commit.
+			ct, ok := byKey[key]
+			if !ok {
+				ct = &Contributor{Name: name, Email: email}
+				byKey[key] = ct
+				contributors = append(contributors, ct)
+			}
+			ct.Commits++
+			if c.Stats != nil {
+				ct.Additions += c.Stats.Additions
+				ct.Deletions += c.Stats.Deletions
+			}
+		}
+
+		if resp.NextPage == 0 {
+			break
+		}
+		copt.Page = resp.NextPage
+	}
+
+	sort.SliceStable(contributors, func(i, j int) bool {
+		return contributors[i].Commits > contributors[j].Commits
+	})
+
+	return contributors, nil
+}
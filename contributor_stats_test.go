@@ -0,0 +1,66 @@
+package gitlab
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMailmapIdentities(t *testing.T) {
+	identity := MailmapIdentities(`
+# Comments are ignored
+Jane Doe <jane@example.com>
+<jane@example.com> <jane@old.example.com>
+Jane Doe <jane@example.com> jd <root@localhost>
+Joe Developer <joe@example.com> <JOE@laptop.local>
+`)
+
+	tests := []struct{ name, email, wantName, wantEmail string }{
+		{"jane", "jane@example.com", "Jane Doe", "jane@example.com"},
+		{"Jane", "jane@old.example.com", "Jane", "jane@example.com"},
+		{"jd", "root@localhost", "Jane Doe", "jane@example.com"},
+		{"other", "root@localhost", "other", "root@localhost"},
+		{"joe", "joe@Laptop.local", "Joe Developer", "joe@example.com"},
+		{"Someone", "someone@example.com", "Someone", "someone@example.com"},
+	}
+	for _, tt := range tests {
+		name, email := identity(tt.name, tt.email)
+		require.Equal(t, tt.wantName, name, tt.email)
+		require.Equal(t, tt.wantEmail, email, tt.email)
+	}
+}
+
+func TestGetContributorStats(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/repository/commits", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		q := r.URL.Query()
+		require.Equal(t, "true", q.Get("with_stats"))
+		require.Equal(t, "2024-01-01T00:00:00Z", q.Get("since"))
+
+		if q.Get("page") == "2" {
+			fmt.Fprint(w, `[{"author_name": "joe", "author_email": "joe@example.com", "stats": {"additions": 1, "deletions": 1}}]`)
+			return
+		}
+		w.Header().Set("X-Next-Page", "2")
+		fmt.Fprint(w, `[
+			{"author_name": "Jane Doe", "author_email": "jane@example.com", "stats": {"additions": 10, "deletions": 2}},
+			{"author_name": "jane", "author_email": "jane@old.example.com", "stats": {"additions": 5, "deletions": 0}},
+			{"author_name": "Jane", "author_email": "JANE@example.com", "stats": {"additions": 1, "deletions": 1}}
+		]`)
+	})
+
+	stats, err := client.Repositories.GetContributorStats(1, &GetContributorStatsOptions{
+		Since:    Ptr(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)),
+		Identity: MailmapIdentities(`<jane@example.com> <jane@old.example.com>`),
+	})
+	require.NoError(t, err)
+	require.Equal(t, []*Contributor{
+		{Name: "Jane Doe", Email: "jane@example.com", Commits: 3, Additions: 16, Deletions: 3},
+		{Name: "joe", Email: "joe@example.com", Commits: 1, Additions: 1, Deletions: 1},
+	}, stats)
+}
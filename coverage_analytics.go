@@ -0,0 +1,209 @@
+package gitlab
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// PipelineCoverage represents the test coverage reported by a pipeline.
+type PipelineCoverage struct {
+	PipelineID int
+	SHA        string
+	Ref        string
+	CreatedAt  *time.Time
+
+	// Coverage is the coverage percentage.
+	Coverage float64
+}
+
+// GetCoverageHistoryOptions represents the available GetCoverageHistory()
+// options.
+type GetCoverageHistoryOptions struct {
+	// Ref limits the history to the pipelines of a branch or tag, like the
+	// default branch.
+	Ref *string
+
+	// UpdatedAfter and UpdatedBefore limit the history to the pipelines
+	// updated in a time range.
+	UpdatedAfter  *time.Time
+	UpdatedBefore *time.Time
+}
+
+// GetCoverageHistory gets the test coverage of the successful pipelines of a
+// project, oldest first, for coverage trend charts. Pipelines which do not
+// report coverage are skipped. The coverage is only included in the details
+// of a pipeline, so every pipeline is requested separately; use the options
+// to limit the number of pipelines.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/pipelines.html#list-project-pipelines
+// https://docs.gitlab.com/ee/api/pipelines.html#get-a-single-pipeline
+func (s *PipelinesService) GetCoverageHistory(pid interface{}, opt *GetCoverageHistoryOptions, options ...RequestOptionFunc) ([]*PipelineCoverage, error) {
+	if opt == nil {
+		opt = new(GetCoverageHistoryOptions)
+	}
+
+	lopt := &ListProjectPipelinesOptions{
+		ListOptions:   ListOptions{PerPage: 100},
+		Status:        Ptr(Success),
+		Ref:           opt.Ref,
+		UpdatedAfter:  opt.UpdatedAfter,
+		UpdatedBefore: opt.UpdatedBefore,
+		OrderBy:       Ptr("id"),
+		Sort:          Ptr("asc"),
+	}
+
+	var history []*PipelineCoverage
+	for {
+		pipelines, resp, err := s.ListProjectPipelines(pid, lopt, options...)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, pi := range pipelines {
+			p, _, err := s.GetPipeline(pid, pi.ID, options...)
+			if err != nil {
+				return nil, err
+			}
+			if p.Coverage == "" {
+				continue
+			}
+			coverage, err := strconv.ParseFloat(p.Coverage, 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid coverage %q of pipeline %d: %w", p.Coverage, p.ID, err)
+			}
+			history = append(history, &PipelineCoverage{
+				PipelineID: p.ID,
+				SHA:        p.SHA,
+				Ref:        p.Ref,
+				CreatedAt:  p.CreatedAt,
+				Coverage:   coverage,
+			})
+		}
+
+		if resp.NextPage == 0 {
+			break
+		}
+		lopt.Page = resp.NextPage
+	}
+
+	return history, nil
+}
+
+// CodeCoverageSummary represents the latest test coverage of the jobs of the
+// default branch of a project.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/graphql/reference/#codecoveragesummary
+type CodeCoverageSummary struct {
+	AverageCoverage float64  `json:"averageCoverage"`
+	CoverageCount   int      `json:"coverageCount"`
+	LastUpdatedOn   *ISOTime `json:"lastUpdatedOn"`
+}
+
+// GetCodeCoverageSummary gets the latest test coverage of the default branch
+// of a project.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/graphql/reference/#projectcodecoveragesummary
+func (s *ProjectsService) GetCodeCoverageSummary(pid interface{}, options ...RequestOptionFunc) (*CodeCoverageSummary, *Response, error) {
+	fullPath, err := s.client.projectFullPath(pid, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	q := GraphQLQuery{
+		Query: `query($fullPath: ID!) {
+			project(fullPath: $fullPath) {
+				codeCoverageSummary { averageCoverage coverageCount lastUpdatedOn }
+			}
+		}`,
+		Variables: map[string]interface{}{"fullPath": fullPath},
+	}
+
+	var data struct {
+		Project *struct {
+			Summary *CodeCoverageSummary `json:"codeCoverageSummary"`
+		} `json:"project"`
+	}
+	resp, err := s.client.GraphQL(q, &data, options...)
+	if err != nil {
+		return nil, resp, err
+	}
+	if data.Project == nil || data.Project.Summary == nil {
+		return nil, resp, ErrNotFound
+	}
+
+	return data.Project.Summary, resp, nil
+}
+
+// CodeCoverageActivity represents the daily test coverage of the projects of
+// a group, as shown in the repository analytics of the group.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/graphql/reference/#codecoverageactivity
+type CodeCoverageActivity struct {
+	Date            *ISOTime `json:"date"`
+	AverageCoverage float64  `json:"averageCoverage"`
+	CoverageCount   int      `json:"coverageCount"`
+	ProjectCount    int      `json:"projectCount"`
+}
+
+// ListCodeCoverageActivities gets the daily test coverage of the projects of
+// a group since the given date.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/graphql/reference/#groupcodecoverageactivities
+func (s *GroupsService) ListCodeCoverageActivities(gid interface{}, startDate ISOTime, options ...RequestOptionFunc) ([]*CodeCoverageActivity, *Response, error) {
+	fullPath, err := s.client.groupFullPath(gid, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var activities []*CodeCoverageActivity
+	var after interface{}
+
+	for {
+		q := GraphQLQuery{
+			Query: `query($fullPath: ID!, $startDate: Date!, $after: String) {
+				group(fullPath: $fullPath) {
+					codeCoverageActivities(startDate: $startDate, after: $after) {
+						nodes { date averageCoverage coverageCount projectCount }
+						pageInfo { hasNextPage endCursor }
+					}
+				}
+			}`,
+			Variables: map[string]interface{}{
+				"fullPath":  fullPath,
+				"startDate": startDate.String(),
+				"after":     after,
+			},
+		}
+
+		var data struct {
+			Group *struct {
+				Activities struct {
+					Nodes    []*CodeCoverageActivity `json:"nodes"`
+					PageInfo struct {
+						HasNextPage bool   `json:"hasNextPage"`
+						EndCursor   string `json:"endCursor"`
+					} `json:"pageInfo"`
+				} `json:"codeCoverageActivities"`
+			} `json:"group"`
+		}
+		resp, err := s.client.GraphQL(q, &data, options...)
+		if err != nil {
+			return nil, resp, err
+		}
+		if data.Group == nil {
+			return nil, resp, ErrNotFound
+		}
+
+		activities = append(activities, data.Group.Activities.Nodes...)
+		if !data.Group.Activities.PageInfo.HasNextPage {
+			return activities, resp, nil
+		}
+		after = data.Group.Activities.PageInfo.EndCursor
+	}
+}
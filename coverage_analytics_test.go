@@ -0,0 +1,86 @@
+package gitlab
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetCoverageHistory(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/pipelines", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		q := r.URL.Query()
+		require.Equal(t, "success", q.Get("status"))
+		require.Equal(t, "main", q.Get("ref"))
+		require.Equal(t, "asc", q.Get("sort"))
+		fmt.Fprint(w, `[{"id": 1}, {"id": 2}, {"id": 3}]`)
+	})
+	mux.HandleFunc("/api/v4/projects/1/pipelines/1", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"id": 1, "sha": "a1", "ref": "main", "created_at": "2024-01-01T00:00:00Z", "coverage": "80.5"}`)
+	})
+	mux.HandleFunc("/api/v4/projects/1/pipelines/2", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"id": 2, "sha": "b2", "ref": "main", "coverage": null}`)
+	})
+	mux.HandleFunc("/api/v4/projects/1/pipelines/3", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"id": 3, "sha": "c3", "ref": "main", "coverage": "82"}`)
+	})
+
+	history, err := client.Pipelines.GetCoverageHistory(1, &GetCoverageHistoryOptions{Ref: Ptr("main")})
+	require.NoError(t, err)
+	require.Equal(t, []*PipelineCoverage{
+		{PipelineID: 1, SHA: "a1", Ref: "main", CreatedAt: Ptr(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)), Coverage: 80.5},
+		{PipelineID: 3, SHA: "c3", Ref: "main", Coverage: 82},
+	}, history)
+}
+
+func TestGetCodeCoverageSummary(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/graphql", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		var q GraphQLQuery
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&q))
+		require.Equal(t, "group/project", q.Variables["fullPath"])
+		fmt.Fprint(w, `{"data": {"project": {"codeCoverageSummary": {
+			"averageCoverage": 77.5, "coverageCount": 2, "lastUpdatedOn": "2024-03-01"
+		}}}}`)
+	})
+
+	summary, _, err := client.Projects.GetCodeCoverageSummary("group/project")
+	require.NoError(t, err)
+	lastUpdatedOn := ISOTime(time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC))
+	require.Equal(t, &CodeCoverageSummary{AverageCoverage: 77.5, CoverageCount: 2, LastUpdatedOn: &lastUpdatedOn}, summary)
+}
+
+func TestListCodeCoverageActivities(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/groups/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"id": 1, "full_path": "my-group"}`)
+	})
+	mux.HandleFunc("/api/graphql", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		var q GraphQLQuery
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&q))
+		require.Equal(t, "my-group", q.Variables["fullPath"])
+		require.Equal(t, "2024-03-01", q.Variables["startDate"])
+		fmt.Fprint(w, `{"data": {"group": {"codeCoverageActivities": {
+			"nodes": [{"date": "2024-03-01", "averageCoverage": 75, "coverageCount": 4, "projectCount": 2}],
+			"pageInfo": {"hasNextPage": false}
+		}}}}`)
+	})
+
+	startDate := ISOTime(time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC))
+	activities, _, err := client.Groups.ListCodeCoverageActivities(1, startDate)
+	require.NoError(t, err)
+	require.Equal(t, []*CodeCoverageActivity{
+		{Date: &startDate, AverageCoverage: 75, CoverageCount: 4, ProjectCount: 2},
+	}, activities)
+}
@@ -0,0 +1,245 @@
+package gitlab
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// CustomEmojiService handles communication with the custom emoji related
+// methods of the GitLab GraphQL API, and with the emoji catalogue of the
+// GitLab instance.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/graphql/custom_emoji.html
+type CustomEmojiService struct {
+	client *Client
+}
+
+// CustomEmoji represents a custom emoji of a group.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/graphql/reference/#customemoji
+type CustomEmoji struct {
+	ID        string     `json:"id"`
+	Name      string     `json:"name"`
+	URL       string     `json:"url"`
+	External  bool       `json:"external"`
+	CreatedAt *time.Time `json:"createdAt"`
+}
+
+func (e CustomEmoji) String() string {
+	return Stringify(e)
+}
+
+const customEmojiFields = `id name url external createdAt`
+
+// ListGroupCustomEmoji gets the custom emoji of a group, including the custom
+// emoji of its parent groups.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/graphql/custom_emoji.html#use-graphql-queries
+func (s *CustomEmojiService) ListGroupCustomEmoji(gid interface{}, options ...RequestOptionFunc) ([]*CustomEmoji, *Response, error) {
+	groupPath, err := s.client.groupFullPath(gid, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var emoji []*CustomEmoji
+	var after interface{}
+
+	for {
+		q := GraphQLQuery{
+			Query: `query($fullPath: ID!, $after: String) {
+				group(fullPath: $fullPath) {
+					customEmoji(includeAncestorGroups: true, after: $after) {
+						nodes { ` + customEmojiFields + ` }
+						pageInfo { hasNextPage endCursor }
+					}
+				}
+			}`,
+			Variables: map[string]interface{}{"fullPath": groupPath, "after": after},
+		}
+
+		var data struct {
+			Group *struct {
+				CustomEmoji struct {
+					Nodes    []*CustomEmoji `json:"nodes"`
+					PageInfo struct {
+						HasNextPage bool   `json:"hasNextPage"`
+						EndCursor   string `json:"endCursor"`
+					} `json:"pageInfo"`
+				} `json:"customEmoji"`
+			} `json:"group"`
+		}
+		resp, err := s.client.GraphQL(q, &data, options...)
+		if err != nil {
+			return nil, resp, err
+		}
+		if data.Group == nil {
+			return nil, resp, ErrNotFound
+		}
+
+		emoji = append(emoji, data.Group.CustomEmoji.Nodes...)
+		if !data.Group.CustomEmoji.PageInfo.HasNextPage {
+			return emoji, resp, nil
+		}
+		after = data.Group.CustomEmoji.PageInfo.EndCursor
+	}
+}
+
+// CreateCustomEmojiOptions represents the available CreateCustomEmoji()
+// options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/graphql/reference/#mutationcreatecustomemoji
+type CreateCustomEmojiOptions struct {
+	Name *string `json:"name,omitempty"`
+	URL  *string `json:"url,omitempty"`
+}
+
+// CreateCustomEmoji creates a custom emoji for a group.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/graphql/reference/#mutationcreatecustomemoji
+func (s *CustomEmojiService) CreateCustomEmoji(gid interface{}, opt *CreateCustomEmojiOptions, options ...RequestOptionFunc) (*CustomEmoji, *Response, error) {
+	groupPath, err := s.client.groupFullPath(gid, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	input := map[string]interface{}{"groupPath": groupPath}
+	if opt != nil {
+		if opt.Name != nil {
+			input["name"] = *opt.Name
+		}
+		if opt.URL != nil {
+			input["url"] = *opt.URL
+		}
+	}
+
+	q := GraphQLQuery{
+		Query: `mutation($input: CreateCustomEmojiInput!) {
+			createCustomEmoji(input: $input) {
+				errors
+				customEmoji { ` + customEmojiFields + ` }
+			}
+		}`,
+		Variables: map[string]interface{}{"input": input},
+	}
+
+	var data struct {
+		Payload struct {
+			Errors      []string     `json:"errors"`
+			CustomEmoji *CustomEmoji `json:"customEmoji"`
+		} `json:"createCustomEmoji"`
+	}
+	resp, err := s.client.GraphQL(q, &data, options...)
+	if err != nil {
+		return nil, resp, err
+	}
+	if err := mutationErrors(data.Payload.Errors); err != nil {
+		return nil, resp, err
+	}
+
+	return data.Payload.CustomEmoji, resp, nil
+}
+
+// DeleteCustomEmoji deletes a custom emoji.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/graphql/reference/#mutationdestroycustomemoji
+func (s *CustomEmojiService) DeleteCustomEmoji(emoji string, options ...RequestOptionFunc) (*Response, error) {
+	q := GraphQLQuery{
+		Query: `mutation($input: DestroyCustomEmojiInput!) {
+			destroyCustomEmoji(input: $input) { errors }
+		}`,
+		Variables: map[string]interface{}{"input": map[string]interface{}{"id": emoji}},
+	}
+
+	var data struct {
+		Payload struct {
+			Errors []string `json:"errors"`
+		} `json:"destroyCustomEmoji"`
+	}
+	resp, err := s.client.GraphQL(q, &data, options...)
+	if err != nil {
+		return resp, err
+	}
+
+	return resp, mutationErrors(data.Payload.Errors)
+}
+
+// Emoji represents a built-in emoji of the emoji catalogue.
+type Emoji struct {
+	Category    string `json:"c"`
+	Moji        string `json:"e"`
+	Description string `json:"d"`
+	Unicode     string `json:"u"`
+}
+
+// EmojiCatalogue contains the emoji that can be used on a GitLab instance,
+// by name.
+type EmojiCatalogue struct {
+	Emoji       map[string]*Emoji
+	CustomEmoji map[string]*CustomEmoji
+}
+
+// Has reports whether an emoji with the given name exists in the catalogue.
+func (c *EmojiCatalogue) Has(name string) bool {
+	if _, ok := c.Emoji[name]; ok {
+		return true
+	}
+	_, ok := c.CustomEmoji[name]
+	return ok
+}
+
+// defaultEmojiCatalogueVersion is the version of the emoji catalogue served
+// by current GitLab versions.
+const defaultEmojiCatalogueVersion = 4
+
+// GetEmojiCatalogueOptions represents the available GetEmojiCatalogue()
+// options.
+type GetEmojiCatalogueOptions struct {
+	// Version is the version of the emoji catalogue served by the instance.
+	// Defaults to the version used by current GitLab versions.
+	Version *int
+
+	// Group, if set, is the ID or path of a group whose custom emoji are
+	// included in the catalogue.
+	Group interface{}
+}
+
+// GetEmojiCatalogue gets the built-in emoji of the GitLab instance and,
+// optionally, the custom emoji of a group.
+func (s *CustomEmojiService) GetEmojiCatalogue(opt *GetEmojiCatalogueOptions, options ...RequestOptionFunc) (*EmojiCatalogue, *Response, error) {
+	version := defaultEmojiCatalogueVersion
+	if opt != nil && opt.Version != nil {
+		version = *opt.Version
+	}
+
+	req, err := s.client.NewRequest(http.MethodGet, "", nil, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// The catalogue is a static asset, which is not part of the REST API.
+	req.URL.Path = s.client.hostPath(fmt.Sprintf("-/emojis/%d/emojis.json", version))
+	req.URL.RawPath = ""
+
+	c := &EmojiCatalogue{CustomEmoji: make(map[string]*CustomEmoji)}
+	resp, err := s.client.Do(req, &c.Emoji)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	if opt != nil && opt.Group != nil {
+		emoji, resp, err := s.ListGroupCustomEmoji(opt.Group, options...)
+		if err != nil {
+			return nil, resp, err
+		}
+		for _, e := range emoji {
+			c.CustomEmoji[e.Name] = e
+		}
+	}
+
+	return c, resp, nil
+}
@@ -0,0 +1,87 @@
+package gitlab
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCustomEmojiService_ListGroupCustomEmoji(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/graphql", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+
+		var q GraphQLQuery
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&q))
+
+		if q.Variables["after"] == nil {
+			fmt.Fprint(w, `{"data": {"group": {"customEmoji": {
+				"nodes": [{"id": "gid://gitlab/CustomEmoji/1", "name": "party-parrot", "url": "https://example.com/parrot.gif", "external": true}],
+				"pageInfo": {"hasNextPage": true, "endCursor": "abc"}
+			}}}}`)
+			return
+		}
+
+		require.Equal(t, "abc", q.Variables["after"])
+		fmt.Fprint(w, `{"data": {"group": {"customEmoji": {
+			"nodes": [{"id": "gid://gitlab/CustomEmoji/2", "name": "shipit", "url": "https://example.com/shipit.png", "external": true}],
+			"pageInfo": {"hasNextPage": false, "endCursor": "def"}
+		}}}}`)
+	})
+
+	emoji, _, err := client.CustomEmoji.ListGroupCustomEmoji("my-group")
+	require.NoError(t, err)
+	require.Len(t, emoji, 2)
+	require.Equal(t, "party-parrot", emoji[0].Name)
+	require.Equal(t, "shipit", emoji[1].Name)
+}
+
+func TestCustomEmojiService_CreateCustomEmoji(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/groups/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"id": 1, "full_path": "my-group"}`)
+	})
+	mux.HandleFunc("/api/graphql", func(w http.ResponseWriter, r *http.Request) {
+		var q GraphQLQuery
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&q))
+		require.Equal(t, map[string]interface{}{
+			"groupPath": "my-group",
+			"name":      "shipit",
+			"url":       "https://example.com/shipit.png",
+		}, q.Variables["input"])
+
+		fmt.Fprint(w, `{"data": {"createCustomEmoji": {"errors": [], "customEmoji": {"id": "gid://gitlab/CustomEmoji/2", "name": "shipit"}}}}`)
+	})
+
+	e, _, err := client.CustomEmoji.CreateCustomEmoji(1, &CreateCustomEmojiOptions{
+		Name: Ptr("shipit"),
+		URL:  Ptr("https://example.com/shipit.png"),
+	})
+	require.NoError(t, err)
+	require.Equal(t, "gid://gitlab/CustomEmoji/2", e.ID)
+}
+
+func TestCustomEmojiService_GetEmojiCatalogue(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/-/emojis/4/emojis.json", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"thumbsup": {"c": "people", "e": "👍", "d": "thumbs up sign", "u": "6.0"}}`)
+	})
+	mux.HandleFunc("/api/graphql", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"data": {"group": {"customEmoji": {"nodes": [{"name": "shipit"}], "pageInfo": {"hasNextPage": false}}}}}`)
+	})
+
+	c, _, err := client.CustomEmoji.GetEmojiCatalogue(&GetEmojiCatalogueOptions{Group: "my-group"})
+	require.NoError(t, err)
+	require.Equal(t, "👍", c.Emoji["thumbsup"].Moji)
+	require.True(t, c.Has("thumbsup"))
+	require.True(t, c.Has("shipit"))
+	require.False(t, c.Has("unknown"))
+}
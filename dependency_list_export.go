@@ -2,9 +2,11 @@ package gitlab
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"io"
 	"net/http"
+	"time"
 )
 
 type DependencyListExportService struct {
@@ -33,6 +35,10 @@ type DependencyListExport struct {
 
 const defaultExportType = "sbom"
 
+// defaultGroupExportType is the export type used for group exports when
+// none is given.
+const defaultGroupExportType = "csv"
+
 // CreateDependencyListExport creates a new CycloneDX JSON export for all the project dependencies
 // detected in a pipeline.
 //
@@ -68,6 +74,38 @@ func (s *DependencyListExportService) CreateDependencyListExport(pipelineID int,
 	return export, resp, nil
 }
 
+// CreateGroupDependencyListExport creates a new export of the dependencies
+// of all projects in a group and its subgroups. The export type can be
+// "json_array" or "csv", and defaults to "csv".
+//
+// GitLab docs:
+// https://docs.gitlab.com/ee/api/dependency_list_export.html#create-a-group-level-dependency-list-export
+func (s *DependencyListExportService) CreateGroupDependencyListExport(gid interface{}, opt *CreateDependencyListExportOptions, options ...RequestOptionFunc) (*DependencyListExport, *Response, error) {
+	group, err := parseGroupID(gid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("groups/%s/dependency_list_exports", PathEscape(group))
+
+	o := CreateDependencyListExportOptions{ExportType: Ptr(defaultGroupExportType)}
+	if opt != nil && opt.ExportType != nil {
+		o.ExportType = opt.ExportType
+	}
+
+	req, err := s.client.NewRequest(http.MethodPost, u, &o, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	export := new(DependencyListExport)
+	resp, err := s.client.Do(req, &export)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return export, resp, nil
+}
+
 // GetDependencyListExport gets metadata about a single dependency list export.
 //
 // GitLab docs:
@@ -120,3 +158,35 @@ func (s *DependencyListExportService) DownloadDependencyListExport(id int, optio
 
 	return &sbomBuffer, resp, nil
 }
+
+// DownloadDependencyListExportTo streams a single dependency list export
+// into the given writer, which avoids keeping large exports in memory.
+//
+// GitLab docs:
+// https://docs.gitlab.com/ee/api/dependency_list_export.html#download-dependency-list-export
+func (s *DependencyListExportService) DownloadDependencyListExportTo(id int, w io.Writer, options ...RequestOptionFunc) (*Response, error) {
+	u := fmt.Sprintf("dependency_list_exports/%d/download", id)
+
+	req, err := s.client.NewRequest(http.MethodGet, u, nil, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, w)
+}
+
+// WaitForDependencyListExport polls a dependency list export using the given
+// interval until it has finished or the context is done, and returns the
+// finished export. A non-positive interval defaults to five seconds.
+//
+// GitLab docs:
+// https://docs.gitlab.com/ee/api/dependency_list_export.html#get-single-dependency-list-export
+func (s *DependencyListExportService) WaitForDependencyListExport(ctx context.Context, id int, interval time.Duration, options ...RequestOptionFunc) (*DependencyListExport, *Response, error) {
+	get := func(options ...RequestOptionFunc) (*DependencyListExport, *Response, error) {
+		return s.GetDependencyListExport(id, options...)
+	}
+	done := func(export *DependencyListExport) bool {
+		return export.HasFinished
+	}
+	return pollUntil(ctx, interval, get, done, options)
+}
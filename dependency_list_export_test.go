@@ -2,11 +2,13 @@ package gitlab
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"io"
 	"net/http"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -83,3 +85,47 @@ func TestDownloadDependencyListExport(t *testing.T) {
 
 	require.Equal(t, &want, sbomReader)
 }
+
+func TestCreateGroupDependencyListExport(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/groups/7/dependency_list_exports", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testBody(t, r, `{"export_type":"csv"}`)
+		mustWriteHTTPResponse(t, w, "testdata/create_dependency_list_export.json")
+	})
+
+	export, _, err := client.DependencyListExport.CreateGroupDependencyListExport(7, nil)
+	require.NoError(t, err)
+	require.Equal(t, 5678, export.ID)
+}
+
+func TestWaitForDependencyListExportAndDownload(t *testing.T) {
+	mux, client := setup(t)
+
+	calls := 0
+	mux.HandleFunc("/api/v4/dependency_list_exports/5678", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		calls++
+		if calls < 3 {
+			w.WriteHeader(http.StatusAccepted)
+			mustWriteHTTPResponse(t, w, "testdata/create_dependency_list_export.json")
+			return
+		}
+		mustWriteHTTPResponse(t, w, "testdata/get_dependency_list_export.json")
+	})
+	mux.HandleFunc("/api/v4/dependency_list_exports/5678/download", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		io.WriteString(w, "name,version\nrails,7.1.0\n")
+	})
+
+	export, _, err := client.DependencyListExport.WaitForDependencyListExport(context.Background(), 5678, time.Millisecond)
+	require.NoError(t, err)
+	require.True(t, export.HasFinished)
+	require.Equal(t, 3, calls)
+
+	var b bytes.Buffer
+	_, err = client.DependencyListExport.DownloadDependencyListExportTo(5678, &b)
+	require.NoError(t, err)
+	require.Equal(t, "name,version\nrails,7.1.0\n", b.String())
+}
@@ -77,7 +77,7 @@ type ListProjectDeployTokensOptions ListOptions
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/deploy_tokens.html#list-project-deploy-tokens
 func (s *DeployTokensService) ListProjectDeployTokens(pid interface{}, opt *ListProjectDeployTokensOptions, options ...RequestOptionFunc) ([]*DeployToken, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -102,7 +102,7 @@ func (s *DeployTokensService) ListProjectDeployTokens(pid interface{}, opt *List
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/deploy_tokens.html#get-a-project-deploy-token
 func (s *DeployTokensService) GetProjectDeployToken(pid interface{}, deployToken int, options ...RequestOptionFunc) (*DeployToken, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -138,7 +138,7 @@ type CreateProjectDeployTokenOptions struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/deploy_tokens.html#create-a-project-deploy-token
 func (s *DeployTokensService) CreateProjectDeployToken(pid interface{}, opt *CreateProjectDeployTokenOptions, options ...RequestOptionFunc) (*DeployToken, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -163,7 +163,7 @@ func (s *DeployTokensService) CreateProjectDeployToken(pid interface{}, opt *Cre
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/deploy_tokens.html#delete-a-project-deploy-token
 func (s *DeployTokensService) DeleteProjectDeployToken(pid interface{}, deployToken int, options ...RequestOptionFunc) (*Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, err
 	}
@@ -189,7 +189,7 @@ type ListGroupDeployTokensOptions ListOptions
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/deploy_tokens.html#list-group-deploy-tokens
 func (s *DeployTokensService) ListGroupDeployTokens(gid interface{}, opt *ListGroupDeployTokensOptions, options ...RequestOptionFunc) ([]*DeployToken, *Response, error) {
-	group, err := parseID(gid)
+	group, err := parseGroupID(gid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -214,7 +214,7 @@ func (s *DeployTokensService) ListGroupDeployTokens(gid interface{}, opt *ListGr
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/deploy_tokens.html#get-a-group-deploy-token
 func (s *DeployTokensService) GetGroupDeployToken(gid interface{}, deployToken int, options ...RequestOptionFunc) (*DeployToken, *Response, error) {
-	group, err := parseID(gid)
+	group, err := parseGroupID(gid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -250,7 +250,7 @@ type CreateGroupDeployTokenOptions struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/deploy_tokens.html#create-a-group-deploy-token
 func (s *DeployTokensService) CreateGroupDeployToken(gid interface{}, opt *CreateGroupDeployTokenOptions, options ...RequestOptionFunc) (*DeployToken, *Response, error) {
-	group, err := parseID(gid)
+	group, err := parseGroupID(gid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -275,7 +275,7 @@ func (s *DeployTokensService) CreateGroupDeployToken(gid interface{}, opt *Creat
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/deploy_tokens.html#delete-a-group-deploy-token
 func (s *DeployTokensService) DeleteGroupDeployToken(gid interface{}, deployToken int, options ...RequestOptionFunc) (*Response, error) {
-	group, err := parseID(gid)
+	group, err := parseGroupID(gid)
 	if err != nil {
 		return nil, err
 	}
@@ -91,7 +91,7 @@ type ListProjectDeploymentsOptions struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/deployments.html#list-project-deployments
 func (s *DeploymentsService) ListProjectDeployments(pid interface{}, opts *ListProjectDeploymentsOptions, options ...RequestOptionFunc) ([]*Deployment, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -116,7 +116,7 @@ func (s *DeploymentsService) ListProjectDeployments(pid interface{}, opts *ListP
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/deployments.html#get-a-specific-deployment
 func (s *DeploymentsService) GetProjectDeployment(pid interface{}, deployment int, options ...RequestOptionFunc) (*Deployment, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -154,7 +154,7 @@ type CreateProjectDeploymentOptions struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/deployments.html#create-a-deployment
 func (s *DeploymentsService) CreateProjectDeployment(pid interface{}, opt *CreateProjectDeploymentOptions, options ...RequestOptionFunc) (*Deployment, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -188,7 +188,7 @@ type UpdateProjectDeploymentOptions struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/deployments.html#update-a-deployment
 func (s *DeploymentsService) UpdateProjectDeployment(pid interface{}, deployment int, opt *UpdateProjectDeploymentOptions, options ...RequestOptionFunc) (*Deployment, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -226,7 +226,7 @@ type ApproveOrRejectProjectDeploymentOptions struct {
 func (s *DeploymentsService) ApproveOrRejectProjectDeployment(pid interface{}, deployment int,
 	opt *ApproveOrRejectProjectDeploymentOptions, options ...RequestOptionFunc,
 ) (*Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, err
 	}
@@ -245,7 +245,7 @@ func (s *DeploymentsService) ApproveOrRejectProjectDeployment(pid interface{}, d
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/deployments.html#delete-a-specific-deployment
 func (s *DeploymentsService) DeleteProjectDeployment(pid interface{}, deployment int, options ...RequestOptionFunc) (*Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, err
 	}
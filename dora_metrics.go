@@ -64,7 +64,7 @@ type GetDORAMetricsOptions struct {
 // GitLab API Docs:
 // https://docs.gitlab.com/ee/api/dora/metrics.html#get-project-level-dora-metrics
 func (s *DORAMetricsService) GetProjectDORAMetrics(pid interface{}, opt GetDORAMetricsOptions, options ...RequestOptionFunc) ([]DORAMetric, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -89,7 +89,7 @@ func (s *DORAMetricsService) GetProjectDORAMetrics(pid interface{}, opt GetDORAM
 // GitLab API Docs:
 // https://docs.gitlab.com/ee/api/dora/metrics.html#get-group-level-dora-metrics
 func (s *DORAMetricsService) GetGroupDORAMetrics(gid interface{}, opt GetDORAMetricsOptions, options ...RequestOptionFunc) ([]DORAMetric, *Response, error) {
-	group, err := parseID(gid)
+	group, err := parseGroupID(gid)
 	if err != nil {
 		return nil, nil, err
 	}
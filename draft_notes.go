@@ -58,7 +58,7 @@ type ListDraftNotesOptions struct {
 // Gitlab API docs:
 // https://docs.gitlab.com/ee/api/draft_notes.html#list-all-merge-request-draft-notes
 func (s *DraftNotesService) ListDraftNotes(pid interface{}, mergeRequest int, opt *ListDraftNotesOptions, options ...RequestOptionFunc) ([]*DraftNote, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -83,7 +83,7 @@ func (s *DraftNotesService) ListDraftNotes(pid interface{}, mergeRequest int, op
 // Gitlab API docs:
 // https://docs.gitlab.com/ee/api/draft_notes.html#get-a-single-draft-note
 func (s *DraftNotesService) GetDraftNote(pid interface{}, mergeRequest int, note int, options ...RequestOptionFunc) (*DraftNote, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -121,7 +121,7 @@ type CreateDraftNoteOptions struct {
 // Gitlab API docs:
 // https://docs.gitlab.com/ee/api/draft_notes.html#create-a-draft-note
 func (s *DraftNotesService) CreateDraftNote(pid interface{}, mergeRequest int, opt *CreateDraftNoteOptions, options ...RequestOptionFunc) (*DraftNote, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -155,7 +155,7 @@ type UpdateDraftNoteOptions struct {
 //
 // Gitlab API docs: https://docs.gitlab.com/ee/api/draft_notes.html#create-a-draft-note
 func (s *DraftNotesService) UpdateDraftNote(pid interface{}, mergeRequest int, note int, opt *UpdateDraftNoteOptions, options ...RequestOptionFunc) (*DraftNote, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -180,7 +180,7 @@ func (s *DraftNotesService) UpdateDraftNote(pid interface{}, mergeRequest int, n
 // Gitlab API docs:
 // https://docs.gitlab.com/ee/api/draft_notes.html#delete-a-draft-note
 func (s *DraftNotesService) DeleteDraftNote(pid interface{}, mergeRequest int, note int, options ...RequestOptionFunc) (*Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, err
 	}
@@ -199,7 +199,7 @@ func (s *DraftNotesService) DeleteDraftNote(pid interface{}, mergeRequest int, n
 // Gitlab API docs:
 // https://docs.gitlab.com/ee/api/draft_notes.html#publish-a-draft-note
 func (s *DraftNotesService) PublishDraftNote(pid interface{}, mergeRequest int, note int, options ...RequestOptionFunc) (*Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, err
 	}
@@ -218,7 +218,7 @@ func (s *DraftNotesService) PublishDraftNote(pid interface{}, mergeRequest int,
 // Gitlab API docs:
 // https://docs.gitlab.com/ee/api/draft_notes.html#publish-a-draft-note
 func (s *DraftNotesService) PublishAllDraftNotes(pid interface{}, mergeRequest int, options ...RequestOptionFunc) (*Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, err
 	}
@@ -231,3 +231,27 @@ func (s *DraftNotesService) PublishAllDraftNotes(pid interface{}, mergeRequest i
 
 	return s.client.Do(req, nil)
 }
+
+// PublishDraftNotes publishes the given draft notes for a merge request one by
+// one. Unlike PublishAllDraftNotes, a failure to publish one of the notes does
+// not prevent the other notes from being published, and the outcome for each
+// note is reported in the returned result.
+//
+// Gitlab API docs:
+// https://docs.gitlab.com/ee/api/draft_notes.html#publish-a-draft-note
+func (s *DraftNotesService) PublishDraftNotes(pid interface{}, mergeRequest int, notes []int, options ...RequestOptionFunc) (*BulkResult[int], error) {
+	if _, err := parseProjectID(pid); err != nil {
+		return nil, err
+	}
+
+	result := new(BulkResult[int])
+	for _, note := range notes {
+		if _, err := s.PublishDraftNote(pid, mergeRequest, note, options...); err != nil {
+			result.Failed = append(result.Failed, &BulkItemError[int]{Item: note, Err: err})
+			continue
+		}
+		result.Succeeded = append(result.Succeeded, note)
+	}
+
+	return result, nil
+}
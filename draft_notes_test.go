@@ -17,6 +17,7 @@
 package gitlab
 
 import (
+	"errors"
 	"net/http"
 	"reflect"
 	"testing"
@@ -210,3 +211,28 @@ func TestPublishAllDraftNotes(t *testing.T) {
 		t.Errorf("DraftNotes.PublishAllDraftNotes returned error: %v", err)
 	}
 }
+
+func TestPublishDraftNotes(t *testing.T) {
+	mux, client := setup(t)
+	mux.HandleFunc("/api/v4/projects/1/merge_requests/4329/draft_notes/3/publish", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+	})
+	mux.HandleFunc("/api/v4/projects/1/merge_requests/4329/draft_notes/5/publish", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+	})
+
+	result, err := client.DraftNotes.PublishDraftNotes("1", 4329, []int{3, 4, 5})
+	if err != nil {
+		t.Fatalf("DraftNotes.PublishDraftNotes returned error: %v", err)
+	}
+
+	if want := []int{3, 5}; !reflect.DeepEqual(want, result.Succeeded) {
+		t.Errorf("DraftNotes.PublishDraftNotes returned succeeded %v, want %v", result.Succeeded, want)
+	}
+	if !result.HasFailures() || len(result.Failed) != 1 || result.Failed[0].Item != 4 {
+		t.Fatalf("DraftNotes.PublishDraftNotes returned failed %v, want [4]", result.Failed)
+	}
+	if !errors.Is(result.Failed[0], ErrNotFound) {
+		t.Errorf("DraftNotes.PublishDraftNotes returned error %v, want %v", result.Failed[0].Err, ErrNotFound)
+	}
+}
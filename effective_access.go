@@ -0,0 +1,217 @@
+package gitlab
+
+import "errors"
+
+// AccessSourceKind describes how a user gets access to a project.
+type AccessSourceKind string
+
+// The ways a user can get access to a project.
+const (
+	// DirectAccess is a direct membership of the project.
+	DirectAccess AccessSourceKind = "direct"
+
+	// InheritedAccess is a membership of the group of the project, or one
+	// of its ancestor groups.
+	InheritedAccess AccessSourceKind = "inherited"
+
+	// ProjectShareAccess is a membership of a group the project is shared
+	// with.
+	ProjectShareAccess AccessSourceKind = "project_share"
+
+	// GroupShareAccess is a membership of a group the group of the project,
+	// or one of its ancestor groups, is shared with.
+	GroupShareAccess AccessSourceKind = "group_share"
+)
+
+// AccessSource represents a single way a user gets access to a project.
+type AccessSource struct {
+	Kind AccessSourceKind
+
+	// Group is the full path of the group the user is a member of. It is
+	// empty for direct project memberships.
+	Group string
+
+	// SharedGroup is the full path of the group which is shared with Group,
+	// for group shares.
+	SharedGroup string
+
+	// MemberAccessLevel is the access level of the membership.
+	MemberAccessLevel AccessLevelValue
+
+	// AccessLevel is the access level granted to the project, which is the
+	// membership access level capped by the access level of the share.
+	AccessLevel AccessLevelValue
+}
+
+// EffectiveAccess represents the effective access of a user to a project.
+type EffectiveAccess struct {
+	// AccessLevel is the highest access level granted by any source, or
+	// NoPermissions if the user is not a member.
+	AccessLevel AccessLevelValue
+
+	// Sources contains all the ways the user gets access to the project.
+	Sources []*AccessSource
+}
+
+// GetEffectiveAccessLevel computes the effective access level of a user in a
+// project, considering direct membership, membership of the project's group
+// and its ancestors, and the groups the project and its groups are shared
+// with. Access granted by sharing is capped by the access level of the
+// share, and is not passed on by groups which are shared in turn. The
+// authenticated user must be able to see the members of all involved groups.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/user/project/members/#membership-types
+func (s *ProjectMembersService) GetEffectiveAccessLevel(pid interface{}, user int, options ...RequestOptionFunc) (*EffectiveAccess, error) {
+	p, _, err := s.client.Projects.GetProject(pid, nil, options...)
+	if err != nil {
+		return nil, err
+	}
+
+	r := &accessResolver{client: s.client, user: user, options: options, groups: make(map[int]*Group), levels: make(map[int]AccessLevelValue)}
+	ea := new(EffectiveAccess)
+	add := func(src *AccessSource) {
+		ea.Sources = append(ea.Sources, src)
+		if src.AccessLevel > ea.AccessLevel {
+			ea.AccessLevel = src.AccessLevel
+		}
+	}
+
+	pm, _, err := s.GetProjectMember(p.ID, user, options...)
+	switch {
+	case err == nil:
+		add(&AccessSource{Kind: DirectAccess, MemberAccessLevel: pm.AccessLevel, AccessLevel: pm.AccessLevel})
+	case !errors.Is(err, ErrNotFound):
+		return nil, err
+	}
+
+	for _, share := range p.SharedWithGroups {
+		sources, err := r.shareSources(ProjectShareAccess, share.GroupID, "", AccessLevelValue(share.GroupAccessLevel))
+		if err != nil {
+			return nil, err
+		}
+		for _, src := range sources {
+			add(src)
+		}
+	}
+
+	if p.Namespace == nil || p.Namespace.Kind != "group" {
+		return ea, nil
+	}
+
+	ancestry, err := r.ancestry(p.Namespace.ID)
+	if err != nil {
+		return nil, err
+	}
+	for _, g := range ancestry {
+		level, err := r.memberAccessLevel(g.ID)
+		if err != nil {
+			return nil, err
+		}
+		if level > NoPermissions {
+			add(&AccessSource{Kind: InheritedAccess, Group: g.FullPath, MemberAccessLevel: level, AccessLevel: level})
+		}
+
+		for _, share := range g.SharedWithGroups {
+			sources, err := r.shareSources(GroupShareAccess, share.GroupID, g.FullPath, AccessLevelValue(share.GroupAccessLevel))
+			if err != nil {
+				return nil, err
+			}
+			for _, src := range sources {
+				add(src)
+			}
+		}
+	}
+
+	return ea, nil
+}
+
+// accessResolver looks up the group memberships of a single user, caching
+// the groups and memberships it retrieved.
+type accessResolver struct {
+	client  *Client
+	user    int
+	options []RequestOptionFunc
+	groups  map[int]*Group
+	levels  map[int]AccessLevelValue
+}
+
+func (r *accessResolver) group(gid int) (*Group, error) {
+	if g, ok := r.groups[gid]; ok {
+		return g, nil
+	}
+	g, _, err := r.client.Groups.GetGroup(gid, &GetGroupOptions{WithProjects: Ptr(false)}, r.options...)
+	if err != nil {
+		return nil, err
+	}
+	r.groups[gid] = g
+	return g, nil
+}
+
+// ancestry returns the given group followed by its ancestors.
+func (r *accessResolver) ancestry(gid int) ([]*Group, error) {
+	var groups []*Group
+	for gid != 0 {
+		g, err := r.group(gid)
+		if err != nil {
+			return nil, err
+		}
+		groups = append(groups, g)
+		gid = g.ParentID
+	}
+	return groups, nil
+}
+
+// memberAccessLevel returns the access level of the direct membership of
+// the user in the given group.
+func (r *accessResolver) memberAccessLevel(gid int) (AccessLevelValue, error) {
+	if level, ok := r.levels[gid]; ok {
+		return level, nil
+	}
+
+	level := NoPermissions
+	m, _, err := r.client.GroupMembers.GetGroupMember(gid, r.user, r.options...)
+	switch {
+	case err == nil:
+		level = m.AccessLevel
+	case !errors.Is(err, ErrNotFound):
+		return NoPermissions, err
+	}
+
+	r.levels[gid] = level
+	return level, nil
+}
+
+// shareSources returns the access sources granted by sharing with the given
+// group, whose direct and inherited members get access.
+func (r *accessResolver) shareSources(kind AccessSourceKind, gid int, sharedGroup string, shareLevel AccessLevelValue) ([]*AccessSource, error) {
+	ancestry, err := r.ancestry(gid)
+	if err != nil {
+		return nil, err
+	}
+
+	var sources []*AccessSource
+	for _, g := range ancestry {
+		level, err := r.memberAccessLevel(g.ID)
+		if err != nil {
+			return nil, err
+		}
+		if level == NoPermissions {
+			continue
+		}
+
+		granted := level
+		if shareLevel < granted {
+			granted = shareLevel
+		}
+		sources = append(sources, &AccessSource{
+			Kind:              kind,
+			Group:             g.FullPath,
+			SharedGroup:       sharedGroup,
+			MemberAccessLevel: level,
+			AccessLevel:       granted,
+		})
+	}
+
+	return sources, nil
+}
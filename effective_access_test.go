@@ -0,0 +1,72 @@
+package gitlab
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetEffectiveAccessLevel(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{
+			"id": 1,
+			"namespace": {"id": 10, "kind": "group", "full_path": "parent/child"},
+			"shared_with_groups": [{"group_id": 20, "group_access_level": 20}]
+		}`)
+	})
+
+	groups := map[int]string{
+		10: `{"id": 10, "full_path": "parent/child", "parent_id": 11}`,
+		11: `{"id": 11, "full_path": "parent", "shared_with_groups": [{"group_id": 30, "group_access_level": 30}]}`,
+		20: `{"id": 20, "full_path": "reporters"}`,
+		30: `{"id": 30, "full_path": "org/developers", "parent_id": 31}`,
+		31: `{"id": 31, "full_path": "org"}`,
+	}
+	members := map[int]int{11: 10, 20: 40, 31: 50}
+
+	for id, body := range groups {
+		id, body := id, body
+		mux.HandleFunc(fmt.Sprintf("/api/v4/groups/%d", id), func(w http.ResponseWriter, r *http.Request) {
+			testMethod(t, r, http.MethodGet)
+			fmt.Fprint(w, body)
+		})
+		mux.HandleFunc(fmt.Sprintf("/api/v4/groups/%d/members/5", id), func(w http.ResponseWriter, r *http.Request) {
+			level, ok := members[id]
+			if !ok {
+				http.NotFound(w, r)
+				return
+			}
+			fmt.Fprintf(w, `{"id": 5, "access_level": %d}`, level)
+		})
+	}
+
+	ea, err := client.ProjectMembers.GetEffectiveAccessLevel(1, 5)
+	require.NoError(t, err)
+	require.Equal(t, DeveloperPermissions, ea.AccessLevel)
+	require.Equal(t, []*AccessSource{
+		{Kind: ProjectShareAccess, Group: "reporters", MemberAccessLevel: MaintainerPermissions, AccessLevel: ReporterPermissions},
+		{Kind: InheritedAccess, Group: "parent", MemberAccessLevel: GuestPermissions, AccessLevel: GuestPermissions},
+		{Kind: GroupShareAccess, Group: "org", SharedGroup: "parent", MemberAccessLevel: OwnerPermissions, AccessLevel: DeveloperPermissions},
+	}, ea.Sources)
+}
+
+func TestGetEffectiveAccessLevelDirect(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"id": 1, "namespace": {"id": 3, "kind": "user"}}`)
+	})
+	mux.HandleFunc("/api/v4/projects/1/members/5", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"id": 5, "access_level": 40}`)
+	})
+
+	ea, err := client.ProjectMembers.GetEffectiveAccessLevel(1, 5)
+	require.NoError(t, err)
+	require.Equal(t, MaintainerPermissions, ea.AccessLevel)
+	require.Len(t, ea.Sources, 1)
+	require.Equal(t, DirectAccess, ea.Sources[0].Kind)
+}
@@ -44,7 +44,7 @@ type EpicIssueAssignment struct {
 // Gitlab API docs:
 // https://docs.gitlab.com/ee/api/epic_issues.html#list-issues-for-an-epic
 func (s *EpicIssuesService) ListEpicIssues(gid interface{}, epic int, opt *ListOptions, options ...RequestOptionFunc) ([]*Issue, *Response, error) {
-	group, err := parseID(gid)
+	group, err := parseGroupID(gid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -69,7 +69,7 @@ func (s *EpicIssuesService) ListEpicIssues(gid interface{}, epic int, opt *ListO
 // Gitlab API Docs:
 // https://docs.gitlab.com/ee/api/epic_issues.html#assign-an-issue-to-the-epic
 func (s *EpicIssuesService) AssignEpicIssue(gid interface{}, epic, issue int, options ...RequestOptionFunc) (*EpicIssueAssignment, *Response, error) {
-	group, err := parseID(gid)
+	group, err := parseGroupID(gid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -94,7 +94,7 @@ func (s *EpicIssuesService) AssignEpicIssue(gid interface{}, epic, issue int, op
 // Gitlab API Docs:
 // https://docs.gitlab.com/ee/api/epic_issues.html#remove-an-issue-from-the-epic
 func (s *EpicIssuesService) RemoveEpicIssue(gid interface{}, epic, epicIssue int, options ...RequestOptionFunc) (*EpicIssueAssignment, *Response, error) {
-	group, err := parseID(gid)
+	group, err := parseGroupID(gid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -131,7 +131,7 @@ type UpdateEpicIsssueAssignmentOptions struct {
 // Gitlab API Docs:
 // https://docs.gitlab.com/ee/api/epic_issues.html#update-epic---issue-association
 func (s *EpicIssuesService) UpdateEpicIssueAssignment(gid interface{}, epic, epicIssue int, opt *UpdateEpicIsssueAssignmentOptions, options ...RequestOptionFunc) ([]*Issue, *Response, error) {
-	group, err := parseID(gid)
+	group, err := parseGroupID(gid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -0,0 +1,118 @@
+package gitlab
+
+// EpicProgress represents the roll-up progress of an epic, aggregated over
+// the epic's issues and all its descendant epics and their issues.
+type EpicProgress struct {
+	Epic *Epic
+
+	// Epics and ClosedEpics count all descendant epics, and the closed ones.
+	Epics       int
+	ClosedEpics int
+
+	// Issues and ClosedIssues count the issues of the epic and all its
+	// descendants, and the closed ones.
+	Issues       int
+	ClosedIssues int
+
+	// Weight and ClosedWeight sum the weights of those issues, and of the
+	// closed ones.
+	Weight       int
+	ClosedWeight int
+
+	// Children contains the progress of the direct child epics.
+	Children []*EpicProgress
+}
+
+// EpicsClosedRatio returns the ratio of closed descendant epics, or 0 if
+// the epic has no child epics.
+func (p *EpicProgress) EpicsClosedRatio() float64 {
+	return ratio(p.ClosedEpics, p.Epics)
+}
+
+// IssuesClosedRatio returns the ratio of closed issues, or 0 if there are no
+// issues.
+func (p *EpicProgress) IssuesClosedRatio() float64 {
+	return ratio(p.ClosedIssues, p.Issues)
+}
+
+// WeightClosedRatio returns the ratio of the weight of closed issues to the
+// total weight, or 0 if the issues have no weight.
+func (p *EpicProgress) WeightClosedRatio() float64 {
+	return ratio(p.ClosedWeight, p.Weight)
+}
+
+func ratio(n, total int) float64 {
+	if total == 0 {
+		return 0
+	}
+	return float64(n) / float64(total)
+}
+
+// GetEpicProgress aggregates the issue counts, weights and closed ratios of
+// an epic and all its descendant epics. Child epics can belong to other
+// groups than their parent.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/epic_links.html
+// https://docs.gitlab.com/ee/api/epic_issues.html
+func (s *EpicsService) GetEpicProgress(gid interface{}, epic int, options ...RequestOptionFunc) (*EpicProgress, error) {
+	e, _, err := s.GetEpic(gid, epic, options...)
+	if err != nil {
+		return nil, err
+	}
+	return s.epicProgress(e, make(map[int]bool), options)
+}
+
+func (s *EpicsService) epicProgress(e *Epic, seen map[int]bool, options []RequestOptionFunc) (*EpicProgress, error) {
+	seen[e.ID] = true
+	p := &EpicProgress{Epic: e}
+
+	opt := &ListOptions{PerPage: 100}
+	for {
+		issues, resp, err := s.client.EpicIssues.ListEpicIssues(e.GroupID, e.IID, opt, options...)
+		if err != nil {
+			return nil, err
+		}
+		for _, i := range issues {
+			p.Issues++
+			p.Weight += i.Weight
+			if i.State == "closed" {
+				p.ClosedIssues++
+				p.ClosedWeight += i.Weight
+			}
+		}
+		if resp.NextPage == 0 {
+			break
+		}
+		opt.Page = resp.NextPage
+	}
+
+	children, _, err := s.GetEpicLinks(e.GroupID, e.IID, options...)
+	if err != nil {
+		return nil, err
+	}
+	for _, child := range children {
+		// Epic hierarchies cannot contain cycles, but guard against
+		// counting an epic twice anyway.
+		if seen[child.ID] {
+			continue
+		}
+		cp, err := s.epicProgress(child, seen, options)
+		if err != nil {
+			return nil, err
+		}
+		p.Children = append(p.Children, cp)
+
+		p.Epics += 1 + cp.Epics
+		p.ClosedEpics += cp.ClosedEpics
+		if child.State == "closed" {
+			p.ClosedEpics++
+		}
+		p.Issues += cp.Issues
+		p.ClosedIssues += cp.ClosedIssues
+		p.Weight += cp.Weight
+		p.ClosedWeight += cp.ClosedWeight
+	}
+
+	return p, nil
+}
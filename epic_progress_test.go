@@ -0,0 +1,52 @@
+package gitlab
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetEpicProgress(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/groups/1/epics/5", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"id":50,"iid":5,"group_id":1,"state":"opened"}`)
+	})
+	mux.HandleFunc("/api/v4/groups/1/epics/5/issues", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `[{"id":1,"state":"closed","weight":3},{"id":2,"state":"opened","weight":1}]`)
+	})
+	mux.HandleFunc("/api/v4/groups/1/epics/5/epics", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `[{"id":60,"iid":6,"group_id":2,"state":"closed"}]`)
+	})
+	mux.HandleFunc("/api/v4/groups/2/epics/6/issues", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `[{"id":3,"state":"closed","weight":4}]`)
+	})
+	mux.HandleFunc("/api/v4/groups/2/epics/6/epics", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `[]`)
+	})
+
+	p, err := client.Epics.GetEpicProgress(1, 5)
+	require.NoError(t, err)
+
+	require.Equal(t, 50, p.Epic.ID)
+	require.Equal(t, 1, p.Epics)
+	require.Equal(t, 1, p.ClosedEpics)
+	require.Equal(t, 3, p.Issues)
+	require.Equal(t, 2, p.ClosedIssues)
+	require.Equal(t, 8, p.Weight)
+	require.Equal(t, 7, p.ClosedWeight)
+	require.InDelta(t, 7.0/8.0, p.WeightClosedRatio(), 1e-9)
+	require.InDelta(t, 2.0/3.0, p.IssuesClosedRatio(), 1e-9)
+	require.Equal(t, 1.0, p.EpicsClosedRatio())
+
+	require.Len(t, p.Children, 1)
+	require.Equal(t, 1, p.Children[0].Issues)
+	require.Equal(t, 0.0, p.Children[0].EpicsClosedRatio())
+}
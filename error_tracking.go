@@ -64,7 +64,7 @@ func (p ErrorTrackingSettings) String() string {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/error_tracking.html#get-error-tracking-settings
 func (s *ErrorTrackingService) GetErrorTrackingSettings(pid interface{}, options ...RequestOptionFunc) (*ErrorTrackingSettings, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -100,7 +100,7 @@ type EnableDisableErrorTrackingOptions struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/error_tracking.html#enable-or-disable-the-error-tracking-project-settings
 func (s *ErrorTrackingService) EnableDisableErrorTracking(pid interface{}, opt *EnableDisableErrorTrackingOptions, options ...RequestOptionFunc) (*ErrorTrackingSettings, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -131,7 +131,7 @@ type ListClientKeysOptions ListOptions
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/error_tracking.html#list-project-client-keys
 func (s *ErrorTrackingService) ListClientKeys(pid interface{}, opt *ListClientKeysOptions, options ...RequestOptionFunc) ([]*ErrorTrackingClientKey, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -156,7 +156,7 @@ func (s *ErrorTrackingService) ListClientKeys(pid interface{}, opt *ListClientKe
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/error_tracking.html#create-a-client-key
 func (s *ErrorTrackingService) CreateClientKey(pid interface{}, options ...RequestOptionFunc) (*ErrorTrackingClientKey, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -181,7 +181,7 @@ func (s *ErrorTrackingService) CreateClientKey(pid interface{}, options ...Reque
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/error_tracking.html#delete-a-client-key
 func (s *ErrorTrackingService) DeleteClientKey(pid interface{}, keyID int, options ...RequestOptionFunc) (*Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, err
 	}
@@ -118,6 +118,7 @@ type CommitCommentEvent struct {
 		Description  string             `json:"description"`
 		Action       CommentEventAction `json:"action"`
 		URL          string             `json:"url"`
+		Internal     bool               `json:"internal"`
 	} `json:"object_attributes"`
 	Commit *struct {
 		ID        string     `json:"id"`
@@ -272,6 +273,7 @@ type IssueCommentEvent struct {
 		Description  string             `json:"description"`
 		Action       CommentEventAction `json:"action"`
 		URL          string             `json:"url"`
+		Internal     bool               `json:"internal"`
 	} `json:"object_attributes"`
 	Issue struct {
 		ID                  int           `json:"id"`
@@ -540,6 +542,7 @@ type MergeCommentEvent struct {
 		Description      string             `json:"description"`
 		Action           CommentEventAction `json:"action"`
 		URL              string             `json:"url"`
+		Internal         bool               `json:"internal"`
 	} `json:"object_attributes"`
 	Repository   *Repository `json:"repository"`
 	MergeRequest struct {
@@ -1119,6 +1122,7 @@ type SnippetCommentEvent struct {
 		Description  string             `json:"description"`
 		Action       CommentEventAction `json:"action"`
 		URL          string             `json:"url"`
+		Internal     bool               `json:"internal"`
 	} `json:"object_attributes"`
 	Snippet *struct {
 		ID                 int    `json:"id"`
@@ -89,7 +89,7 @@ func (s *UsersService) ListUserContributionEvents(uid interface{}, opt *ListCont
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("users/%s/events", user)
+	u := fmt.Sprintf("users/%s/events", PathEscape(user))
 
 	req, err := s.client.NewRequest(http.MethodGet, u, opt, options)
 	if err != nil {
@@ -210,7 +210,7 @@ type ListProjectVisibleEventsOptions struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/events.html#list-a-projects-visible-events
 func (s *EventsService) ListProjectVisibleEvents(pid interface{}, opt *ListProjectVisibleEventsOptions, options ...RequestOptionFunc) ([]*ProjectEvent, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
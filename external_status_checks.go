@@ -44,7 +44,7 @@ type StatusCheckProtectedBranch struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/status_checks.html#list-status-checks-for-a-merge-request
 func (s *ExternalStatusChecksService) ListMergeStatusChecks(pid interface{}, mr int, opt *ListOptions, options ...RequestOptionFunc) ([]*MergeStatusCheck, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -80,7 +80,7 @@ type SetExternalStatusCheckStatusOptions struct {
 // Gitlab API docs:
 // https://docs.gitlab.com/ee/api/status_checks.html#set-status-of-an-external-status-check
 func (s *ExternalStatusChecksService) SetExternalStatusCheckStatus(pid interface{}, mergeRequest int, opt *SetExternalStatusCheckStatusOptions, options ...RequestOptionFunc) (*Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, err
 	}
@@ -99,7 +99,7 @@ func (s *ExternalStatusChecksService) SetExternalStatusCheckStatus(pid interface
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/status_checks.html#get-project-external-status-checks
 func (s *ExternalStatusChecksService) ListProjectStatusChecks(pid interface{}, opt *ListOptions, options ...RequestOptionFunc) ([]*ProjectStatusCheck, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -135,7 +135,7 @@ type CreateExternalStatusCheckOptions struct {
 // Gitlab API docs:
 // https://docs.gitlab.com/ee/api/status_checks.html#create-external-status-check
 func (s *ExternalStatusChecksService) CreateExternalStatusCheck(pid interface{}, opt *CreateExternalStatusCheckOptions, options ...RequestOptionFunc) (*Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, err
 	}
@@ -154,7 +154,7 @@ func (s *ExternalStatusChecksService) CreateExternalStatusCheck(pid interface{},
 // Gitlab API docs:
 // https://docs.gitlab.com/ee/api/status_checks.html#delete-external-status-check
 func (s *ExternalStatusChecksService) DeleteExternalStatusCheck(pid interface{}, check int, options ...RequestOptionFunc) (*Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, err
 	}
@@ -184,7 +184,7 @@ type UpdateExternalStatusCheckOptions struct {
 // Gitlab API docs:
 // https://docs.gitlab.com/ee/api/status_checks.html#update-external-status-check
 func (s *ExternalStatusChecksService) UpdateExternalStatusCheck(pid interface{}, check int, opt *UpdateExternalStatusCheckOptions, options ...RequestOptionFunc) (*Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, err
 	}
@@ -203,7 +203,7 @@ func (s *ExternalStatusChecksService) UpdateExternalStatusCheck(pid interface{},
 // Gitlab API docs:
 // https://docs.gitlab.com/ee/api/status_checks.html#retry-failed-status-check-for-a-merge-request
 func (s *ExternalStatusChecksService) RetryFailedStatusCheckForAMergeRequest(pid interface{}, mergeRequest int, externalStatusCheck int, options ...RequestOptionFunc) (*Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, err
 	}
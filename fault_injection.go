@@ -0,0 +1,131 @@
+package gitlab
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// FaultInjector can be used to simulate failures and latency in tests of
+// code using the client. It is called for every HTTP request, including
+// retries, before the request is sent.
+type FaultInjector interface {
+	// InjectFault returns the response or error to use instead of sending
+	// the request. If both are nil, the request is sent as usual. It may
+	// block to simulate latency, but should respect the context of the
+	// request.
+	InjectFault(req *http.Request) (*http.Response, error)
+}
+
+// FaultInjectorFunc is an adapter to use a function as a FaultInjector.
+type FaultInjectorFunc func(req *http.Request) (*http.Response, error)
+
+// InjectFault calls f(req).
+func (f FaultInjectorFunc) InjectFault(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+// Fault describes a simulated failure of a single request.
+type Fault struct {
+	// Latency delays the request.
+	Latency time.Duration
+
+	// StatusCode, if not 0, is the status code of the simulated response.
+	StatusCode int
+
+	// Header contains the headers of the simulated response, like
+	// Retry-After or RateLimit-Reset.
+	Header http.Header
+
+	// Body is the body of the simulated response.
+	Body string
+
+	// Err, if not nil, is returned instead of a response, to simulate a
+	// network error.
+	Err error
+}
+
+// FaultPlan is a FaultInjector which applies a fixed list of faults to the
+// requests made, one per request in order. Once all faults are applied,
+// requests are sent as usual.
+type FaultPlan struct {
+	mu     sync.Mutex
+	faults []Fault
+}
+
+// NewFaultPlan returns a FaultPlan which applies the given faults. A zero
+// Fault sends the request as usual, which can be used to only fail later
+// requests.
+func NewFaultPlan(faults ...Fault) *FaultPlan {
+	return &FaultPlan{faults: faults}
+}
+
+// Remaining returns the number of faults not yet applied.
+func (p *FaultPlan) Remaining() int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return len(p.faults)
+}
+
+// InjectFault applies the next fault of the plan to the request.
+func (p *FaultPlan) InjectFault(req *http.Request) (*http.Response, error) {
+	p.mu.Lock()
+	if len(p.faults) == 0 {
+		p.mu.Unlock()
+		return nil, nil
+	}
+	f := p.faults[0]
+	p.faults = p.faults[1:]
+	p.mu.Unlock()
+
+	if f.Latency > 0 {
+		t := time.NewTimer(f.Latency)
+		select {
+		case <-t.C:
+		case <-req.Context().Done():
+			t.Stop()
+			return nil, req.Context().Err()
+		}
+	}
+
+	switch {
+	case f.Err != nil:
+		return nil, f.Err
+	case f.StatusCode != 0:
+		header := f.Header.Clone()
+		if header == nil {
+			header = make(http.Header)
+		}
+		return &http.Response{
+			Status:        fmt.Sprintf("%d %s", f.StatusCode, http.StatusText(f.StatusCode)),
+			StatusCode:    f.StatusCode,
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        header,
+			Body:          io.NopCloser(bytes.NewBufferString(f.Body)),
+			ContentLength: int64(len(f.Body)),
+			Request:       req,
+		}, nil
+	}
+
+	return nil, nil
+}
+
+// faultTransport applies a FaultInjector to the requests sent by the next
+// transport.
+type faultTransport struct {
+	injector FaultInjector
+	next     http.RoundTripper
+}
+
+func (t *faultTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := t.injector.InjectFault(req)
+	if err != nil || resp != nil {
+		return resp, err
+	}
+	return t.next.RoundTrip(req)
+}
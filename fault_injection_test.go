@@ -0,0 +1,86 @@
+package gitlab
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func newFaultTestClient(t *testing.T, injector FaultInjector) (*http.ServeMux, *Client) {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	client, err := NewClient("",
+		WithBaseURL(server.URL),
+		WithFaultInjector(injector),
+		WithCustomBackoff(func(_, _ time.Duration, _ int, _ *http.Response) time.Duration {
+			return 10 * time.Millisecond
+		}),
+	)
+	require.NoError(t, err)
+
+	return mux, client
+}
+
+func TestWithFaultInjector(t *testing.T) {
+	plan := NewFaultPlan(
+		Fault{StatusCode: http.StatusServiceUnavailable},
+		Fault{StatusCode: http.StatusBadGateway, Body: `{"message": "bad gateway"}`},
+	)
+	mux, client := newFaultTestClient(t, plan)
+
+	requests := 0
+	mux.HandleFunc("/api/v4/projects/1", func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		fmt.Fprint(w, `{"id": 1}`)
+	})
+
+	p, _, err := client.Projects.GetProject(1, nil)
+	require.NoError(t, err)
+	require.Equal(t, 1, p.ID)
+	require.Equal(t, 1, requests)
+	require.Equal(t, 0, plan.Remaining())
+}
+
+func TestWithFaultInjectorError(t *testing.T) {
+	errInjected := errors.New("connection reset")
+	_, client := newFaultTestClient(t, NewFaultPlan(Fault{Err: errInjected}))
+
+	_, _, err := client.Projects.GetProject(1, nil)
+	require.ErrorIs(t, err, errInjected)
+}
+
+func TestWithFaultInjectorLatency(t *testing.T) {
+	_, client := newFaultTestClient(t, NewFaultPlan(Fault{Latency: time.Minute}))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	_, _, err := client.Projects.GetProject(1, nil, WithContext(ctx))
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestWithFaultInjectorFunc(t *testing.T) {
+	mux, client := newFaultTestClient(t, FaultInjectorFunc(func(req *http.Request) (*http.Response, error) {
+		if req.Method == http.MethodDelete {
+			return nil, errors.New("deletes are not allowed")
+		}
+		return nil, nil
+	}))
+
+	mux.HandleFunc("/api/v4/projects/1", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"id": 1}`)
+	})
+
+	_, _, err := client.Projects.GetProject(1, nil)
+	require.NoError(t, err)
+	_, err = client.Projects.DeleteProject(1, nil)
+	require.Error(t, err)
+}
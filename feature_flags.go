@@ -51,6 +51,25 @@ func (f Feature) String() string {
 	return Stringify(f)
 }
 
+// FeatureDefinition represents the definition of a GitLab feature flag.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/features.html#list-all-feature-definitions
+type FeatureDefinition struct {
+	Name            string `json:"name"`
+	IntroducedByURL string `json:"introduced_by_url"`
+	RolloutIssueURL string `json:"rollout_issue_url"`
+	Milestone       string `json:"milestone"`
+	LogStateChanges bool   `json:"log_state_changes"`
+	Type            string `json:"type"`
+	Group           string `json:"group"`
+	DefaultEnabled  bool   `json:"default_enabled"`
+}
+
+func (d FeatureDefinition) String() string {
+	return Stringify(d)
+}
+
 // ListFeatures gets a list of feature flags
 //
 // GitLab API docs:
@@ -94,3 +113,88 @@ func (s *FeaturesService) SetFeatureFlag(name string, value interface{}, options
 	}
 	return f, resp, nil
 }
+
+// ListFeatureDefinitions gets the definitions of all feature flags.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/features.html#list-all-feature-definitions
+func (s *FeaturesService) ListFeatureDefinitions(options ...RequestOptionFunc) ([]*FeatureDefinition, *Response, error) {
+	req, err := s.client.NewRequest(http.MethodGet, "features/definitions", nil, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var d []*FeatureDefinition
+	resp, err := s.client.Do(req, &d)
+	if err != nil {
+		return nil, resp, err
+	}
+	return d, resp, nil
+}
+
+// SetFeatureGateOptions represents the available SetFeatureGate() options.
+// Without an actor, the gate applies to the whole instance.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/features.html#set-or-create-a-feature
+type SetFeatureGateOptions struct {
+	// Value is true or false to enable or disable the feature, or an integer
+	// percentage.
+	Value interface{} `url:"value" json:"value"`
+
+	// Key is "percentage_of_actors" or "percentage_of_time" (the default)
+	// for percentage values.
+	Key *string `url:"key,omitempty" json:"key,omitempty"`
+
+	// FeatureGroup is the name of a feature group, like "gitlab_team_members".
+	FeatureGroup *string `url:"feature_group,omitempty" json:"feature_group,omitempty"`
+
+	// User, Group, Namespace, Project and Repository are comma separated
+	// usernames and full paths of the actors to set the gate for.
+	User       *string `url:"user,omitempty" json:"user,omitempty"`
+	Group      *string `url:"group,omitempty" json:"group,omitempty"`
+	Namespace  *string `url:"namespace,omitempty" json:"namespace,omitempty"`
+	Project    *string `url:"project,omitempty" json:"project,omitempty"`
+	Repository *string `url:"repository,omitempty" json:"repository,omitempty"`
+
+	// Force skips the validation of the feature flag against its
+	// definition.
+	Force *bool `url:"force,omitempty" json:"force,omitempty"`
+}
+
+// SetFeatureGate sets or creates a gate of a feature flag, for the whole
+// instance, a percentage of time or actors, or specific actors.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/features.html#set-or-create-a-feature
+func (s *FeaturesService) SetFeatureGate(name string, opt *SetFeatureGateOptions, options ...RequestOptionFunc) (*Feature, *Response, error) {
+	u := fmt.Sprintf("features/%s", url.PathEscape(name))
+
+	req, err := s.client.NewRequest(http.MethodPost, u, opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	f := &Feature{}
+	resp, err := s.client.Do(req, f)
+	if err != nil {
+		return nil, resp, err
+	}
+	return f, resp, nil
+}
+
+// DeleteFeatureFlag removes a feature gate, which restores the default
+// state of the feature.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/features.html#delete-a-feature
+func (s *FeaturesService) DeleteFeatureFlag(name string, options ...RequestOptionFunc) (*Response, error) {
+	u := fmt.Sprintf("features/%s", url.PathEscape(name))
+
+	req, err := s.client.NewRequest(http.MethodDelete, u, nil, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, nil)
+}
@@ -104,3 +104,90 @@ func TestSetFeatureFlag(t *testing.T) {
 		t.Errorf("Features.SetFeatureFlag returned %+v, want %+v", feature, want)
 	}
 }
+
+func TestListFeatureDefinitions(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/features/definitions", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `[{
+			"name": "geo_pages_deployment_replication",
+			"introduced_by_url": "https://gitlab.com/gitlab-org/gitlab/-/merge_requests/68662",
+			"rollout_issue_url": "https://gitlab.com/gitlab-org/gitlab/-/issues/337676",
+			"milestone": "14.3",
+			"log_state_changes": null,
+			"type": "development",
+			"group": "group::geo",
+			"default_enabled": true
+		}]`)
+	})
+
+	definitions, _, err := client.Features.ListFeatureDefinitions()
+	if err != nil {
+		t.Errorf("Features.ListFeatureDefinitions returned error: %v", err)
+	}
+
+	want := []*FeatureDefinition{{
+		Name:            "geo_pages_deployment_replication",
+		IntroducedByURL: "https://gitlab.com/gitlab-org/gitlab/-/merge_requests/68662",
+		RolloutIssueURL: "https://gitlab.com/gitlab-org/gitlab/-/issues/337676",
+		Milestone:       "14.3",
+		Type:            "development",
+		Group:           "group::geo",
+		DefaultEnabled:  true,
+	}}
+	if !reflect.DeepEqual(want, definitions) {
+		t.Errorf("Features.ListFeatureDefinitions returned %+v, want %+v", definitions, want)
+	}
+}
+
+func TestSetFeatureGate(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/features/new_library", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testBody(t, r, `{"value":true,"project":"gitlab-org/gitlab"}`)
+		fmt.Fprint(w, `{
+			"name": "new_library",
+			"state": "conditional",
+			"gates": [
+				{"key": "boolean", "value": false},
+				{"key": "actors", "value": ["Project:1"]}
+			]
+		}`)
+	})
+
+	feature, _, err := client.Features.SetFeatureGate("new_library", &SetFeatureGateOptions{
+		Value:   true,
+		Project: Ptr("gitlab-org/gitlab"),
+	})
+	if err != nil {
+		t.Errorf("Features.SetFeatureGate returned error: %v", err)
+	}
+
+	want := &Feature{
+		Name:  "new_library",
+		State: "conditional",
+		Gates: []Gate{
+			{Key: "boolean", Value: false},
+			{Key: "actors", Value: []interface{}{"Project:1"}},
+		},
+	}
+	if !reflect.DeepEqual(want, feature) {
+		t.Errorf("Features.SetFeatureGate returned %+v, want %+v", feature, want)
+	}
+}
+
+func TestDeleteFeatureFlag(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/features/new_library", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodDelete)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	_, err := client.Features.DeleteFeatureFlag("new_library")
+	if err != nil {
+		t.Errorf("Features.DeleteFeatureFlag returned error: %v", err)
+	}
+}
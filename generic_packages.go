@@ -64,7 +64,7 @@ type GenericPackagesFile struct {
 // FormatPackageURL returns the GitLab Package Registry URL for the given artifact metadata, without the BaseURL.
 // This does not make a GitLab API request, but rather computes it based on their documentation.
 func (s *GenericPackagesService) FormatPackageURL(pid interface{}, packageName, packageVersion, fileName string) (string, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return "", err
 	}
@@ -93,7 +93,7 @@ type PublishPackageFileOptions struct {
 // GitLab docs:
 // https://docs.gitlab.com/ee/user/packages/generic_packages/index.html#publish-a-package-file
 func (s *GenericPackagesService) PublishPackageFile(pid interface{}, packageName, packageVersion, fileName string, content io.Reader, opt *PublishPackageFileOptions, options ...RequestOptionFunc) (*GenericPackagesFile, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -131,7 +131,7 @@ func (s *GenericPackagesService) PublishPackageFile(pid interface{}, packageName
 // GitLab docs:
 // https://docs.gitlab.com/ee/user/packages/generic_packages/index.html#download-package-file
 func (s *GenericPackagesService) DownloadPackageFile(pid interface{}, packageName, packageVersion, fileName string, options ...RequestOptionFunc) ([]byte, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
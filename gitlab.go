@@ -24,6 +24,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"log/slog"
 	"math"
 	"math/rand"
 	"mime/multipart"
@@ -67,7 +68,15 @@ const (
 	PrivateToken
 )
 
-var ErrNotFound = errors.New("404 Not Found")
+// List of sentinel errors which can be used with errors.Is to check the
+// reason an API request failed.
+var (
+	ErrNotFound     = errors.New("404 Not Found")
+	ErrUnauthorized = errors.New("401 Unauthorized")
+	ErrForbidden    = errors.New("403 Forbidden")
+	ErrConflict     = errors.New("409 Conflict")
+	ErrRateLimited  = errors.New("429 Too Many Requests")
+)
 
 // A Client manages communication with the GitLab API.
 //
@@ -87,6 +96,10 @@ type Client struct {
 	// disableRetries is used to disable the default retry logic.
 	disableRetries bool
 
+	// retryPolicy is used to configure the retry logic. If nil, the default
+	// retry logic is used.
+	retryPolicy *RetryPolicy
+
 	// configureLimiterOnce is used to make sure the limiter is configured exactly
 	// once and block all other calls until the initial (one) call is done.
 	configureLimiterOnce sync.Once
@@ -109,6 +122,35 @@ type Client struct {
 	// Default request options applied to every request.
 	defaultRequestOptions []RequestOptionFunc
 
+	// logger is used to log all requests at the debug level.
+	logger *slog.Logger
+
+	// logBodies enables logging of (redacted) request and response bodies.
+	logBodies bool
+
+	// auditor records requests and their (redacted) bodies for audit logs.
+	auditor *auditor
+
+	// strictDecoding makes requests fail when a response does not match the
+	// type it is decoded into.
+	strictDecoding bool
+
+	// decodingReportHandler is called for every response which does not
+	// match the type it is decoded into.
+	decodingReportHandler func(*DecodingReport)
+
+	// listDefaults contains the default query parameters of list requests.
+	listDefaults listDefaults
+
+	// perPageTuner raises the number of items per page of list requests.
+	perPageTuner *perPageTuner
+
+	// faultInjector simulates failures of requests in tests.
+	faultInjector FaultInjector
+
+	// tokenPrescan is the check of the token run by NewClient.
+	tokenPrescan *TokenPrescan
+
 	// User agent used when communicating with the GitLab API.
 	UserAgent string
 
@@ -117,16 +159,22 @@ type Client struct {
 	Appearance                   *AppearanceService
 	Applications                 *ApplicationsService
 	AuditEvents                  *AuditEventsService
+	AuditEventStreaming          *AuditEventStreamingService
 	Avatar                       *AvatarRequestsService
 	AwardEmoji                   *AwardEmojiService
 	Boards                       *IssueBoardsService
 	Branches                     *BranchesService
 	BroadcastMessage             *BroadcastMessagesService
+	BulkImports                  *BulkImportsService
+	CICatalog                    *CICatalogService
 	CIYMLTemplate                *CIYMLTemplatesService
 	ClusterAgents                *ClusterAgentsService
 	Commits                      *CommitsService
+	ComplianceFrameworks         *ComplianceFrameworksService
+	ComposerPackages             *ComposerPackagesService
 	ContainerRegistry            *ContainerRegistryService
 	CustomAttribute              *CustomAttributesService
+	CustomEmoji                  *CustomEmojiService
 	DependencyListExport         *DependencyListExportService
 	DeployKeys                   *DeployKeysService
 	DeployTokens                 *DeployTokensService
@@ -187,6 +235,7 @@ type Client struct {
 	Namespaces                   *NamespacesService
 	Notes                        *NotesService
 	NotificationSettings         *NotificationSettingsService
+	NPMPackages                  *NPMPackagesService
 	Packages                     *PackagesService
 	Pages                        *PagesService
 	PagesDomains                 *PagesDomainsService
@@ -224,6 +273,7 @@ type Client struct {
 	ResourceMilestoneEvents      *ResourceMilestoneEventsService
 	ResourceStateEvents          *ResourceStateEventsService
 	ResourceWeightEvents         *ResourceWeightEventsService
+	RubyGemsPackages             *RubyGemsPackagesService
 	Runners                      *RunnersService
 	Search                       *SearchService
 	Services                     *ServicesService
@@ -238,6 +288,7 @@ type Client struct {
 	Users                        *UsersService
 	Validate                     *ValidateService
 	Version                      *VersionService
+	VulnerabilityExports         *VulnerabilityExportsService
 	Wikis                        *WikisService
 }
 
@@ -277,6 +328,17 @@ func NewClient(token string, options ...ClientOptionFunc) (*Client, error) {
 	}
 	client.authType = PrivateToken
 	client.token = token
+
+	if p := client.tokenPrescan; p != nil {
+		t, _, err := client.PersonalAccessTokens.PrescanToken(p.RequiredScopes)
+		if err != nil {
+			return nil, err
+		}
+		if p.Report != nil {
+			p.Report(t)
+		}
+	}
+
 	return client, nil
 }
 
@@ -361,6 +423,22 @@ func newClient(options ...ClientOptionFunc) (*Client, error) {
 		}
 	}
 
+	// Make retries count against the retry budget of a request, whichever
+	// backoff is used.
+	c.client.Backoff = withRetryBudgetBackoff(c.client.Backoff)
+
+	// Inject faults in a copy of the HTTP client, so a client passed using
+	// WithHTTPClient is not modified.
+	if c.faultInjector != nil {
+		httpClient := *c.client.HTTPClient
+		next := httpClient.Transport
+		if next == nil {
+			next = http.DefaultTransport
+		}
+		httpClient.Transport = &faultTransport{injector: c.faultInjector, next: next}
+		c.client.HTTPClient = &httpClient
+	}
+
 	// If no custom limiter was set using a client option, configure
 	// the default rate limiter with values that implicitly disable
 	// rate limiting until an initial HTTP call is done and we can
@@ -377,16 +455,22 @@ func newClient(options ...ClientOptionFunc) (*Client, error) {
 	c.Appearance = &AppearanceService{client: c}
 	c.Applications = &ApplicationsService{client: c}
 	c.AuditEvents = &AuditEventsService{client: c}
+	c.AuditEventStreaming = &AuditEventStreamingService{client: c}
 	c.Avatar = &AvatarRequestsService{client: c}
 	c.AwardEmoji = &AwardEmojiService{client: c}
 	c.Boards = &IssueBoardsService{client: c}
 	c.Branches = &BranchesService{client: c}
 	c.BroadcastMessage = &BroadcastMessagesService{client: c}
+	c.BulkImports = &BulkImportsService{client: c}
+	c.CICatalog = &CICatalogService{client: c}
 	c.CIYMLTemplate = &CIYMLTemplatesService{client: c}
 	c.ClusterAgents = &ClusterAgentsService{client: c}
 	c.Commits = &CommitsService{client: c}
+	c.ComplianceFrameworks = &ComplianceFrameworksService{client: c}
+	c.ComposerPackages = &ComposerPackagesService{client: c}
 	c.ContainerRegistry = &ContainerRegistryService{client: c}
 	c.CustomAttribute = &CustomAttributesService{client: c}
+	c.CustomEmoji = &CustomEmojiService{client: c}
 	c.DependencyListExport = &DependencyListExportService{client: c}
 	c.DeployKeys = &DeployKeysService{client: c}
 	c.DeployTokens = &DeployTokensService{client: c}
@@ -447,6 +531,7 @@ func newClient(options ...ClientOptionFunc) (*Client, error) {
 	c.Namespaces = &NamespacesService{client: c}
 	c.Notes = &NotesService{client: c}
 	c.NotificationSettings = &NotificationSettingsService{client: c}
+	c.NPMPackages = &NPMPackagesService{client: c}
 	c.Packages = &PackagesService{client: c}
 	c.Pages = &PagesService{client: c}
 	c.PagesDomains = &PagesDomainsService{client: c}
@@ -484,6 +569,7 @@ func newClient(options ...ClientOptionFunc) (*Client, error) {
 	c.ResourceMilestoneEvents = &ResourceMilestoneEventsService{client: c}
 	c.ResourceStateEvents = &ResourceStateEventsService{client: c}
 	c.ResourceWeightEvents = &ResourceWeightEventsService{client: c}
+	c.RubyGemsPackages = &RubyGemsPackagesService{client: c}
 	c.Runners = &RunnersService{client: c}
 	c.Search = &SearchService{client: c}
 	c.Services = &ServicesService{client: c}
@@ -498,11 +584,86 @@ func newClient(options ...ClientOptionFunc) (*Client, error) {
 	c.Users = &UsersService{client: c}
 	c.Validate = &ValidateService{client: c}
 	c.Version = &VersionService{client: c}
+	c.VulnerabilityExports = &VulnerabilityExportsService{client: c}
 	c.Wikis = &WikisService{client: c}
 
 	return c, nil
 }
 
+// RetryPolicy describes when and how often failed requests are retried.
+type RetryPolicy struct {
+	// MaxAttempts is the maximum number of attempts made for a request,
+	// including the initial attempt. A value of 1 disables retries.
+	MaxAttempts int
+
+	// WaitMin and WaitMax bound the time to wait between attempts.
+	WaitMin time.Duration
+	WaitMax time.Duration
+
+	// Backoff is used to calculate the time to wait between attempts. If
+	// nil, the default backoff of the client is used.
+	Backoff retryablehttp.Backoff
+
+	// RetryOn contains the status codes that are retried. If empty, rate
+	// limit (429) and server (>= 500) errors are retried.
+	RetryOn []int
+
+	// RetryNonIdempotent enables retrying POST and PATCH requests on errors
+	// other than 429. By default these requests are only retried when they
+	// are rate limited, as GitLab will not have processed them.
+	RetryNonIdempotent bool
+}
+
+// isIdempotent reports whether the given HTTP method can safely be retried.
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodPost, http.MethodPatch:
+		return false
+	}
+	return true
+}
+
+// shouldRetry reports whether a response with the given status code to a
+// request using the given method should be retried.
+func (p *RetryPolicy) shouldRetry(method string, statusCode int) bool {
+	if statusCode != http.StatusTooManyRequests && !isIdempotent(method) && !p.RetryNonIdempotent {
+		return false
+	}
+	if len(p.RetryOn) == 0 {
+		return statusCode == http.StatusTooManyRequests || statusCode >= 500
+	}
+	for _, code := range p.RetryOn {
+		if code == statusCode {
+			return true
+		}
+	}
+	return false
+}
+
+type (
+	retryPolicyContextKey struct{}
+	retryStateContextKey  struct{}
+)
+
+// retryState keeps track of the attempts made for a single request.
+type retryState struct {
+	policy   *RetryPolicy
+	attempts int
+}
+
+// withRetryState returns a context that tracks the retry state of a request
+// using the per request retry policy if set, or the client retry policy.
+func (c *Client) withRetryState(ctx context.Context) context.Context {
+	policy, ok := ctx.Value(retryPolicyContextKey{}).(*RetryPolicy)
+	if !ok {
+		policy = c.retryPolicy
+	}
+	if policy == nil {
+		return ctx
+	}
+	return context.WithValue(ctx, retryStateContextKey{}, &retryState{policy: policy})
+}
+
 // retryHTTPCheck provides a callback for Client.CheckRetry which
 // will retry both rate limit (429) and server (>= 500) errors.
 func (c *Client) retryHTTPCheck(ctx context.Context, resp *http.Response, err error) (bool, error) {
@@ -512,15 +673,40 @@ func (c *Client) retryHTTPCheck(ctx context.Context, resp *http.Response, err er
 	if err != nil {
 		return false, err
 	}
-	if !c.disableRetries && (resp.StatusCode == 429 || resp.StatusCode >= 500) {
-		return true, nil
+	if c.disableRetries {
+		return false, nil
+	}
+	if state, ok := ctx.Value(retryStateContextKey{}).(*retryState); ok {
+		state.attempts++
+		if state.policy.MaxAttempts > 0 && state.attempts >= state.policy.MaxAttempts {
+			return false, nil
+		}
+		if !state.policy.shouldRetry(resp.Request.Method, resp.StatusCode) {
+			return false, nil
+		}
+	} else if resp.StatusCode != 429 && resp.StatusCode < 500 {
+		return false, nil
+	}
+	if budget := retryBudgetFromContext(ctx); budget != nil && budget.exhausted() {
+		return false, nil
 	}
-	return false, nil
+	return true, nil
 }
 
 // retryHTTPBackoff provides a generic callback for Client.Backoff which
 // will pass through all calls based on the status code of the response.
 func (c *Client) retryHTTPBackoff(min, max time.Duration, attemptNum int, resp *http.Response) time.Duration {
+	if resp != nil && resp.Request != nil {
+		if state, ok := resp.Request.Context().Value(retryStateContextKey{}).(*retryState); ok {
+			if state.policy.WaitMin > 0 || state.policy.WaitMax > 0 {
+				min, max = state.policy.WaitMin, state.policy.WaitMax
+			}
+			if state.policy.Backoff != nil {
+				return state.policy.Backoff(min, max, attemptNum, resp)
+			}
+		}
+	}
+
 	// Use the rate limit backoff function when we are rate limited.
 	if resp != nil && resp.StatusCode == 429 {
 		return rateLimitBackoff(min, max, attemptNum, resp)
@@ -603,6 +789,12 @@ func (c *Client) BaseURL() *url.URL {
 	return &u
 }
 
+// hostPath returns the URL path of a resource which is not part of the
+// versioned REST API, like the GraphQL API.
+func (c *Client) hostPath(path string) string {
+	return strings.TrimSuffix(c.baseURL.Path, apiVersionPath) + path
+}
+
 // setBaseURL sets the base URL for API requests to a custom endpoint.
 func (c *Client) setBaseURL(urlStr string) error {
 	// Make sure the given URL end with a slash
@@ -687,6 +879,13 @@ func (c *Client) NewRequest(method, path string, opt interface{}, options []Requ
 		req.Header[k] = v
 	}
 
+	if c.listDefaults != nil && method == http.MethodGet {
+		c.listDefaults.apply(req, opt)
+	}
+	if c.perPageTuner != nil && method == http.MethodGet {
+		c.perPageTuner.tune(req, opt)
+	}
+
 	return req, nil
 }
 
@@ -785,6 +984,10 @@ type Response struct {
 	NextLink     string
 	FirstLink    string
 	LastLink     string
+
+	// DecodingReport is set when strict decoding or decoding reports are
+	// enabled, and the response did not match the type it was decoded into.
+	DecodingReport *DecodingReport
 }
 
 // newResponse creates a new Response for the provided http.Response.
@@ -901,7 +1104,16 @@ func (c *Client) Do(req *retryablehttp.Request, v interface{}) (*Response, error
 		}
 	}
 
-	resp, err := c.client.Do(req)
+	start := time.Now()
+	resp, err := c.client.Do(req.WithContext(c.withRetryState(req.Context())))
+	c.logRequest(req, resp, err, time.Since(start))
+	if c.auditor != nil && c.auditor.audits(req.Method) {
+		if err != nil {
+			c.auditor.fail(req, err)
+		} else {
+			c.auditor.tee(req, resp)
+		}
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -923,6 +1135,9 @@ func (c *Client) Do(req *retryablehttp.Request, v interface{}) (*Response, error
 	c.configureLimiterOnce.Do(func() { c.configureLimiter(req.Context(), resp.Header) })
 
 	response := newResponse(resp)
+	if c.perPageTuner != nil {
+		c.perPageTuner.observe(req, response)
+	}
 
 	err = CheckResponse(resp)
 	if err != nil {
@@ -931,17 +1146,87 @@ func (c *Client) Do(req *retryablehttp.Request, v interface{}) (*Response, error
 		return response, err
 	}
 
+	progress := progressFromContext(req.Context())
+
 	if v != nil {
+		if progress != nil {
+			v = progress.trackResponse(response, v)
+		}
 		if w, ok := v.(io.Writer); ok {
 			_, err = io.Copy(w, resp.Body)
 		} else {
-			err = json.NewDecoder(resp.Body).Decode(v)
+			err = c.decodeResponse(response, v)
+			if err == nil && progress != nil {
+				progress.trackItems(v)
+			}
 		}
 	}
 
 	return response, err
 }
 
+// Head sends a HEAD request for the given path, which is relative to the
+// base URL of the client, like "projects/1/repository/branches/main". As
+// HEAD responses have no body, this only costs the headers, which makes it
+// suitable for polling. It returns ErrNotFound if the resource does not
+// exist.
+func (c *Client) Head(path string, opt interface{}, options ...RequestOptionFunc) (*Response, error) {
+	req, err := c.NewRequest(http.MethodHead, path, opt, options)
+	if err != nil {
+		return nil, err
+	}
+	return c.Do(req, nil)
+}
+
+// Raw sends a request to an endpoint which is not (yet) wrapped by this
+// package, using the authentication, retries and error handling of the
+// client. The path is relative to the base URL of the client, and must be
+// escaped like "projects/group%2Fapp/new_feature". The query parameters
+// are added to the URL, and a non-nil body is sent as JSON. The response
+// is decoded into the value pointed to by into, or streamed into it if it
+// is an io.Writer. Pagination headers are available in the Response.
+func (c *Client) Raw(ctx context.Context, method, path string, query url.Values, body, into interface{}, options ...RequestOptionFunc) (*Response, error) {
+	req, err := c.NewRequest(method, path, nil, append([]RequestOptionFunc{WithContext(ctx)}, options...))
+	if err != nil {
+		return nil, err
+	}
+
+	if len(query) > 0 {
+		q := req.URL.Query()
+		for k, v := range query {
+			q[k] = v
+		}
+		req.URL.RawQuery = q.Encode()
+	}
+
+	if body != nil {
+		b, err := json.Marshal(body)
+		if err != nil {
+			return nil, err
+		}
+		if err := req.SetBody(b); err != nil {
+			return nil, err
+		}
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	return c.Do(req, into)
+}
+
+// exists reports whether the resource at the given path exists, using a
+// HEAD request.
+func (c *Client) exists(path string, opt interface{}, options []RequestOptionFunc) (bool, *Response, error) {
+	resp, err := c.Head(path, opt, options...)
+	switch {
+	case err == nil:
+		return true, resp, nil
+	case errors.Is(err, ErrNotFound):
+		return false, resp, nil
+	default:
+		return false, resp, err
+	}
+}
+
 func (c *Client) requestOAuthToken(ctx context.Context, token string) (string, error) {
 	c.tokenLock.Lock()
 	defer c.tokenLock.Unlock()
@@ -976,16 +1261,104 @@ func parseID(id interface{}) (string, error) {
 		return strconv.Itoa(v), nil
 	case string:
 		return v, nil
+	case ProjectID, GroupID:
+		return "", fmt.Errorf("invalid ID type %T, the ID must be an int or a string", id)
 	default:
 		return "", fmt.Errorf("invalid ID type %#v, the ID must be an int or a string", id)
 	}
 }
 
+// parseProjectID parses a project identifier, which is either a numeric ID,
+// a full path or a ProjectID. A GroupID is rejected.
+func parseProjectID(pid interface{}) (string, error) {
+	switch v := pid.(type) {
+	case ProjectID:
+		if v.id == "" {
+			return "", errors.New("invalid ID, the ProjectID is empty")
+		}
+		return v.id, nil
+	case GroupID:
+		return "", errors.New("invalid ID, expected a project ID but got a GroupID")
+	default:
+		return parseID(pid)
+	}
+}
+
+// parseGroupID parses a group identifier, which is either a numeric ID, a
+// full path or a GroupID. A ProjectID is rejected.
+func parseGroupID(gid interface{}) (string, error) {
+	switch v := gid.(type) {
+	case GroupID:
+		if v.id == "" {
+			return "", errors.New("invalid ID, the GroupID is empty")
+		}
+		return v.id, nil
+	case ProjectID:
+		return "", errors.New("invalid ID, expected a group ID but got a ProjectID")
+	default:
+		return parseID(gid)
+	}
+}
+
 // Helper function to escape a project identifier.
 func PathEscape(s string) string {
 	return strings.ReplaceAll(url.PathEscape(s), ".", "%2E")
 }
 
+// EscapeID converts a project, group or user identifier into an escaped API
+// path segment. The identifier can be either a numeric ID or a full path like
+// "namespace/project".
+func EscapeID(id interface{}) (string, error) {
+	var v string
+	var err error
+	switch id.(type) {
+	case ProjectID:
+		v, err = parseProjectID(id)
+	case GroupID:
+		v, err = parseGroupID(id)
+	default:
+		v, err = parseID(id)
+	}
+	if err != nil {
+		return "", err
+	}
+	return PathEscape(v), nil
+}
+
+// ProjectPath builds the API path of a project resource, escaping the project
+// identifier and all given path elements. For example:
+//
+//	ProjectPath("diaspora/diaspora", "repository", "branches", "feature/x")
+//
+// returns "projects/diaspora%2Fdiaspora/repository/branches/feature%2Fx".
+func ProjectPath(pid interface{}, elem ...string) (string, error) {
+	project, err := parseProjectID(pid)
+	if err != nil {
+		return "", err
+	}
+	return resourcePath("projects", project, elem), nil
+}
+
+// GroupPath builds the API path of a group resource, escaping the group
+// identifier and all given path elements.
+func GroupPath(gid interface{}, elem ...string) (string, error) {
+	group, err := parseGroupID(gid)
+	if err != nil {
+		return "", err
+	}
+	return resourcePath("groups", group, elem), nil
+}
+
+func resourcePath(resource string, id string, elem []string) string {
+	segments := make([]string, 0, len(elem)+2)
+	segments = append(segments, resource, PathEscape(id))
+	for _, e := range elem {
+		segments = append(segments, PathEscape(e))
+	}
+
+	return strings.Join(segments, "/")
+}
+
 // An ErrorResponse reports one or more errors caused by an API request.
 //
 // GitLab API docs:
@@ -994,6 +1367,10 @@ type ErrorResponse struct {
 	Body     []byte
 	Response *http.Response
 	Message  string
+
+	// FieldErrors contains the validation errors GitLab reported for
+	// individual attributes of the request, sorted by field name.
+	FieldErrors []*FieldError
 }
 
 func (e *ErrorResponse) Error() string {
@@ -1007,6 +1384,114 @@ func (e *ErrorResponse) Error() string {
 	}
 }
 
+// Is reports whether the error matches the sentinel error belonging to the
+// status code of the response, so it can be used with errors.Is.
+func (e *ErrorResponse) Is(target error) bool {
+	if e.Response == nil {
+		return false
+	}
+	switch e.Response.StatusCode {
+	case http.StatusUnauthorized:
+		return target == ErrUnauthorized
+	case http.StatusForbidden:
+		return target == ErrForbidden
+	case http.StatusNotFound:
+		return target == ErrNotFound
+	case http.StatusConflict:
+		return target == ErrConflict
+	case http.StatusTooManyRequests:
+		return target == ErrRateLimited
+	}
+	return false
+}
+
+// HasFieldError reports whether GitLab returned a validation error for the
+// given field containing the given message, e.g. ("name", "has already been
+// taken"). An empty message matches any error for the field.
+func (e *ErrorResponse) HasFieldError(field, message string) bool {
+	for _, fe := range e.FieldErrors {
+		if fe.Field == field && strings.Contains(fe.Message, message) {
+			return true
+		}
+	}
+	return false
+}
+
+// FieldError represents a validation error GitLab reported for a single
+// attribute. Attributes of embedded entities are joined using a dot, for
+// example "namespace.path".
+type FieldError struct {
+	Field   string
+	Message string
+}
+
+func (e *FieldError) Error() string {
+	return fmt.Sprintf("%s %s", e.Field, e.Message)
+}
+
+// RateLimitError is returned when an API request is rejected because the
+// rate limit is exceeded, and all retries (if any) are exhausted. It wraps
+// the ErrorResponse of the rejected request.
+type RateLimitError struct {
+	*ErrorResponse
+
+	// Limit is the number of requests allowed per minute, if reported.
+	Limit int
+
+	// Reset is the time at which the rate limit resets, if reported.
+	Reset time.Time
+}
+
+// Unwrap returns the underlying ErrorResponse.
+func (e *RateLimitError) Unwrap() error {
+	return e.ErrorResponse
+}
+
+// TierError is returned when GitLab rejects a request which uses features
+// that are only available in a paid tier, like health status or iterations,
+// with an error message which names the license or these features. The
+// instance is most likely not licensed for (one of) these features.
+type TierError struct {
+	Features []string
+	Tier     string
+	Err      error
+}
+
+func (e *TierError) Error() string {
+	return fmt.Sprintf("%s requires GitLab %s or higher: %v", strings.Join(e.Features, ", "), e.Tier, e.Err)
+}
+
+func (e *TierError) Unwrap() error {
+	return e.Err
+}
+
+// BulkResult contains the outcome of an operation which is applied to
+// multiple items, and which can partially fail.
+type BulkResult[T any] struct {
+	Succeeded []T
+	Failed    []*BulkItemError[T]
+}
+
+// HasFailures reports whether the operation failed for any of the items.
+func (r *BulkResult[T]) HasFailures() bool {
+	return len(r.Failed) > 0
+}
+
+// BulkItemError reports the error of a single item of a bulk operation.
+type BulkItemError[T any] struct {
+	Item T
+	Err  error
+}
+
+func (e *BulkItemError[T]) Error() string {
+	return fmt.Sprintf("%v: %v", e.Item, e.Err)
+}
+
+// Unwrap returns the underlying error.
+func (e *BulkItemError[T]) Unwrap() error {
+	return e.Err
+}
+
 // CheckResponse checks the API response for errors, and returns them if present.
 func CheckResponse(r *http.Response) error {
 	switch r.StatusCode {
@@ -1027,12 +1512,63 @@ func CheckResponse(r *http.Response) error {
 			errorResponse.Message = fmt.Sprintf("failed to parse unknown error format: %s", data)
 		} else {
 			errorResponse.Message = parseError(raw)
+			if m, ok := raw.(map[string]interface{}); ok {
+				errorResponse.FieldErrors = parseFieldErrors("", m["message"])
+			}
+		}
+	}
+
+	if r.StatusCode == http.StatusTooManyRequests {
+		rateLimitError := &RateLimitError{ErrorResponse: errorResponse}
+		if v := r.Header.Get(headerRateLimit); v != "" {
+			rateLimitError.Limit, _ = strconv.Atoi(v)
+		}
+		if v := r.Header.Get(headerRateReset); v != "" {
+			if reset, _ := strconv.ParseInt(v, 10, 64); reset > 0 {
+				rateLimitError.Reset = time.Unix(reset, 0)
+			}
 		}
+		return rateLimitError
 	}
 
 	return errorResponse
 }
 
+// parseFieldErrors flattens the "message" attribute of an error response into
+// a list of field errors. Only a message containing a map of properties is
+// considered to contain field errors.
+func parseFieldErrors(prefix string, raw interface{}) []*FieldError {
+	m, ok := raw.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var errs []*FieldError
+	for _, k := range keys {
+		field := k
+		if prefix != "" {
+			field = prefix + "." + k
+		}
+		switch v := m[k].(type) {
+		case string:
+			errs = append(errs, &FieldError{Field: field, Message: v})
+		case []interface{}:
+			for _, msg := range v {
+				errs = append(errs, &FieldError{Field: field, Message: parseError(msg)})
+			}
+		case map[string]interface{}:
+			errs = append(errs, parseFieldErrors(field, v)...)
+		}
+	}
+	return errs
+}
+
 // Format:
 //
 //	{
@@ -24,6 +24,7 @@ import (
 	"io"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
 	"os"
 	"strings"
 	"testing"
@@ -231,6 +232,142 @@ func TestCheckResponseOnHeadRequestError(t *testing.T) {
 	}
 }
 
+func TestCheckResponseFieldErrors(t *testing.T) {
+	c, err := NewClient("")
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	req, err := c.NewRequest(http.MethodPost, "projects", nil, nil)
+	if err != nil {
+		t.Fatalf("Failed to create request: %v", err)
+	}
+
+	resp := &http.Response{
+		Request:    req.Request,
+		StatusCode: http.StatusBadRequest,
+		Body: io.NopCloser(strings.NewReader(`
+		{
+			"message": {
+				"name": ["has already been taken"],
+				"namespace": {
+					"path": ["is too long", "is invalid"]
+				}
+			}
+		}`)),
+	}
+
+	err = CheckResponse(resp)
+
+	var errResp *ErrorResponse
+	if !errors.As(err, &errResp) {
+		t.Fatalf("Expected *ErrorResponse, got %T", err)
+	}
+
+	want := []*FieldError{
+		{Field: "name", Message: "has already been taken"},
+		{Field: "namespace.path", Message: "is too long"},
+		{Field: "namespace.path", Message: "is invalid"},
+	}
+	if len(errResp.FieldErrors) != len(want) {
+		t.Fatalf("Expected %d field errors, got %d", len(want), len(errResp.FieldErrors))
+	}
+	for i, fe := range errResp.FieldErrors {
+		if *fe != *want[i] {
+			t.Errorf("Field error %d: expected %+v, got %+v", i, want[i], fe)
+		}
+	}
+
+	if !errResp.HasFieldError("name", "has already been taken") {
+		t.Error("Expected a field error for name")
+	}
+	if errResp.HasFieldError("path", "") {
+		t.Error("Did not expect a field error for path")
+	}
+}
+
+func TestCheckResponseSentinelErrors(t *testing.T) {
+	c, err := NewClient("")
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	req, err := c.NewRequest(http.MethodGet, "test", nil, nil)
+	if err != nil {
+		t.Fatalf("Failed to create request: %v", err)
+	}
+
+	tests := []struct {
+		status int
+		want   error
+	}{
+		{http.StatusUnauthorized, ErrUnauthorized},
+		{http.StatusForbidden, ErrForbidden},
+		{http.StatusNotFound, ErrNotFound},
+		{http.StatusConflict, ErrConflict},
+		{http.StatusTooManyRequests, ErrRateLimited},
+	}
+
+	for _, tt := range tests {
+		resp := &http.Response{
+			Request:    req.Request,
+			StatusCode: tt.status,
+			Header:     http.Header{},
+			Body:       io.NopCloser(strings.NewReader(`{"message": "error"}`)),
+		}
+
+		err := CheckResponse(resp)
+		if !errors.Is(err, tt.want) {
+			t.Errorf("Status %d: expected errors.Is(%v), got %v", tt.status, tt.want, err)
+		}
+		if tt.want != ErrForbidden && errors.Is(err, ErrForbidden) {
+			t.Errorf("Status %d: did not expect errors.Is(ErrForbidden)", tt.status)
+		}
+	}
+}
+
+func TestCheckResponseRateLimitError(t *testing.T) {
+	c, err := NewClient("")
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	req, err := c.NewRequest(http.MethodGet, "test", nil, nil)
+	if err != nil {
+		t.Fatalf("Failed to create request: %v", err)
+	}
+
+	resp := &http.Response{
+		Request:    req.Request,
+		StatusCode: http.StatusTooManyRequests,
+		Header:     http.Header{},
+		Body:       io.NopCloser(strings.NewReader(`{"message": "Retry later"}`)),
+	}
+	resp.Header.Set(headerRateLimit, "600")
+	resp.Header.Set(headerRateReset, "1700000000")
+
+	err = CheckResponse(resp)
+
+	var rateLimitErr *RateLimitError
+	if !errors.As(err, &rateLimitErr) {
+		t.Fatalf("Expected *RateLimitError, got %T", err)
+	}
+	if rateLimitErr.Limit != 600 {
+		t.Errorf("Expected limit 600, got %d", rateLimitErr.Limit)
+	}
+	if !rateLimitErr.Reset.Equal(time.Unix(1700000000, 0)) {
+		t.Errorf("Expected reset %v, got %v", time.Unix(1700000000, 0), rateLimitErr.Reset)
+	}
+
+	var errResp *ErrorResponse
+	if !errors.As(err, &errResp) || errResp.Message != "{message: Retry later}" {
+		t.Errorf("Expected wrapped *ErrorResponse with message, got %v", err)
+	}
+	if !errors.Is(err, ErrRateLimited) {
+		t.Errorf("Expected ErrRateLimited, got %v", err)
+	}
+}
+
 func TestRequestWithContext(t *testing.T) {
 	c, err := NewClient("")
 	if err != nil {
@@ -267,6 +404,56 @@ func TestPathEscape(t *testing.T) {
 	}
 }
 
+func TestParseID(t *testing.T) {
+	tests := []struct {
+		id   interface{}
+		want string
+	}{
+		{1, "1"},
+		{"diaspora/diaspora", "diaspora/diaspora"},
+	}
+
+	for _, tt := range tests {
+		got, err := parseID(tt.id)
+		if err != nil {
+			t.Errorf("parseID(%#v) returned error: %v", tt.id, err)
+		}
+		if got != tt.want {
+			t.Errorf("parseID(%#v): expected %s, got %s", tt.id, tt.want, got)
+		}
+	}
+
+	for _, id := range []interface{}{1.5, int64(2), NewProjectID(1)} {
+		if _, err := parseID(id); err == nil {
+			t.Errorf("Expected an error for invalid ID type %T", id)
+		}
+	}
+}
+
+func TestProjectPath(t *testing.T) {
+	got, err := ProjectPath("diaspora/diaspora.git", "repository", "branches", "feature/v1.0")
+	if err != nil {
+		t.Fatalf("ProjectPath returned error: %v", err)
+	}
+
+	want := "projects/diaspora%2Fdiaspora%2Egit/repository/branches/feature%2Fv1%2E0"
+	if got != want {
+		t.Errorf("Expected: %s, got %s", want, got)
+	}
+
+	got, err = GroupPath(42, "members")
+	if err != nil {
+		t.Fatalf("GroupPath returned error: %v", err)
+	}
+	if want := "groups/42/members"; got != want {
+		t.Errorf("Expected: %s, got %s", want, got)
+	}
+
+	if _, err := ProjectPath(nil); err == nil {
+		t.Error("Expected an error for an invalid ID type")
+	}
+}
+
 func TestPaginationPopulatePageValuesEmpty(t *testing.T) {
 	wantPageHeaders := map[string]int{
 		xTotal:      0,
@@ -454,3 +641,126 @@ func TestExponentialBackoffLogic(t *testing.T) {
 		t.Fatal("Expected to get a 429 code given the server is hard-coded to return this. Received instead:", resp.StatusCode)
 	}
 }
+
+func TestRetryPolicy(t *testing.T) {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	var backoffCalls int
+	client, err := NewClient("",
+		WithBaseURL(server.URL),
+		WithRetryPolicy(RetryPolicy{
+			MaxAttempts: 2,
+			Backoff: func(_, _ time.Duration, _ int, _ *http.Response) time.Duration {
+				backoffCalls++
+				return 0
+			},
+		}),
+	)
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	attempts := 0
+	mux.HandleFunc("/api/v4/projects/1", func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+
+	_, resp, err := client.Projects.GetProject(1, nil)
+	if err == nil {
+		t.Fatal("Expected an error")
+	}
+	if resp.StatusCode != http.StatusInternalServerError {
+		t.Errorf("Expected status %d, got %d", http.StatusInternalServerError, resp.StatusCode)
+	}
+	if attempts != 2 {
+		t.Errorf("Expected 2 attempts, got %d", attempts)
+	}
+	if backoffCalls != 1 {
+		t.Errorf("Expected 1 backoff call, got %d", backoffCalls)
+	}
+
+	// A per request policy overrides the client policy.
+	attempts = 0
+	_, _, err = client.Projects.GetProject(1, nil, WithRequestRetry(RetryPolicy{MaxAttempts: 1}))
+	if err == nil {
+		t.Fatal("Expected an error")
+	}
+	if attempts != 1 {
+		t.Errorf("Expected 1 attempt, got %d", attempts)
+	}
+}
+
+func TestHead(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/repository/branches/main", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodHead)
+		w.Header().Set("ETag", `W/"abc"`)
+	})
+
+	resp, err := client.Head("projects/1/repository/branches/main", nil)
+	if err != nil {
+		t.Fatalf("Head returned error: %v", err)
+	}
+	if got := resp.Header.Get("ETag"); got != `W/"abc"` {
+		t.Errorf("Head returned ETag %q, want %q", got, `W/"abc"`)
+	}
+
+	_, err = client.Head("projects/1/repository/branches/missing", nil)
+	if !errors.Is(err, ErrNotFound) {
+		t.Errorf("Head returned error %v, want %v", err, ErrNotFound)
+	}
+}
+
+func TestRaw(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/new_things", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			testURL(t, r, "/api/v4/projects/1/new_things?page=2&scope=all")
+			w.Header().Set("X-Next-Page", "3")
+			fmt.Fprint(w, `[{"id": 1}]`)
+		case http.MethodPost:
+			testBody(t, r, `{"name":"thing"}`)
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprint(w, `{"id": 2, "name": "thing"}`)
+		case http.MethodDelete:
+			testBody(t, r, `{"force":true}`)
+			w.WriteHeader(http.StatusConflict)
+			fmt.Fprint(w, `{"message": "Thing is in use"}`)
+		}
+	})
+
+	var things []struct {
+		ID int `json:"id"`
+	}
+	resp, err := client.Raw(context.Background(), http.MethodGet, "projects/1/new_things", url.Values{"scope": {"all"}, "page": {"2"}}, nil, &things)
+	if err != nil {
+		t.Fatalf("Raw returned error: %v", err)
+	}
+	if len(things) != 1 || things[0].ID != 1 {
+		t.Errorf("Raw decoded %+v, want one thing with ID 1", things)
+	}
+	if resp.NextPage != 3 {
+		t.Errorf("Raw returned next page %d, want 3", resp.NextPage)
+	}
+
+	thing := make(map[string]interface{})
+	resp, err = client.Raw(context.Background(), http.MethodPost, "projects/1/new_things", nil, map[string]string{"name": "thing"}, &thing)
+	if err != nil {
+		t.Fatalf("Raw returned error: %v", err)
+	}
+	if resp.StatusCode != http.StatusCreated || thing["name"] != "thing" {
+		t.Errorf("Raw returned status %d and %+v", resp.StatusCode, thing)
+	}
+
+	_, err = client.Raw(context.Background(), http.MethodDelete, "projects/1/new_things", nil, map[string]bool{"force": true}, nil)
+	var errResp *ErrorResponse
+	if !errors.As(err, &errResp) || errResp.Message != "{message: Thing is in use}" {
+		t.Errorf("Raw returned error %v, want conflict", err)
+	}
+}
@@ -1,7 +1,7 @@
 // Deprecated: This module has been migrated to gitlab.com/gitlab-org/api/client-go. See https://gitlab.com/gitlab-org/api/client-go
 module github.com/xanzy/go-gitlab
 
-go 1.19
+go 1.21
 
 require (
 	github.com/google/go-querystring v1.1.0
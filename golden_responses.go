@@ -0,0 +1,93 @@
+package gitlab
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/http"
+	"os"
+	"strings"
+)
+
+// GoldenResponses is a FaultInjector which answers all requests using golden
+// JSON responses from a file system, without sending any request. This
+// allows demos and tests of tools using the client in air-gapped
+// environments.
+//
+// Responses are keyed by the method and escaped URL path of the request,
+// ignoring the query. The response to "GET /api/v4/projects/group%2Fapp"
+// is read from the file "GET/api/v4/projects/group%2Fapp.json". Requests
+// without a golden response get a 404 Not Found response.
+type GoldenResponses struct {
+	fsys fs.FS
+}
+
+// NewGoldenResponses returns a GoldenResponses serving the responses in the
+// given file system.
+func NewGoldenResponses(fsys fs.FS) *GoldenResponses {
+	return &GoldenResponses{fsys: fsys}
+}
+
+// WithGoldenResponses makes the client answer all requests using the golden
+// responses in the given directory. See GoldenResponses for the layout of
+// the directory. It replaces any injector set using WithFaultInjector.
+func WithGoldenResponses(dir string) ClientOptionFunc {
+	return WithFaultInjector(NewGoldenResponses(os.DirFS(dir)))
+}
+
+// goldenResponseName returns the name of the file containing the golden
+// response to the given request.
+func goldenResponseName(req *http.Request) string {
+	return req.Method + "/" + strings.TrimPrefix(req.URL.EscapedPath(), "/") + ".json"
+}
+
+// InjectFault returns the golden response to the request.
+func (g *GoldenResponses) InjectFault(req *http.Request) (*http.Response, error) {
+	name := goldenResponseName(req)
+
+	var body []byte
+	var err error
+	if fs.ValidPath(name) {
+		body, err = fs.ReadFile(g.fsys, name)
+	} else {
+		err = fs.ErrNotExist
+	}
+
+	status := http.StatusOK
+	switch {
+	case errors.Is(err, fs.ErrNotExist):
+		status = http.StatusNotFound
+		body, err = json.Marshal(map[string]string{
+			"message": fmt.Sprintf("404 golden response %s not found", name),
+		})
+		if err != nil {
+			return nil, err
+		}
+	case err != nil:
+		return nil, err
+	case req.Method == http.MethodPost:
+		status = http.StatusCreated
+	case len(body) == 0:
+		status = http.StatusNoContent
+	}
+
+	header := make(http.Header)
+	if len(body) > 0 {
+		header.Set("Content-Type", "application/json")
+	}
+
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", status, http.StatusText(status)),
+		StatusCode:    status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}, nil
+}
@@ -0,0 +1,56 @@
+package gitlab
+
+import (
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGoldenResponses(t *testing.T) {
+	fsys := fstest.MapFS{
+		"GET/api/v4/projects/group%2Fapp.json":             {Data: []byte(`{"id": 1, "path_with_namespace": "group/app"}`)},
+		"POST/api/v4/projects/1/issues.json":               {Data: []byte(`{"id": 10, "iid": 1, "title": "Offline"}`)},
+		"DELETE/api/v4/projects/1/repository/tags/v1.json": {Data: nil},
+	}
+
+	client, err := NewClient("",
+		WithBaseURL("https://gitlab.example.com"),
+		WithFaultInjector(NewGoldenResponses(fsys)),
+	)
+	require.NoError(t, err)
+
+	p, _, err := client.Projects.GetProject("group/app", nil)
+	require.NoError(t, err)
+	require.Equal(t, &Project{ID: 1, PathWithNamespace: "group/app"}, p)
+
+	i, resp, err := client.Issues.CreateIssue(1, &CreateIssueOptions{Title: Ptr("Offline")})
+	require.NoError(t, err)
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+	require.Equal(t, "Offline", i.Title)
+
+	resp, err = client.Tags.DeleteTag(1, "v1")
+	require.NoError(t, err)
+	require.Equal(t, http.StatusNoContent, resp.StatusCode)
+
+	_, resp, err = client.Projects.GetProject(2, nil)
+	require.ErrorIs(t, err, ErrNotFound)
+	require.Equal(t, http.StatusNotFound, resp.StatusCode)
+}
+
+func TestWithGoldenResponses(t *testing.T) {
+	dir := t.TempDir()
+	name := filepath.Join(dir, "GET", "api", "v4", "version.json")
+	require.NoError(t, os.MkdirAll(filepath.Dir(name), 0o755))
+	require.NoError(t, os.WriteFile(name, []byte(`{"version": "17.0.0", "revision": "abc"}`), 0o644))
+
+	client, err := NewClient("", WithBaseURL("https://gitlab.example.com"), WithGoldenResponses(dir))
+	require.NoError(t, err)
+
+	v, _, err := client.Version.GetVersion()
+	require.NoError(t, err)
+	require.Equal(t, &Version{Version: "17.0.0", Revision: "abc"}, v)
+}
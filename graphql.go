@@ -0,0 +1,117 @@
+package gitlab
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// graphQLPath is the path of the GraphQL API relative to the GitLab host.
+const graphQLPath = "api/graphql"
+
+// GraphQLQuery represents a request to the GitLab GraphQL API.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/graphql/
+type GraphQLQuery struct {
+	Query     string                 `json:"query"`
+	Variables map[string]interface{} `json:"variables,omitempty"`
+}
+
+// GraphQLError represents a single error returned by the GraphQL API.
+type GraphQLError struct {
+	Message string        `json:"message"`
+	Path    []interface{} `json:"path,omitempty"`
+}
+
+// GraphQLErrors is returned when the GraphQL API reports one or more errors
+// for a request which was otherwise handled successfully.
+type GraphQLErrors []*GraphQLError
+
+func (e GraphQLErrors) Error() string {
+	msgs := make([]string, 0, len(e))
+	for _, err := range e {
+		msgs = append(msgs, err.Message)
+	}
+	return "graphql: " + strings.Join(msgs, "; ")
+}
+
+// GraphQL sends a query to the GitLab GraphQL API and decodes the data of
+// the response into v. Errors reported in the response are returned as
+// GraphQLErrors.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/graphql/
+func (c *Client) GraphQL(query GraphQLQuery, v interface{}, options ...RequestOptionFunc) (*Response, error) {
+	req, err := c.NewRequest(http.MethodPost, "", query, options)
+	if err != nil {
+		return nil, err
+	}
+
+	// The GraphQL API is not part of the versioned REST API.
+	req.URL.Path = c.hostPath(graphQLPath)
+	req.URL.RawPath = ""
+
+	var result struct {
+		Data   json.RawMessage `json:"data"`
+		Errors GraphQLErrors   `json:"errors"`
+	}
+	resp, err := c.Do(req, &result)
+	if err != nil {
+		return resp, err
+	}
+
+	if len(result.Errors) > 0 {
+		return resp, result.Errors
+	}
+
+	if v != nil && len(result.Data) > 0 {
+		if err := json.Unmarshal(result.Data, v); err != nil {
+			return resp, fmt.Errorf("graphql: failed to decode data: %w", err)
+		}
+	}
+
+	return resp, nil
+}
+
+// globalID returns the GraphQL global ID of the object of the given type
+// with the given numeric ID.
+func globalID(typ string, id int) string {
+	return fmt.Sprintf("gid://gitlab/%s/%d", typ, id)
+}
+
+// projectFullPath returns the full path of a project, as required by the
+// GraphQL API. Projects identified by their numeric ID are looked up first.
+func (c *Client) projectFullPath(pid interface{}, options []RequestOptionFunc) (string, error) {
+	project, err := parseProjectID(pid)
+	if err != nil {
+		return "", err
+	}
+	if _, err := strconv.Atoi(project); err != nil {
+		return project, nil
+	}
+
+	p, _, err := c.Projects.GetProject(project, nil, options...)
+	if err != nil {
+		return "", err
+	}
+	return p.PathWithNamespace, nil
+}
+
+// groupFullPath returns the full path of a group, as required by the GraphQL
+// API. Groups identified by their numeric ID are looked up first.
+func (c *Client) groupFullPath(gid interface{}, options []RequestOptionFunc) (string, error) {
+	group, err := parseGroupID(gid)
+	if err != nil {
+		return "", err
+	}
+	if _, err := strconv.Atoi(group); err != nil {
+		return group, nil
+	}
+
+	g, _, err := c.Groups.GetGroup(group, &GetGroupOptions{WithProjects: Ptr(false)}, options...)
+	if err != nil {
+		return "", err
+	}
+	return g.FullPath, nil
+}
@@ -63,7 +63,7 @@ type ListGroupAccessTokensOptions ListOptions
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/group_access_tokens.html#list-group-access-tokens
 func (s *GroupAccessTokensService) ListGroupAccessTokens(gid interface{}, opt *ListGroupAccessTokensOptions, options ...RequestOptionFunc) ([]*GroupAccessToken, *Response, error) {
-	groups, err := parseID(gid)
+	groups, err := parseGroupID(gid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -88,7 +88,7 @@ func (s *GroupAccessTokensService) ListGroupAccessTokens(gid interface{}, opt *L
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/group_access_tokens.html#get-a-group-access-token
 func (s *GroupAccessTokensService) GetGroupAccessToken(gid interface{}, id int, options ...RequestOptionFunc) (*GroupAccessToken, *Response, error) {
-	groups, err := parseID(gid)
+	groups, err := parseGroupID(gid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -125,7 +125,7 @@ type CreateGroupAccessTokenOptions struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/group_access_tokens.html#create-a-group-access-token
 func (s *GroupAccessTokensService) CreateGroupAccessToken(gid interface{}, opt *CreateGroupAccessTokenOptions, options ...RequestOptionFunc) (*GroupAccessToken, *Response, error) {
-	groups, err := parseID(gid)
+	groups, err := parseGroupID(gid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -160,7 +160,7 @@ type RotateGroupAccessTokenOptions struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/group_access_tokens.html#rotate-a-group-access-token
 func (s *GroupAccessTokensService) RotateGroupAccessToken(gid interface{}, id int, opt *RotateGroupAccessTokenOptions, options ...RequestOptionFunc) (*GroupAccessToken, *Response, error) {
-	groups, err := parseID(gid)
+	groups, err := parseGroupID(gid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -184,7 +184,7 @@ func (s *GroupAccessTokensService) RotateGroupAccessToken(gid interface{}, id in
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/group_access_tokens.html#revoke-a-group-access-token
 func (s *GroupAccessTokensService) RevokeGroupAccessToken(gid interface{}, id int, options ...RequestOptionFunc) (*Response, error) {
-	groups, err := parseID(gid)
+	groups, err := parseGroupID(gid)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,190 @@
+package gitlab
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+// ListGroupActivityOptions represents the available ListGroupActivity()
+// options.
+type ListGroupActivityOptions struct {
+	// Since only lists events created at or after the given time.
+	Since time.Time
+
+	// Cursor resumes a previous listing, by only listing the events of each
+	// project which are newer than the events it returned for that project.
+	// Use the Cursor of the previous GroupActivity. Projects which are not
+	// in the cursor are only bounded by Since.
+	Cursor GroupActivityCursor
+
+	// IncludeSubGroups also lists the events of projects in subgroups.
+	IncludeSubGroups bool
+
+	// Action and TargetType filter the events, like for
+	// ListProjectVisibleEvents.
+	Action     *EventTypeValue
+	TargetType *EventTargetTypeValue
+
+	// Concurrency is the maximum number of projects whose events are
+	// listed at the same time. Defaults to 4.
+	Concurrency int
+}
+
+// GroupActivityCursor contains the ID of the newest listed event of each
+// project, by project ID. Projects are listed one after the other, so a
+// single event ID for all projects would skip events created in a project
+// while later projects were still being listed.
+type GroupActivityCursor map[int]int
+
+// GroupActivity represents the merged events of the projects of a group.
+type GroupActivity struct {
+	// Events are the events of all projects, oldest first.
+	Events []*ProjectEvent
+
+	// Cursor can be passed to a later ListGroupActivity call to only list
+	// the events created since this listing. For projects without events,
+	// the entry of the cursor of the listing is kept.
+	Cursor GroupActivityCursor
+}
+
+// ListGroupActivity lists the events of all projects of a group, merged into
+// a single stream ordered by creation time. The events of the projects are
+// listed concurrently, newest first, until the events are older than Since
+// or the Cursor of the project. Set at least one of them to bound the number
+// of requests.
+//
+// If listing the events of a project fails, no further projects are listed,
+// and the error is returned together with the events listed so far. The
+// Cursor of this partial result only advances for the listed projects, so it
+// can be used to resume the listing.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/events.html#list-a-projects-visible-events
+func (s *EventsService) ListGroupActivity(gid interface{}, opt *ListGroupActivityOptions, options ...RequestOptionFunc) (*GroupActivity, error) {
+	if opt == nil {
+		opt = new(ListGroupActivityOptions)
+	}
+	concurrency := opt.Concurrency
+	if concurrency <= 0 {
+		concurrency = 4
+	}
+
+	projects, err := listAllPages(func(lo ListOptions) ([]*Project, *Response, error) {
+		return s.client.Groups.ListGroupProjects(gid, &ListGroupProjectsOptions{
+			ListOptions:      lo,
+			IncludeSubGroups: Ptr(opt.IncludeSubGroups),
+			Simple:           Ptr(true),
+		}, options...)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	cursor := make(GroupActivityCursor)
+	for pid, id := range opt.Cursor {
+		cursor[pid] = id
+	}
+
+	var (
+		wg     sync.WaitGroup
+		mu     sync.Mutex
+		events []*ProjectEvent
+		first  error
+	)
+	sem := make(chan struct{}, concurrency)
+
+	for _, p := range projects {
+		sem <- struct{}{}
+
+		// Stop listing further projects once listing a project failed.
+		mu.Lock()
+		failed := first != nil
+		mu.Unlock()
+		if failed {
+			<-sem
+			break
+		}
+
+		pid := p.ID
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			pe, err := s.listProjectActivity(pid, opt, options)
+
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				if first == nil {
+					first = err
+				}
+				return
+			}
+			events = append(events, pe...)
+			// The events are listed newest first.
+			if len(pe) > 0 {
+				cursor[pid] = pe[0].ID
+			}
+		}()
+	}
+	wg.Wait()
+
+	sort.SliceStable(events, func(i, j int) bool {
+		ti, tj := eventCreatedAt(events[i]), eventCreatedAt(events[j])
+		if !ti.Equal(tj) {
+			return ti.Before(tj)
+		}
+		return events[i].ID < events[j].ID
+	})
+
+	return &GroupActivity{Events: events, Cursor: cursor}, first
+}
+
+// listProjectActivity lists the events of a single project, newest first,
+// until reaching events which are older than Since or the Cursor of the
+// project.
+func (s *EventsService) listProjectActivity(pid int, opt *ListGroupActivityOptions, options []RequestOptionFunc) ([]*ProjectEvent, error) {
+	cursor := opt.Cursor[pid]
+
+	lo := &ListProjectVisibleEventsOptions{
+		ListOptions: ListOptions{PerPage: 100},
+		Action:      opt.Action,
+		TargetType:  opt.TargetType,
+		Sort:        Ptr("desc"),
+	}
+	if !opt.Since.IsZero() {
+		// The after filter is a date, and excludes events on that date.
+		lo.After = Ptr(ISOTime(opt.Since.AddDate(0, 0, -1)))
+	}
+
+	var events []*ProjectEvent
+	for {
+		pe, resp, err := s.ListProjectVisibleEvents(pid, lo, options...)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, e := range pe {
+			if e.ID <= cursor || (!opt.Since.IsZero() && eventCreatedAt(e).Before(opt.Since)) {
+				return events, nil
+			}
+			events = append(events, e)
+		}
+
+		if resp.NextPage == 0 {
+			break
+		}
+		lo.Page = resp.NextPage
+	}
+
+	return events, nil
+}
+
+// eventCreatedAt returns the creation time of an event, or the zero time if
+// it cannot be parsed.
+func eventCreatedAt(e *ProjectEvent) time.Time {
+	t, _ := time.Parse(time.RFC3339, e.CreatedAt)
+	return t
+}
@@ -0,0 +1,90 @@
+package gitlab
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestEventsService_ListGroupActivity(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/groups/3/projects", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testParams(t, r, "include_subgroups=true&per_page=100&simple=true")
+		fmt.Fprint(w, `[{"id": 1}, {"id": 2}]`)
+	})
+	mux.HandleFunc("/api/v4/projects/1/events", func(w http.ResponseWriter, r *http.Request) {
+		testParams(t, r, "after=2024-04-30&per_page=100&sort=desc")
+		fmt.Fprint(w, `[
+			{"id": 14, "project_id": 1, "action_name": "pushed to", "created_at": "2024-05-01T12:00:00Z"},
+			{"id": 12, "project_id": 1, "action_name": "closed", "created_at": "2024-05-01T10:30:00Z"},
+			{"id": 10, "project_id": 1, "action_name": "opened", "created_at": "2024-05-01T09:00:00Z"},
+			{"id": 7, "project_id": 1, "action_name": "opened", "created_at": "2024-04-30T23:00:00Z"}
+		]`)
+	})
+	mux.HandleFunc("/api/v4/projects/2/events", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("page") == "2" {
+			fmt.Fprint(w, `[{"id": 11, "project_id": 2, "action_name": "commented on", "created_at": "2024-05-01T10:00:00Z"}]`)
+			return
+		}
+		w.Header().Set("X-Next-Page", "2")
+		fmt.Fprint(w, `[{"id": 13, "project_id": 2, "action_name": "closed", "created_at": "2024-05-01T11:00:00Z"}]`)
+	})
+
+	since := time.Date(2024, time.May, 1, 0, 0, 0, 0, time.UTC)
+	activity, err := client.Events.ListGroupActivity(3, &ListGroupActivityOptions{
+		Since:            since,
+		IncludeSubGroups: true,
+		Concurrency:      1,
+	})
+	require.NoError(t, err)
+
+	var ids []int
+	for _, e := range activity.Events {
+		ids = append(ids, e.ID)
+	}
+	require.Equal(t, []int{10, 11, 12, 13, 14}, ids)
+	require.Equal(t, GroupActivityCursor{1: 14, 2: 13}, activity.Cursor)
+
+	// Event 12 of project 1 is newer than the events of project 1 in the
+	// cursor, even though project 2 already has a newer event.
+	activity, err = client.Events.ListGroupActivity(3, &ListGroupActivityOptions{
+		Since:            since,
+		Cursor:           GroupActivityCursor{1: 10, 2: 13},
+		IncludeSubGroups: true,
+	})
+	require.NoError(t, err)
+
+	ids = nil
+	for _, e := range activity.Events {
+		ids = append(ids, e.ID)
+	}
+	require.Equal(t, []int{12, 14}, ids)
+	require.Equal(t, GroupActivityCursor{1: 14, 2: 13}, activity.Cursor)
+}
+
+func TestEventsService_ListGroupActivityError(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/groups/3/projects", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `[{"id": 1}, {"id": 2}, {"id": 4}]`)
+	})
+	mux.HandleFunc("/api/v4/projects/1/events", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `[{"id": 8, "project_id": 1, "action_name": "opened", "created_at": "2024-05-01T09:00:00Z"}]`)
+	})
+	mux.HandleFunc("/api/v4/projects/4/events", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("listed the events of a project after an error")
+	})
+
+	activity, err := client.Events.ListGroupActivity(3, &ListGroupActivityOptions{
+		Cursor:      GroupActivityCursor{1: 5, 2: 5, 4: 5},
+		Concurrency: 1,
+	})
+	require.ErrorIs(t, err, ErrNotFound)
+	require.Len(t, activity.Events, 1)
+	require.Equal(t, GroupActivityCursor{1: 8, 2: 5, 4: 5}, activity.Cursor)
+}
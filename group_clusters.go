@@ -60,7 +60,7 @@ func (v GroupCluster) String() string {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/group_clusters.html#list-group-clusters
 func (s *GroupClustersService) ListClusters(pid interface{}, options ...RequestOptionFunc) ([]*GroupCluster, *Response, error) {
-	group, err := parseID(pid)
+	group, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -85,7 +85,7 @@ func (s *GroupClustersService) ListClusters(pid interface{}, options ...RequestO
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/group_clusters.html#get-a-single-group-cluster
 func (s *GroupClustersService) GetCluster(pid interface{}, cluster int, options ...RequestOptionFunc) (*GroupCluster, *Response, error) {
-	group, err := parseID(pid)
+	group, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -133,7 +133,7 @@ type AddGroupPlatformKubernetesOptions struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/group_clusters.html#add-existing-cluster-to-group
 func (s *GroupClustersService) AddCluster(pid interface{}, opt *AddGroupClusterOptions, options ...RequestOptionFunc) (*GroupCluster, *Response, error) {
-	group, err := parseID(pid)
+	group, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -177,7 +177,7 @@ type EditGroupPlatformKubernetesOptions struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/group_clusters.html#edit-group-cluster
 func (s *GroupClustersService) EditCluster(pid interface{}, cluster int, opt *EditGroupClusterOptions, options ...RequestOptionFunc) (*GroupCluster, *Response, error) {
-	group, err := parseID(pid)
+	group, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -202,7 +202,7 @@ func (s *GroupClustersService) EditCluster(pid interface{}, cluster int, opt *Ed
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/group_clusters.html#delete-group-cluster
 func (s *GroupClustersService) DeleteCluster(pid interface{}, cluster int, options ...RequestOptionFunc) (*Response, error) {
-	group, err := parseID(pid)
+	group, err := parseProjectID(pid)
 	if err != nil {
 		return nil, err
 	}
@@ -58,7 +58,7 @@ type ListGroupEpicBoardsOptions ListOptions
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/group_epic_boards.html#list-all-epic-boards-in-a-group
 func (s *GroupEpicBoardsService) ListGroupEpicBoards(gid interface{}, opt *ListGroupEpicBoardsOptions, options ...RequestOptionFunc) ([]*GroupEpicBoard, *Response, error) {
-	group, err := parseID(gid)
+	group, err := parseGroupID(gid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -83,7 +83,7 @@ func (s *GroupEpicBoardsService) ListGroupEpicBoards(gid interface{}, opt *ListG
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/group_epic_boards.html#single-group-epic-board
 func (s *GroupEpicBoardsService) GetGroupEpicBoard(gid interface{}, board int, options ...RequestOptionFunc) (*GroupEpicBoard, *Response, error) {
-	group, err := parseID(gid)
+	group, err := parseGroupID(gid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -19,6 +19,7 @@ package gitlab
 import (
 	"fmt"
 	"net/http"
+	"net/url"
 	"time"
 )
 
@@ -61,7 +62,7 @@ type ListGroupHooksOptions ListOptions
 //
 // GitLab API docs: https://docs.gitlab.com/ee/api/groups.html#list-group-hooks
 func (s *GroupsService) ListGroupHooks(gid interface{}, opt *ListGroupHooksOptions, options ...RequestOptionFunc) ([]*GroupHook, *Response, error) {
-	group, err := parseID(gid)
+	group, err := parseGroupID(gid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -85,7 +86,7 @@ func (s *GroupsService) ListGroupHooks(gid interface{}, opt *ListGroupHooksOptio
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/groups.html#get-group-hook
 func (s *GroupsService) GetGroupHook(pid interface{}, hook int, options ...RequestOptionFunc) (*GroupHook, *Response, error) {
-	group, err := parseID(pid)
+	group, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -136,7 +137,7 @@ type AddGroupHookOptions struct {
 //
 // GitLab API docs: https://docs.gitlab.com/ee/api/groups.html#add-group-hook
 func (s *GroupsService) AddGroupHook(gid interface{}, opt *AddGroupHookOptions, options ...RequestOptionFunc) (*GroupHook, *Response, error) {
-	group, err := parseID(gid)
+	group, err := parseGroupID(gid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -189,7 +190,7 @@ type EditGroupHookOptions struct {
 // Gitlab API docs:
 // https://docs.gitlab.com/ee/api/groups.html#edit-group-hook
 func (s *GroupsService) EditGroupHook(pid interface{}, hook int, opt *EditGroupHookOptions, options ...RequestOptionFunc) (*GroupHook, *Response, error) {
-	group, err := parseID(pid)
+	group, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -215,7 +216,7 @@ func (s *GroupsService) EditGroupHook(pid interface{}, hook int, opt *EditGroupH
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/groups.html#delete-group-hook
 func (s *GroupsService) DeleteGroupHook(pid interface{}, hook int, options ...RequestOptionFunc) (*Response, error) {
-	group, err := parseID(pid)
+	group, err := parseProjectID(pid)
 	if err != nil {
 		return nil, err
 	}
@@ -234,7 +235,7 @@ func (s *GroupsService) DeleteGroupHook(pid interface{}, hook int, options ...Re
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/group_webhooks.html#trigger-a-test-group-hook
 func (s *GroupsService) TriggerTestGroupHook(pid interface{}, hook int, trigger GroupHookTrigger, options ...RequestOptionFunc) (*Response, error) {
-	group, err := parseID(pid)
+	group, err := parseProjectID(pid)
 	if err != nil {
 		return nil, err
 	}
@@ -253,11 +254,11 @@ func (s *GroupsService) TriggerTestGroupHook(pid interface{}, hook int, trigger
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/groups.html#set-a-custom-header
 func (s *GroupsService) SetGroupCustomHeader(gid interface{}, hook int, key string, opt *SetHookCustomHeaderOptions, options ...RequestOptionFunc) (*Response, error) {
-	group, err := parseID(gid)
+	group, err := parseGroupID(gid)
 	if err != nil {
 		return nil, err
 	}
-	u := fmt.Sprintf("groups/%s/hooks/%d/custom_headers/%s", PathEscape(group), hook, key)
+	u := fmt.Sprintf("groups/%s/hooks/%d/custom_headers/%s", PathEscape(group), hook, url.PathEscape(key))
 
 	req, err := s.client.NewRequest(http.MethodPut, u, opt, options)
 	if err != nil {
@@ -272,11 +273,11 @@ func (s *GroupsService) SetGroupCustomHeader(gid interface{}, hook int, key stri
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/groups.html#delete-a-custom-header
 func (s *GroupsService) DeleteGroupCustomHeader(gid interface{}, hook int, key string, options ...RequestOptionFunc) (*Response, error) {
-	group, err := parseID(gid)
+	group, err := parseGroupID(gid)
 	if err != nil {
 		return nil, err
 	}
-	u := fmt.Sprintf("groups/%s/hooks/%d/custom_headers/%s", PathEscape(group), hook, key)
+	u := fmt.Sprintf("groups/%s/hooks/%d/custom_headers/%s", PathEscape(group), hook, url.PathEscape(key))
 
 	req, err := s.client.NewRequest(http.MethodDelete, u, nil, options)
 	if err != nil {
@@ -40,7 +40,7 @@ type GroupImportExportService struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/group_import_export.html#schedule-new-export
 func (s *GroupImportExportService) ScheduleExport(gid interface{}, options ...RequestOptionFunc) (*Response, error) {
-	group, err := parseID(gid)
+	group, err := parseGroupID(gid)
 	if err != nil {
 		return nil, err
 	}
@@ -59,7 +59,7 @@ func (s *GroupImportExportService) ScheduleExport(gid interface{}, options ...Re
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/group_import_export.html#export-download
 func (s *GroupImportExportService) ExportDownload(gid interface{}, options ...RequestOptionFunc) (*bytes.Reader, *Response, error) {
-	group, err := parseID(gid)
+	group, err := parseGroupID(gid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -79,6 +79,26 @@ func (s *GroupImportExportService) ExportDownload(gid interface{}, options ...Re
 	return bytes.NewReader(exportDownload.Bytes()), resp, err
 }
 
+// ExportDownloadTo streams the finished export into the given writer, which
+// avoids keeping large exports in memory.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/group_import_export.html#export-download
+func (s *GroupImportExportService) ExportDownloadTo(gid interface{}, w io.Writer, options ...RequestOptionFunc) (*Response, error) {
+	group, err := parseGroupID(gid)
+	if err != nil {
+		return nil, err
+	}
+	u := fmt.Sprintf("groups/%s/export/download", PathEscape(group))
+
+	req, err := s.client.NewRequest(http.MethodGet, u, nil, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, w)
+}
+
 // GroupImportFileOptions represents the available ImportFile() options.
 //
 // GitLab API docs:
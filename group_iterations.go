@@ -69,7 +69,7 @@ type ListGroupIterationsOptions struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/group_iterations.html#list-group-iterations
 func (s *GroupIterationsService) ListGroupIterations(gid interface{}, opt *ListGroupIterationsOptions, options ...RequestOptionFunc) ([]*GroupIteration, *Response, error) {
-	group, err := parseID(gid)
+	group, err := parseGroupID(gid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -55,7 +55,7 @@ type ListGroupLabelsOptions struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/group_labels.html#list-group-labels
 func (s *GroupLabelsService) ListGroupLabels(gid interface{}, opt *ListGroupLabelsOptions, options ...RequestOptionFunc) ([]*GroupLabel, *Response, error) {
-	group, err := parseID(gid)
+	group, err := parseGroupID(gid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -80,7 +80,7 @@ func (s *GroupLabelsService) ListGroupLabels(gid interface{}, opt *ListGroupLabe
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/group_labels.html#get-a-single-group-label
 func (s *GroupLabelsService) GetGroupLabel(gid interface{}, lid interface{}, options ...RequestOptionFunc) (*GroupLabel, *Response, error) {
-	group, err := parseID(gid)
+	group, err := parseGroupID(gid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -121,7 +121,7 @@ type CreateGroupLabelOptions struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/group_labels.html#create-a-new-group-label
 func (s *GroupLabelsService) CreateGroupLabel(gid interface{}, opt *CreateGroupLabelOptions, options ...RequestOptionFunc) (*GroupLabel, *Response, error) {
-	group, err := parseID(gid)
+	group, err := parseGroupID(gid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -154,7 +154,7 @@ type DeleteGroupLabelOptions struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/group_labels.html#delete-a-group-label
 func (s *GroupLabelsService) DeleteGroupLabel(gid interface{}, lid interface{}, opt *DeleteGroupLabelOptions, options ...RequestOptionFunc) (*Response, error) {
-	group, err := parseID(gid)
+	group, err := parseGroupID(gid)
 	if err != nil {
 		return nil, err
 	}
@@ -194,7 +194,7 @@ type UpdateGroupLabelOptions struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/group_labels.html#update-a-group-label
 func (s *GroupLabelsService) UpdateGroupLabel(gid interface{}, lid interface{}, opt *UpdateGroupLabelOptions, options ...RequestOptionFunc) (*GroupLabel, *Response, error) {
-	group, err := parseID(gid)
+	group, err := parseGroupID(gid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -229,7 +229,7 @@ func (s *GroupLabelsService) UpdateGroupLabel(gid interface{}, lid interface{},
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/group_labels.html#subscribe-to-a-group-label
 func (s *GroupLabelsService) SubscribeToGroupLabel(gid interface{}, lid interface{}, options ...RequestOptionFunc) (*GroupLabel, *Response, error) {
-	group, err := parseID(gid)
+	group, err := parseGroupID(gid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -260,7 +260,7 @@ func (s *GroupLabelsService) SubscribeToGroupLabel(gid interface{}, lid interfac
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/group_labels.html#unsubscribe-from-a-group-label
 func (s *GroupLabelsService) UnsubscribeFromGroupLabel(gid interface{}, lid interface{}, options ...RequestOptionFunc) (*Response, error) {
-	group, err := parseID(gid)
+	group, err := parseGroupID(gid)
 	if err != nil {
 		return nil, err
 	}
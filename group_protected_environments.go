@@ -19,6 +19,7 @@ package gitlab
 import (
 	"fmt"
 	"net/http"
+	"net/url"
 )
 
 // GroupProtectedEnvironmentsService handles communication with the group-level
@@ -83,7 +84,7 @@ type ListGroupProtectedEnvironmentsOptions ListOptions
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/group_protected_environments.html#list-group-level-protected-environments
 func (s *GroupProtectedEnvironmentsService) ListGroupProtectedEnvironments(gid interface{}, opt *ListGroupProtectedEnvironmentsOptions, options ...RequestOptionFunc) ([]*GroupProtectedEnvironment, *Response, error) {
-	group, err := parseID(gid)
+	group, err := parseGroupID(gid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -109,11 +110,11 @@ func (s *GroupProtectedEnvironmentsService) ListGroupProtectedEnvironments(gid i
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/group_protected_environments.html#get-a-single-protected-environment
 func (s *GroupProtectedEnvironmentsService) GetGroupProtectedEnvironment(gid interface{}, environment string, options ...RequestOptionFunc) (*GroupProtectedEnvironment, *Response, error) {
-	group, err := parseID(gid)
+	group, err := parseGroupID(gid)
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("groups/%s/protected_environments/%s", PathEscape(group), environment)
+	u := fmt.Sprintf("groups/%s/protected_environments/%s", PathEscape(group), url.PathEscape(environment))
 
 	req, err := s.client.NewRequest(http.MethodGet, u, nil, options)
 	if err != nil {
@@ -172,7 +173,7 @@ type GroupEnvironmentApprovalRuleOptions struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/group_protected_environments.html#protect-a-single-environment
 func (s *GroupProtectedEnvironmentsService) ProtectGroupEnvironment(gid interface{}, opt *ProtectGroupEnvironmentOptions, options ...RequestOptionFunc) (*GroupProtectedEnvironment, *Response, error) {
-	group, err := parseID(gid)
+	group, err := parseGroupID(gid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -240,11 +241,11 @@ type UpdateGroupEnvironmentApprovalRuleOptions struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/group_protected_environments.html#update-a-protected-environment
 func (s *GroupProtectedEnvironmentsService) UpdateGroupProtectedEnvironment(gid interface{}, environment string, opt *UpdateGroupProtectedEnvironmentOptions, options ...RequestOptionFunc) (*GroupProtectedEnvironment, *Response, error) {
-	group, err := parseID(gid)
+	group, err := parseGroupID(gid)
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("groups/%s/protected_environments/%s", PathEscape(group), environment)
+	u := fmt.Sprintf("groups/%s/protected_environments/%s", PathEscape(group), url.PathEscape(environment))
 
 	req, err := s.client.NewRequest(http.MethodPut, u, opt, options)
 	if err != nil {
@@ -266,11 +267,11 @@ func (s *GroupProtectedEnvironmentsService) UpdateGroupProtectedEnvironment(gid
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/group_protected_environments.html#unprotect-a-single-environment
 func (s *GroupProtectedEnvironmentsService) UnprotectGroupEnvironment(gid interface{}, environment string, options ...RequestOptionFunc) (*Response, error) {
-	group, err := parseID(gid)
+	group, err := parseGroupID(gid)
 	if err != nil {
 		return nil, err
 	}
-	u := fmt.Sprintf("groups/%s/protected_environments/%s", PathEscape(group), environment)
+	u := fmt.Sprintf("groups/%s/protected_environments/%s", PathEscape(group), url.PathEscape(environment))
 
 	req, err := s.client.NewRequest(http.MethodDelete, u, nil, options)
 	if err != nil {
@@ -0,0 +1,111 @@
+package gitlab
+
+import "strings"
+
+// GroupRestrictions represents the security related restrictions of a group.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/groups.html#update-group
+type GroupRestrictions struct {
+	// AllowedEmailDomains restricts membership to users with an email
+	// address of one of the domains. Empty means no restriction.
+	AllowedEmailDomains []string
+
+	// IPRestrictionRanges restricts access to the group to the IP address
+	// ranges, in CIDR notation. Empty means no restriction.
+	IPRestrictionRanges []string
+
+	// ShareWithGroupLock prevents sharing projects of the group with other
+	// groups.
+	ShareWithGroupLock bool
+
+	// MembershipLock prevents adding members to projects of the group.
+	MembershipLock bool
+
+	// PreventForkingOutsideGroup prevents forking projects of the group to
+	// namespaces outside the group.
+	PreventForkingOutsideGroup bool
+
+	// PreventSharingGroupsOutsideHierarchy prevents inviting groups outside
+	// the hierarchy of the group.
+	PreventSharingGroupsOutsideHierarchy bool
+}
+
+// GetGroupRestrictions gets the restrictions of a group. Some restrictions
+// are only available for top-level groups, or with GitLab Premium.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/groups.html#details-of-a-group
+func (s *GroupsService) GetGroupRestrictions(gid interface{}, options ...RequestOptionFunc) (*GroupRestrictions, *Response, error) {
+	g, resp, err := s.GetGroup(gid, &GetGroupOptions{WithProjects: Ptr(false)}, options...)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return groupRestrictions(g), resp, nil
+}
+
+// UpdateGroupRestrictionsOptions represents the available
+// UpdateGroupRestrictions() options. Set AllowedEmailDomains or
+// IPRestrictionRanges to an empty list to remove the restriction.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/groups.html#update-group
+type UpdateGroupRestrictionsOptions struct {
+	AllowedEmailDomains                  *[]string
+	IPRestrictionRanges                  *[]string
+	ShareWithGroupLock                   *bool
+	MembershipLock                       *bool
+	PreventForkingOutsideGroup           *bool
+	PreventSharingGroupsOutsideHierarchy *bool
+}
+
+// UpdateGroupRestrictions updates the restrictions of a group, and returns
+// the resulting restrictions.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/groups.html#update-group
+func (s *GroupsService) UpdateGroupRestrictions(gid interface{}, opt *UpdateGroupRestrictionsOptions, options ...RequestOptionFunc) (*GroupRestrictions, *Response, error) {
+	if opt == nil {
+		opt = new(UpdateGroupRestrictionsOptions)
+	}
+
+	uopt := &UpdateGroupOptions{
+		ShareWithGroupLock:                   opt.ShareWithGroupLock,
+		MembershipLock:                       opt.MembershipLock,
+		PreventForkingOutsideGroup:           opt.PreventForkingOutsideGroup,
+		PreventSharingGroupsOutsideHierarchy: opt.PreventSharingGroupsOutsideHierarchy,
+	}
+	if opt.AllowedEmailDomains != nil {
+		uopt.AllowedEmailDomainsList = Ptr(strings.Join(*opt.AllowedEmailDomains, ","))
+	}
+	if opt.IPRestrictionRanges != nil {
+		uopt.IPRestrictionRanges = Ptr(strings.Join(*opt.IPRestrictionRanges, ","))
+	}
+
+	g, resp, err := s.UpdateGroup(gid, uopt, options...)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return groupRestrictions(g), resp, nil
+}
+
+func groupRestrictions(g *Group) *GroupRestrictions {
+	return &GroupRestrictions{
+		AllowedEmailDomains:                  splitRestrictionList(g.AllowedEmailDomainsList),
+		IPRestrictionRanges:                  splitRestrictionList(g.IPRestrictionRanges),
+		ShareWithGroupLock:                   g.ShareWithGroupLock,
+		MembershipLock:                       g.MembershipLock,
+		PreventForkingOutsideGroup:           g.PreventForkingOutsideGroup,
+		PreventSharingGroupsOutsideHierarchy: g.PreventSharingGroupsOutsideHierarchy,
+	}
+}
+
+// splitRestrictionList splits a comma separated list of domains or IP
+// ranges.
+func splitRestrictionList(list string) []string {
+	var items []string
+	for _, item := range strings.Split(list, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
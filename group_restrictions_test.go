@@ -0,0 +1,53 @@
+package gitlab
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetGroupRestrictions(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/groups/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{
+			"id": 1,
+			"allowed_email_domains_list": "example.com, example.org",
+			"ip_restriction_ranges": "10.0.0.0/8",
+			"share_with_group_lock": true,
+			"prevent_sharing_groups_outside_hierarchy": true
+		}`)
+	})
+
+	restrictions, _, err := client.Groups.GetGroupRestrictions(1)
+	require.NoError(t, err)
+	require.Equal(t, &GroupRestrictions{
+		AllowedEmailDomains:                  []string{"example.com", "example.org"},
+		IPRestrictionRanges:                  []string{"10.0.0.0/8"},
+		ShareWithGroupLock:                   true,
+		PreventSharingGroupsOutsideHierarchy: true,
+	}, restrictions)
+}
+
+func TestUpdateGroupRestrictions(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/groups/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		testBody(t, r, `{"membership_lock":true,"ip_restriction_ranges":"","allowed_email_domains_list":"example.com,example.org"}`)
+		fmt.Fprint(w, `{"id": 1, "allowed_email_domains_list": "example.com,example.org", "membership_lock": true}`)
+	})
+
+	restrictions, _, err := client.Groups.UpdateGroupRestrictions(1, &UpdateGroupRestrictionsOptions{
+		AllowedEmailDomains: &[]string{"example.com", "example.org"},
+		IPRestrictionRanges: &[]string{},
+		MembershipLock:      Ptr(true),
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"example.com", "example.org"}, restrictions.AllowedEmailDomains)
+	require.Nil(t, restrictions.IPRestrictionRanges)
+	require.True(t, restrictions.MembershipLock)
+}
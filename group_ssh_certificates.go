@@ -30,7 +30,7 @@ type GroupSSHCertificate struct {
 // Gitlab API docs:
 // https://docs.gitlab.com/ee/api/group_ssh_certificates.html#get-all-ssh-certificates-for-a-particular-group
 func (s *GroupSSHCertificatesService) ListGroupSSHCertificates(gid interface{}, options ...RequestOptionFunc) ([]*GroupSSHCertificate, *Response, error) {
-	group, err := parseID(gid)
+	group, err := parseGroupID(gid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -65,7 +65,7 @@ type CreateGroupSSHCertificateOptions struct {
 // Gitlab API docs:
 // https://docs.gitlab.com/ee/api/group_ssh_certificates.html#create-ssh-certificate
 func (s *GroupSSHCertificatesService) CreateGroupSSHCertificate(gid interface{}, opt *CreateGroupSSHCertificateOptions, options ...RequestOptionFunc) (*GroupSSHCertificate, *Response, error) {
-	group, err := parseID(gid)
+	group, err := parseGroupID(gid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -90,7 +90,7 @@ func (s *GroupSSHCertificatesService) CreateGroupSSHCertificate(gid interface{},
 // Gitlab API docs:
 // https://docs.gitlab.com/ee/api/group_ssh_certificates.html#delete-group-ssh-certificate
 func (s *GroupSSHCertificatesService) DeleteGroupSSHCertificate(gid interface{}, cert int, options ...RequestOptionFunc) (*Response, error) {
-	group, err := parseID(gid)
+	group, err := parseGroupID(gid)
 	if err != nil {
 		return nil, err
 	}
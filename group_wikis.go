@@ -58,7 +58,7 @@ type ListGroupWikisOptions struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/group_wikis.html#list-wiki-pages
 func (s *GroupWikisService) ListGroupWikis(gid interface{}, opt *ListGroupWikisOptions, options ...RequestOptionFunc) ([]*GroupWiki, *Response, error) {
-	group, err := parseID(gid)
+	group, err := parseGroupID(gid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -92,7 +92,7 @@ type GetGroupWikiPageOptions struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/group_wikis.html#get-a-wiki-page
 func (s *GroupWikisService) GetGroupWikiPage(gid interface{}, slug string, opt *GetGroupWikiPageOptions, options ...RequestOptionFunc) (*GroupWiki, *Response, error) {
-	group, err := parseID(gid)
+	group, err := parseGroupID(gid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -128,7 +128,7 @@ type CreateGroupWikiPageOptions struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/group_wikis.html#create-a-new-wiki-page
 func (s *GroupWikisService) CreateGroupWikiPage(gid interface{}, opt *CreateGroupWikiPageOptions, options ...RequestOptionFunc) (*GroupWiki, *Response, error) {
-	group, err := parseID(gid)
+	group, err := parseGroupID(gid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -164,7 +164,7 @@ type EditGroupWikiPageOptions struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/group_wikis.html#edit-an-existing-wiki-page
 func (s *GroupWikisService) EditGroupWikiPage(gid interface{}, slug string, opt *EditGroupWikiPageOptions, options ...RequestOptionFunc) (*GroupWiki, *Response, error) {
-	group, err := parseID(gid)
+	group, err := parseGroupID(gid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -189,7 +189,7 @@ func (s *GroupWikisService) EditGroupWikiPage(gid interface{}, slug string, opt
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/group_wikis.html#delete-a-wiki-page
 func (s *GroupWikisService) DeleteGroupWikiPage(gid interface{}, slug string, options ...RequestOptionFunc) (*Response, error) {
-	group, err := parseID(gid)
+	group, err := parseGroupID(gid)
 	if err != nil {
 		return nil, err
 	}
@@ -77,18 +77,19 @@ type Group struct {
 		GroupAccessLevel int      `json:"group_access_level"`
 		ExpiresAt        *ISOTime `json:"expires_at"`
 	} `json:"shared_with_groups"`
-	LDAPCN                         string             `json:"ldap_cn"`
-	LDAPAccess                     AccessLevelValue   `json:"ldap_access"`
-	LDAPGroupLinks                 []*LDAPGroupLink   `json:"ldap_group_links"`
-	SAMLGroupLinks                 []*SAMLGroupLink   `json:"saml_group_links"`
-	SharedRunnersMinutesLimit      int                `json:"shared_runners_minutes_limit"`
-	ExtraSharedRunnersMinutesLimit int                `json:"extra_shared_runners_minutes_limit"`
-	PreventForkingOutsideGroup     bool               `json:"prevent_forking_outside_group"`
-	MarkedForDeletionOn            *ISOTime           `json:"marked_for_deletion_on"`
-	CreatedAt                      *time.Time         `json:"created_at"`
-	IPRestrictionRanges            string             `json:"ip_restriction_ranges"`
-	AllowedEmailDomainsList        string             `json:"allowed_email_domains_list"`
-	WikiAccessLevel                AccessControlValue `json:"wiki_access_level"`
+	LDAPCN                               string             `json:"ldap_cn"`
+	LDAPAccess                           AccessLevelValue   `json:"ldap_access"`
+	LDAPGroupLinks                       []*LDAPGroupLink   `json:"ldap_group_links"`
+	SAMLGroupLinks                       []*SAMLGroupLink   `json:"saml_group_links"`
+	SharedRunnersMinutesLimit            int                `json:"shared_runners_minutes_limit"`
+	ExtraSharedRunnersMinutesLimit       int                `json:"extra_shared_runners_minutes_limit"`
+	PreventForkingOutsideGroup           bool               `json:"prevent_forking_outside_group"`
+	PreventSharingGroupsOutsideHierarchy bool               `json:"prevent_sharing_groups_outside_hierarchy"`
+	MarkedForDeletionOn                  *ISOTime           `json:"marked_for_deletion_on"`
+	CreatedAt                            *time.Time         `json:"created_at"`
+	IPRestrictionRanges                  string             `json:"ip_restriction_ranges"`
+	AllowedEmailDomainsList              string             `json:"allowed_email_domains_list"`
+	WikiAccessLevel                      AccessControlValue `json:"wiki_access_level"`
 
 	// Deprecated: Use EmailsEnabled instead
 	EmailsDisabled bool `json:"emails_disabled"`
@@ -200,7 +201,7 @@ type ListSubGroupsOptions ListGroupsOptions
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/groups.html#list-a-groups-subgroups
 func (s *GroupsService) ListSubGroups(gid interface{}, opt *ListSubGroupsOptions, options ...RequestOptionFunc) ([]*Group, *Response, error) {
-	group, err := parseID(gid)
+	group, err := parseGroupID(gid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -232,7 +233,7 @@ type ListDescendantGroupsOptions ListGroupsOptions
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/groups.html#list-a-groups-descendant-groups
 func (s *GroupsService) ListDescendantGroups(gid interface{}, opt *ListDescendantGroupsOptions, options ...RequestOptionFunc) ([]*Group, *Response, error) {
-	group, err := parseID(gid)
+	group, err := parseGroupID(gid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -281,7 +282,7 @@ type ListGroupProjectsOptions struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/groups.html#list-a-groups-projects
 func (s *GroupsService) ListGroupProjects(gid interface{}, opt *ListGroupProjectsOptions, options ...RequestOptionFunc) ([]*Project, *Response, error) {
-	group, err := parseID(gid)
+	group, err := parseGroupID(gid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -314,7 +315,7 @@ type GetGroupOptions struct {
 //
 // GitLab API docs: https://docs.gitlab.com/ee/api/groups.html#details-of-a-group
 func (s *GroupsService) GetGroup(gid interface{}, opt *GetGroupOptions, options ...RequestOptionFunc) (*Group, *Response, error) {
-	group, err := parseID(gid)
+	group, err := parseGroupID(gid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -334,12 +335,27 @@ func (s *GroupsService) GetGroup(gid interface{}, opt *GetGroupOptions, options
 	return g, resp, nil
 }
 
+// GroupExists reports whether a group exists and is visible to the
+// authenticated user, without retrieving the group.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/groups.html#details-of-a-group
+func (s *GroupsService) GroupExists(gid interface{}, options ...RequestOptionFunc) (bool, *Response, error) {
+	group, err := parseGroupID(gid)
+	if err != nil {
+		return false, nil, err
+	}
+	u := fmt.Sprintf("groups/%s", PathEscape(group))
+
+	return s.client.exists(u, nil, options)
+}
+
 // DownloadAvatar downloads a group avatar.
 //
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/groups.html#download-a-group-avatar
 func (s *GroupsService) DownloadAvatar(gid interface{}, options ...RequestOptionFunc) (*bytes.Reader, *Response, error) {
-	group, err := parseID(gid)
+	group, err := parseGroupID(gid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -445,11 +461,11 @@ func (s *GroupsService) CreateGroup(opt *CreateGroupOptions, options ...RequestO
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/groups.html#transfer-project-to-group
 func (s *GroupsService) TransferGroup(gid interface{}, pid interface{}, options ...RequestOptionFunc) (*Group, *Response, error) {
-	group, err := parseID(gid)
+	group, err := parseGroupID(gid)
 	if err != nil {
 		return nil, nil, err
 	}
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -483,7 +499,7 @@ type TransferSubGroupOptions struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/groups.html#transfer-a-group-to-a-new-parent-group--turn-a-subgroup-to-a-top-level-group
 func (s *GroupsService) TransferSubGroup(gid interface{}, opt *TransferSubGroupOptions, options ...RequestOptionFunc) (*Group, *Response, error) {
-	group, err := parseID(gid)
+	group, err := parseGroupID(gid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -547,7 +563,7 @@ type UpdateGroupOptions struct {
 //
 // GitLab API docs: https://docs.gitlab.com/ee/api/groups.html#update-group
 func (s *GroupsService) UpdateGroup(gid interface{}, opt *UpdateGroupOptions, options ...RequestOptionFunc) (*Group, *Response, error) {
-	group, err := parseID(gid)
+	group, err := parseGroupID(gid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -586,7 +602,7 @@ func (s *GroupsService) UpdateGroup(gid interface{}, opt *UpdateGroupOptions, op
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/groups.html#upload-a-group-avatar
 func (s *GroupsService) UploadAvatar(gid interface{}, avatar io.Reader, filename string, options ...RequestOptionFunc) (*Group, *Response, error) {
-	group, err := parseID(gid)
+	group, err := parseGroupID(gid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -626,7 +642,7 @@ type DeleteGroupOptions struct {
 //
 // GitLab API docs: https://docs.gitlab.com/ee/api/groups.html#remove-group
 func (s *GroupsService) DeleteGroup(gid interface{}, opt *DeleteGroupOptions, options ...RequestOptionFunc) (*Response, error) {
-	group, err := parseID(gid)
+	group, err := parseGroupID(gid)
 	if err != nil {
 		return nil, err
 	}
@@ -645,7 +661,7 @@ func (s *GroupsService) DeleteGroup(gid interface{}, opt *DeleteGroupOptions, op
 // GitLap API docs:
 // https://docs.gitlab.com/ee/api/groups.html#restore-group-marked-for-deletion
 func (s *GroupsService) RestoreGroup(gid interface{}, options ...RequestOptionFunc) (*Group, *Response, error) {
-	group, err := parseID(gid)
+	group, err := parseGroupID(gid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -708,7 +724,7 @@ type ListProvisionedUsersOptions struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/groups.html#list-provisioned-users
 func (s *GroupsService) ListProvisionedUsers(gid interface{}, opt *ListProvisionedUsersOptions, options ...RequestOptionFunc) ([]*User, *Response, error) {
-	group, err := parseID(gid)
+	group, err := parseGroupID(gid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -734,7 +750,7 @@ func (s *GroupsService) ListProvisionedUsers(gid interface{}, opt *ListProvision
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/groups.html#list-ldap-group-links
 func (s *GroupsService) ListGroupLDAPLinks(gid interface{}, options ...RequestOptionFunc) ([]*LDAPGroupLink, *Response, error) {
-	group, err := parseID(gid)
+	group, err := parseGroupID(gid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -781,7 +797,7 @@ type DeleteGroupLDAPLinkWithCNOrFilterOptions struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/groups.html#add-ldap-group-link-with-cn-or-filter
 func (s *GroupsService) AddGroupLDAPLink(gid interface{}, opt *AddGroupLDAPLinkOptions, options ...RequestOptionFunc) (*LDAPGroupLink, *Response, error) {
-	group, err := parseID(gid)
+	group, err := parseGroupID(gid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -807,7 +823,7 @@ func (s *GroupsService) AddGroupLDAPLink(gid interface{}, opt *AddGroupLDAPLinkO
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/groups.html#delete-ldap-group-link
 func (s *GroupsService) DeleteGroupLDAPLink(gid interface{}, cn string, options ...RequestOptionFunc) (*Response, error) {
-	group, err := parseID(gid)
+	group, err := parseGroupID(gid)
 	if err != nil {
 		return nil, err
 	}
@@ -827,7 +843,7 @@ func (s *GroupsService) DeleteGroupLDAPLink(gid interface{}, cn string, options
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/groups.html#delete-ldap-group-link-with-cn-or-filter
 func (s *GroupsService) DeleteGroupLDAPLinkWithCNOrFilter(gid interface{}, opts *DeleteGroupLDAPLinkWithCNOrFilterOptions, options ...RequestOptionFunc) (*Response, error) {
-	group, err := parseID(gid)
+	group, err := parseGroupID(gid)
 	if err != nil {
 		return nil, err
 	}
@@ -847,7 +863,7 @@ func (s *GroupsService) DeleteGroupLDAPLinkWithCNOrFilter(gid interface{}, opts
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/groups.html#delete-ldap-group-link
 func (s *GroupsService) DeleteGroupLDAPLinkForProvider(gid interface{}, provider, cn string, options ...RequestOptionFunc) (*Response, error) {
-	group, err := parseID(gid)
+	group, err := parseGroupID(gid)
 	if err != nil {
 		return nil, err
 	}
@@ -872,7 +888,7 @@ func (s *GroupsService) DeleteGroupLDAPLinkForProvider(gid interface{}, provider
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/groups.html#list-saml-group-links
 func (s *GroupsService) ListGroupSAMLLinks(gid interface{}, options ...RequestOptionFunc) ([]*SAMLGroupLink, *Response, error) {
-	group, err := parseID(gid)
+	group, err := parseGroupID(gid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -898,7 +914,7 @@ func (s *GroupsService) ListGroupSAMLLinks(gid interface{}, options ...RequestOp
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/groups.html#get-saml-group-link
 func (s *GroupsService) GetGroupSAMLLink(gid interface{}, samlGroupName string, options ...RequestOptionFunc) (*SAMLGroupLink, *Response, error) {
-	group, err := parseID(gid)
+	group, err := parseGroupID(gid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -934,7 +950,7 @@ type AddGroupSAMLLinkOptions struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/groups.html#add-saml-group-link
 func (s *GroupsService) AddGroupSAMLLink(gid interface{}, opt *AddGroupSAMLLinkOptions, options ...RequestOptionFunc) (*SAMLGroupLink, *Response, error) {
-	group, err := parseID(gid)
+	group, err := parseGroupID(gid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -960,7 +976,7 @@ func (s *GroupsService) AddGroupSAMLLink(gid interface{}, opt *AddGroupSAMLLinkO
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/groups.html#delete-saml-group-link
 func (s *GroupsService) DeleteGroupSAMLLink(gid interface{}, samlGroupName string, options ...RequestOptionFunc) (*Response, error) {
-	group, err := parseID(gid)
+	group, err := parseGroupID(gid)
 	if err != nil {
 		return nil, err
 	}
@@ -989,7 +1005,7 @@ type ShareGroupWithGroupOptions struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/groups.html#create-a-link-to-share-a-group-with-another-group
 func (s *GroupsService) ShareGroupWithGroup(gid interface{}, opt *ShareGroupWithGroupOptions, options ...RequestOptionFunc) (*Group, *Response, error) {
-	group, err := parseID(gid)
+	group, err := parseGroupID(gid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -1014,7 +1030,7 @@ func (s *GroupsService) ShareGroupWithGroup(gid interface{}, opt *ShareGroupWith
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/groups.html#delete-link-sharing-group-with-another-group
 func (s *GroupsService) UnshareGroupFromGroup(gid interface{}, groupID int, options ...RequestOptionFunc) (*Response, error) {
-	group, err := parseID(gid)
+	group, err := parseGroupID(gid)
 	if err != nil {
 		return nil, err
 	}
@@ -1055,7 +1071,7 @@ type GroupPushRules struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/groups.html#get-group-push-rules
 func (s *GroupsService) GetGroupPushRules(gid interface{}, options ...RequestOptionFunc) (*GroupPushRules, *Response, error) {
-	group, err := parseID(gid)
+	group, err := parseGroupID(gid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -1101,7 +1117,7 @@ type AddGroupPushRuleOptions struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/groups.html#add-group-push-rule
 func (s *GroupsService) AddGroupPushRule(gid interface{}, opt *AddGroupPushRuleOptions, options ...RequestOptionFunc) (*GroupPushRules, *Response, error) {
-	group, err := parseID(gid)
+	group, err := parseGroupID(gid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -1147,7 +1163,7 @@ type EditGroupPushRuleOptions struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/groups.html#edit-group-push-rule
 func (s *GroupsService) EditGroupPushRule(gid interface{}, opt *EditGroupPushRuleOptions, options ...RequestOptionFunc) (*GroupPushRules, *Response, error) {
-	group, err := parseID(gid)
+	group, err := parseGroupID(gid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -1172,7 +1188,7 @@ func (s *GroupsService) EditGroupPushRule(gid interface{}, opt *EditGroupPushRul
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/groups.html#delete-group-push-rule
 func (s *GroupsService) DeleteGroupPushRule(gid interface{}, options ...RequestOptionFunc) (*Response, error) {
-	group, err := parseID(gid)
+	group, err := parseGroupID(gid)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,231 @@
+package gitlab
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"time"
+)
+
+const defaultHealthCheckInterval = 30 * time.Second
+
+// InstanceHealthState represents the health of a GitLab instance.
+type InstanceHealthState string
+
+// The health states of a GitLab instance.
+const (
+	// InstanceHealthy is an instance which is live and ready to serve
+	// requests.
+	InstanceHealthy InstanceHealthState = "healthy"
+
+	// InstanceDegraded is an instance which is live, but not ready to serve
+	// (all) requests, like during maintenance or an upgrade.
+	InstanceDegraded InstanceHealthState = "degraded"
+
+	// InstanceUnavailable is an instance which cannot be reached, or which
+	// reports it is not live.
+	InstanceUnavailable InstanceHealthState = "unavailable"
+)
+
+// InstanceHealth represents the result of a health check of an instance.
+type InstanceHealth struct {
+	State     InstanceHealthState
+	CheckedAt time.Time
+
+	// Live and Ready are the results of the liveness and readiness probes.
+	// They are nil if the probes cannot be accessed, as they are only
+	// accessible from allowed IP addresses. The health is then determined
+	// using the version endpoint only.
+	Live  *bool
+	Ready *bool
+
+	// Version is the version of the instance, if it could be retrieved.
+	Version string
+
+	// Err is the error which made the instance degraded or unavailable.
+	Err error
+}
+
+// CheckInstanceHealth checks the health of the instance, using the liveness,
+// readiness and version endpoints. Requests are not retried, so the check
+// reports the current state.
+//
+// GitLab docs:
+// https://docs.gitlab.com/ee/administration/monitoring/health_check.html
+func (c *Client) CheckInstanceHealth(options ...RequestOptionFunc) *InstanceHealth {
+	options = append([]RequestOptionFunc{WithRequestRetry(RetryPolicy{MaxAttempts: 1})}, options...)
+	h := &InstanceHealth{CheckedAt: time.Now()}
+
+	live, liveErr := c.probeHealth("-/liveness", options)
+	ready, readyErr := c.probeHealth("-/readiness", options)
+	h.Live, h.Ready = live, ready
+
+	v, _, err := c.Version.GetVersion(options...)
+	if err == nil {
+		h.Version = v.Version
+	}
+
+	var errResp *ErrorResponse
+	switch {
+	case liveErr != nil:
+		// The instance is either not live, or could not be reached at all.
+		h.State, h.Err = InstanceUnavailable, liveErr
+	case readyErr != nil:
+		h.State, h.Err = InstanceDegraded, readyErr
+	case err == nil, errors.Is(err, ErrNotFound):
+		h.State = InstanceHealthy
+	case !errors.As(err, &errResp):
+		h.State, h.Err = InstanceUnavailable, err
+	case errResp.Response.StatusCode >= http.StatusInternalServerError:
+		h.State, h.Err = InstanceDegraded, err
+	default:
+		// The instance responded, but the client may not use the endpoint.
+		h.State = InstanceHealthy
+	}
+
+	return h
+}
+
+// probeHealth requests a health check endpoint. It returns nil if the
+// endpoint cannot be accessed, and an error if the instance cannot be
+// reached or fails the check.
+func (c *Client) probeHealth(path string, options []RequestOptionFunc) (*bool, error) {
+	req, err := c.NewRequest(http.MethodGet, "", nil, options)
+	if err != nil {
+		return nil, err
+	}
+
+	// The probes are not part of the REST API.
+	req.URL.Path = c.hostPath(path)
+	req.URL.RawPath = ""
+
+	_, err = c.Do(req, nil)
+
+	if err == nil {
+		return Ptr(true), nil
+	}
+	if errors.Is(err, ErrNotFound) {
+		return nil, nil
+	}
+
+	var errResp *ErrorResponse
+	if !errors.As(err, &errResp) {
+		return nil, err
+	}
+	switch errResp.Response.StatusCode {
+	case http.StatusUnauthorized, http.StatusForbidden:
+		return nil, nil
+	default:
+		return Ptr(false), err
+	}
+}
+
+// HealthWatchdogOptions represents the available NewHealthWatchdog()
+// options.
+type HealthWatchdogOptions struct {
+	// Interval is the time between health checks. Defaults to 30 seconds.
+	Interval time.Duration
+
+	// OnChange, if set, is called when the health state of the instance
+	// changes, including after the first check, when from is nil.
+	OnChange func(from, to *InstanceHealth)
+}
+
+// HealthWatchdog periodically checks the health of an instance, so long
+// running automation can pause while the instance is degraded or
+// unavailable, like during maintenance, instead of failing.
+type HealthWatchdog struct {
+	client  *Client
+	opt     HealthWatchdogOptions
+	options []RequestOptionFunc
+
+	mu      sync.Mutex
+	health  *InstanceHealth
+	changed chan struct{}
+}
+
+// NewHealthWatchdog returns a HealthWatchdog for the instance of the client.
+// Use Run to start checking.
+func (c *Client) NewHealthWatchdog(opt *HealthWatchdogOptions, options ...RequestOptionFunc) *HealthWatchdog {
+	w := &HealthWatchdog{client: c, options: options, changed: make(chan struct{})}
+	if opt != nil {
+		w.opt = *opt
+	}
+	if w.opt.Interval <= 0 {
+		w.opt.Interval = defaultHealthCheckInterval
+	}
+	return w
+}
+
+// Run checks the health of the instance right away and then periodically,
+// until the context is done.
+func (w *HealthWatchdog) Run(ctx context.Context) {
+	ticker := time.NewTicker(w.opt.Interval)
+	defer ticker.Stop()
+
+	for {
+		w.Check(ctx)
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// Check checks the health of the instance once, and returns the result. A
+// check interrupted by the context is returned, but not recorded.
+func (w *HealthWatchdog) Check(ctx context.Context) *InstanceHealth {
+	h := w.client.CheckInstanceHealth(append([]RequestOptionFunc{WithContext(ctx)}, w.options...)...)
+	if ctx.Err() != nil {
+		// A probe failed by the cancellation says nothing about the instance.
+		return h
+	}
+
+	w.mu.Lock()
+	from := w.health
+	w.health = h
+	changed := from == nil || from.State != h.State
+	if changed {
+		close(w.changed)
+		w.changed = make(chan struct{})
+	}
+	w.mu.Unlock()
+
+	if changed && w.opt.OnChange != nil {
+		w.opt.OnChange(from, h)
+	}
+
+	return h
+}
+
+// Health returns the result of the last health check, or nil if the health
+// was not checked yet.
+func (w *HealthWatchdog) Health() *InstanceHealth {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.health
+}
+
+// WaitHealthy blocks until the last health check found the instance healthy,
+// or the context is done. It relies on Run to check the health.
+func (w *HealthWatchdog) WaitHealthy(ctx context.Context) error {
+	for {
+		w.mu.Lock()
+		healthy := w.health != nil && w.health.State == InstanceHealthy
+		changed := w.changed
+		w.mu.Unlock()
+
+		if healthy {
+			return nil
+		}
+
+		select {
+		case <-changed:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
@@ -0,0 +1,163 @@
+package gitlab
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckInstanceHealth(t *testing.T) {
+	mux, client := setup(t)
+
+	var ready atomic.Bool
+	ready.Store(true)
+
+	mux.HandleFunc("/-/liveness", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		w.Write([]byte(`{"status":"ok"}`))
+	})
+	mux.HandleFunc("/-/readiness", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		if !ready.Load() {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			w.Write([]byte(`{"status":"failed"}`))
+			return
+		}
+		w.Write([]byte(`{"status":"ok"}`))
+	})
+	mux.HandleFunc("/api/v4/version", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		w.Write([]byte(`{"version":"16.5.0-ee","revision":"abc"}`))
+	})
+
+	h := client.CheckInstanceHealth()
+	require.Equal(t, InstanceHealthy, h.State)
+	require.Equal(t, Ptr(true), h.Live)
+	require.Equal(t, Ptr(true), h.Ready)
+	require.Equal(t, "16.5.0-ee", h.Version)
+	require.NoError(t, h.Err)
+
+	ready.Store(false)
+
+	h = client.CheckInstanceHealth()
+	require.Equal(t, InstanceDegraded, h.State)
+	require.Equal(t, Ptr(true), h.Live)
+	require.Equal(t, Ptr(false), h.Ready)
+	require.Error(t, h.Err)
+}
+
+func TestCheckInstanceHealthWithoutProbes(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/version", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		w.WriteHeader(http.StatusBadGateway)
+	})
+
+	h := client.CheckInstanceHealth()
+	require.Equal(t, InstanceDegraded, h.State)
+	require.Nil(t, h.Live)
+	require.Nil(t, h.Ready)
+	require.Error(t, h.Err)
+}
+
+func TestHealthWatchdog(t *testing.T) {
+	mux, client := setup(t)
+
+	var ready atomic.Bool
+
+	mux.HandleFunc("/-/liveness", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"status":"ok"}`))
+	})
+	mux.HandleFunc("/-/readiness", func(w http.ResponseWriter, r *http.Request) {
+		if !ready.Load() {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Write([]byte(`{"status":"ok"}`))
+	})
+	mux.HandleFunc("/api/v4/version", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"version":"16.5.0"}`))
+	})
+
+	var (
+		mu          sync.Mutex
+		transitions [][2]InstanceHealthState
+	)
+	w := client.NewHealthWatchdog(&HealthWatchdogOptions{
+		Interval: 10 * time.Millisecond,
+		OnChange: func(from, to *InstanceHealth) {
+			mu.Lock()
+			defer mu.Unlock()
+			var f InstanceHealthState
+			if from != nil {
+				f = from.State
+			}
+			transitions = append(transitions, [2]InstanceHealthState{f, to.State})
+		},
+	})
+	require.Nil(t, w.Health())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go w.Run(ctx)
+
+	require.Eventually(t, func() bool {
+		h := w.Health()
+		return h != nil && h.State == InstanceDegraded
+	}, time.Second, 5*time.Millisecond)
+
+	waitCtx, waitCancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer waitCancel()
+	require.ErrorIs(t, w.WaitHealthy(waitCtx), context.DeadlineExceeded)
+
+	ready.Store(true)
+
+	waitCtx, waitCancel = context.WithTimeout(context.Background(), time.Second)
+	defer waitCancel()
+	require.NoError(t, w.WaitHealthy(waitCtx))
+
+	want := [][2]InstanceHealthState{
+		{"", InstanceDegraded},
+		{InstanceDegraded, InstanceHealthy},
+	}
+	require.Eventually(t, func() bool {
+		mu.Lock()
+		defer mu.Unlock()
+		return len(transitions) == len(want)
+	}, time.Second, 5*time.Millisecond)
+
+	mu.Lock()
+	defer mu.Unlock()
+	require.Equal(t, want, transitions)
+}
+
+func TestHealthWatchdogCheckCanceled(t *testing.T) {
+	mux, client := setup(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	mux.HandleFunc("/api/v4/version", func(w http.ResponseWriter, r *http.Request) {
+		cancel()
+		<-r.Context().Done()
+	})
+
+	var changes atomic.Int32
+	w := client.NewHealthWatchdog(&HealthWatchdogOptions{
+		OnChange: func(from, to *InstanceHealth) {
+			changes.Add(1)
+		},
+	})
+
+	h := w.Check(ctx)
+	require.Equal(t, InstanceUnavailable, h.State)
+	require.ErrorIs(t, h.Err, context.Canceled)
+	require.Nil(t, w.Health())
+	require.Zero(t, changes.Load())
+}
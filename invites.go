@@ -58,7 +58,7 @@ type ListPendingInvitationsOptions struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/invitations.html#list-all-invitations-pending-for-a-group-or-project
 func (s *InvitesService) ListPendingGroupInvitations(gid interface{}, opt *ListPendingInvitationsOptions, options ...RequestOptionFunc) ([]*PendingInvite, *Response, error) {
-	group, err := parseID(gid)
+	group, err := parseGroupID(gid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -83,7 +83,7 @@ func (s *InvitesService) ListPendingGroupInvitations(gid interface{}, opt *ListP
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/invitations.html#list-all-invitations-pending-for-a-group-or-project
 func (s *InvitesService) ListPendingProjectInvitations(pid interface{}, opt *ListPendingInvitationsOptions, options ...RequestOptionFunc) ([]*PendingInvite, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -130,7 +130,7 @@ type InvitesResult struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/invitations.html#add-a-member-to-a-group-or-project
 func (s *InvitesService) GroupInvites(gid interface{}, opt *InvitesOptions, options ...RequestOptionFunc) (*InvitesResult, *Response, error) {
-	group, err := parseID(gid)
+	group, err := parseGroupID(gid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -155,7 +155,7 @@ func (s *InvitesService) GroupInvites(gid interface{}, opt *InvitesOptions, opti
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/invitations.html#add-a-member-to-a-group-or-project
 func (s *InvitesService) ProjectInvites(pid interface{}, opt *InvitesOptions, options ...RequestOptionFunc) (*InvitesResult, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
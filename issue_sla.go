@@ -0,0 +1,227 @@
+package gitlab
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+// IssueBreachKind describes why an issue is reported as breaching.
+type IssueBreachKind string
+
+// The kinds of issue breaches.
+const (
+	// DueDateBreach is an open issue whose due date has passed.
+	DueDateBreach IssueBreachKind = "due_date"
+
+	// SLABreach is an open issue with an SLA label which is older than the
+	// SLA allows.
+	SLABreach IssueBreachKind = "sla"
+)
+
+// IssueSLA defines the maximum age of open issues with a label.
+type IssueSLA struct {
+	// Label is the label the SLA applies to, like "severity::1".
+	Label string
+
+	// MaxAge is the maximum time an issue with the label may be open,
+	// measured from the creation of the issue.
+	MaxAge time.Duration
+}
+
+// IssueBreach represents an open issue breaching its due date or an SLA.
+type IssueBreach struct {
+	Issue *Issue
+	Kind  IssueBreachKind
+
+	// Label is the label of the breached SLA. It is empty for due date
+	// breaches.
+	Label string
+
+	// Deadline is the time the issue should have been closed by.
+	Deadline time.Time
+
+	// Overdue is the time passed since the deadline.
+	Overdue time.Duration
+}
+
+// ListGroupIssueBreachesOptions represents the available
+// ListGroupIssueBreaches() options.
+type ListGroupIssueBreachesOptions struct {
+	// DueDates reports open issues whose due date has passed.
+	DueDates bool
+
+	// SLAs are the label-defined SLAs to check.
+	SLAs []IssueSLA
+
+	// Concurrency is the maximum number of requests sent at the same time.
+	// Defaults to 4.
+	Concurrency int
+
+	// Now is the time breaches are determined at. Defaults to the current
+	// time.
+	Now time.Time
+}
+
+// ListGroupIssueBreaches lists the open issues of a group, including its
+// subgroups, which breach their due date or one of the given SLAs. An issue
+// breaching multiple SLAs is reported once per SLA. Pages are fetched
+// concurrently, and breaches are sorted by how long they are overdue, longest
+// first.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/issues.html#list-group-issues
+func (s *IssuesService) ListGroupIssueBreaches(gid interface{}, opt *ListGroupIssueBreachesOptions, options ...RequestOptionFunc) ([]*IssueBreach, error) {
+	if opt == nil {
+		opt = new(ListGroupIssueBreachesOptions)
+	}
+	now := opt.Now
+	if now.IsZero() {
+		now = time.Now()
+	}
+	concurrency := opt.Concurrency
+	if concurrency <= 0 {
+		concurrency = 4
+	}
+
+	f := &issueFetcher{
+		service: s,
+		gid:     gid,
+		options: options,
+		sem:     make(chan struct{}, concurrency),
+	}
+
+	if opt.DueDates {
+		f.fetch(&ListGroupIssuesOptions{
+			State:   Ptr("opened"),
+			DueDate: Ptr("overdue"),
+		}, func(i *Issue) *IssueBreach {
+			if i.DueDate == nil {
+				return nil
+			}
+			// An issue is due by the end of its due date.
+			d := time.Time(*i.DueDate)
+			deadline := time.Date(d.Year(), d.Month(), d.Day(), 0, 0, 0, 0, now.Location()).AddDate(0, 0, 1)
+			if !now.After(deadline) {
+				return nil
+			}
+			return &IssueBreach{Issue: i, Kind: DueDateBreach, Deadline: deadline, Overdue: now.Sub(deadline)}
+		})
+	}
+
+	for _, sla := range opt.SLAs {
+		sla := sla
+		f.fetch(&ListGroupIssuesOptions{
+			State:         Ptr("opened"),
+			Labels:        &LabelOptions{sla.Label},
+			CreatedBefore: Ptr(now.Add(-sla.MaxAge)),
+		}, func(i *Issue) *IssueBreach {
+			if i.CreatedAt == nil {
+				return nil
+			}
+			deadline := i.CreatedAt.Add(sla.MaxAge)
+			if !now.After(deadline) {
+				return nil
+			}
+			return &IssueBreach{Issue: i, Kind: SLABreach, Label: sla.Label, Deadline: deadline, Overdue: now.Sub(deadline)}
+		})
+	}
+
+	f.wg.Wait()
+	if f.err != nil {
+		return nil, f.err
+	}
+
+	breaches := f.breaches
+	sort.SliceStable(breaches, func(i, j int) bool {
+		if breaches[i].Overdue != breaches[j].Overdue {
+			return breaches[i].Overdue > breaches[j].Overdue
+		}
+		return breaches[i].Issue.ID < breaches[j].Issue.ID
+	})
+
+	return breaches, nil
+}
+
+// issueFetcher fetches the pages of group issue listings concurrently, using
+// a semaphore to bound the number of requests in flight.
+type issueFetcher struct {
+	service *IssuesService
+	gid     interface{}
+	options []RequestOptionFunc
+	sem     chan struct{}
+	wg      sync.WaitGroup
+
+	mu       sync.Mutex
+	breaches []*IssueBreach
+	err      error
+}
+
+// fetch lists all pages of the given listing, and records the breaches check
+// returns for the listed issues. The first page is fetched to learn the
+// number of pages, after which the others are fetched concurrently. If
+// GitLab does not report the number of pages, they are fetched in order.
+func (f *issueFetcher) fetch(opt *ListGroupIssuesOptions, check func(*Issue) *IssueBreach) {
+	f.wg.Add(1)
+	go func() {
+		defer f.wg.Done()
+
+		first := *opt
+		first.ListOptions = ListOptions{Page: 1, PerPage: 100}
+		resp, ok := f.page(&first, check)
+		if !ok {
+			return
+		}
+
+		if resp.TotalPages == 0 {
+			for resp.NextPage != 0 {
+				next := first
+				next.Page = resp.NextPage
+				if resp, ok = f.page(&next, check); !ok {
+					return
+				}
+			}
+			return
+		}
+
+		for page := 2; page <= resp.TotalPages; page++ {
+			next := first
+			next.Page = page
+			f.wg.Add(1)
+			go func() {
+				defer f.wg.Done()
+				f.page(&next, check)
+			}()
+		}
+	}()
+}
+
+// page fetches a single page, and reports whether fetching should continue.
+func (f *issueFetcher) page(opt *ListGroupIssuesOptions, check func(*Issue) *IssueBreach) (*Response, bool) {
+	f.sem <- struct{}{}
+	defer func() { <-f.sem }()
+
+	f.mu.Lock()
+	failed := f.err != nil
+	f.mu.Unlock()
+	if failed {
+		return nil, false
+	}
+
+	issues, resp, err := f.service.ListGroupIssues(f.gid, opt, f.options...)
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if err != nil {
+		if f.err == nil {
+			f.err = err
+		}
+		return nil, false
+	}
+	for _, i := range issues {
+		if b := check(i); b != nil {
+			f.breaches = append(f.breaches, b)
+		}
+	}
+
+	return resp, true
+}
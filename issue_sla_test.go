@@ -0,0 +1,73 @@
+package gitlab
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestListGroupIssueBreaches(t *testing.T) {
+	mux, client := setup(t)
+
+	now := time.Date(2024, 3, 10, 12, 0, 0, 0, time.UTC)
+
+	mux.HandleFunc("/api/v4/groups/1/issues", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		q := r.URL.Query()
+		require.Equal(t, "opened", q.Get("state"))
+
+		switch {
+		case q.Get("due_date") == "overdue":
+			w.Header().Set("X-Total-Pages", "2")
+			switch q.Get("page") {
+			case "1":
+				fmt.Fprint(w, `[{"id":1,"due_date":"2024-03-08"},{"id":2,"due_date":"2024-03-10"}]`)
+			case "2":
+				fmt.Fprint(w, `[{"id":3,"due_date":"2024-03-09"}]`)
+			default:
+				t.Errorf("unexpected page %q", q.Get("page"))
+			}
+		case q.Get("labels") == "severity::1":
+			require.Equal(t, "2024-03-09T12:00:00Z", q.Get("created_before"))
+			fmt.Fprint(w, `[{"id":4,"created_at":"2024-03-08T12:00:00Z"}]`)
+		default:
+			t.Errorf("unexpected query %q", r.URL.RawQuery)
+		}
+	})
+
+	breaches, err := client.Issues.ListGroupIssueBreaches(1, &ListGroupIssueBreachesOptions{
+		DueDates:    true,
+		SLAs:        []IssueSLA{{Label: "severity::1", MaxAge: 24 * time.Hour}},
+		Concurrency: 2,
+		Now:         now,
+	})
+	require.NoError(t, err)
+	require.Len(t, breaches, 3)
+
+	require.Equal(t, 1, breaches[0].Issue.ID)
+	require.Equal(t, DueDateBreach, breaches[0].Kind)
+	require.Equal(t, time.Date(2024, 3, 9, 0, 0, 0, 0, time.UTC), breaches[0].Deadline)
+	require.Equal(t, 36*time.Hour, breaches[0].Overdue)
+
+	require.Equal(t, 4, breaches[1].Issue.ID)
+	require.Equal(t, SLABreach, breaches[1].Kind)
+	require.Equal(t, "severity::1", breaches[1].Label)
+	require.Equal(t, 24*time.Hour, breaches[1].Overdue)
+
+	require.Equal(t, 3, breaches[2].Issue.ID)
+	require.Equal(t, 12*time.Hour, breaches[2].Overdue)
+}
+
+func TestListGroupIssueBreachesError(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/groups/1/issues", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+	})
+
+	_, err := client.Issues.ListGroupIssueBreaches(1, &ListGroupIssueBreachesOptions{DueDates: true})
+	require.Error(t, err)
+}
@@ -18,9 +18,11 @@ package gitlab
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"reflect"
+	"strings"
 	"time"
 )
 
@@ -276,7 +278,7 @@ type ListGroupIssuesOptions struct {
 //
 // GitLab API docs: https://docs.gitlab.com/ee/api/issues.html#list-group-issues
 func (s *IssuesService) ListGroupIssues(pid interface{}, opt *ListGroupIssuesOptions, options ...RequestOptionFunc) ([]*Issue, *Response, error) {
-	group, err := parseID(pid)
+	group, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -339,7 +341,7 @@ type ListProjectIssuesOptions struct {
 //
 // GitLab API docs: https://docs.gitlab.com/ee/api/issues.html#list-project-issues
 func (s *IssuesService) ListProjectIssues(pid interface{}, opt *ListProjectIssuesOptions, options ...RequestOptionFunc) ([]*Issue, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -383,7 +385,7 @@ func (s *IssuesService) GetIssueByID(issue int, options ...RequestOptionFunc) (*
 //
 // GitLab API docs: https://docs.gitlab.com/ee/api/issues.html#single-project-issue
 func (s *IssuesService) GetIssue(pid interface{}, issue int, options ...RequestOptionFunc) (*Issue, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -427,7 +429,7 @@ type CreateIssueOptions struct {
 //
 // GitLab API docs: https://docs.gitlab.com/ee/api/issues.html#new-issue
 func (s *IssuesService) CreateIssue(pid interface{}, opt *CreateIssueOptions, options ...RequestOptionFunc) (*Issue, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -441,6 +443,9 @@ func (s *IssuesService) CreateIssue(pid interface{}, opt *CreateIssueOptions, op
 	i := new(Issue)
 	resp, err := s.client.Do(req, i)
 	if err != nil {
+		if opt != nil {
+			err = newTierError(err, issueTierFeatures(opt.Weight, opt.EpicID))
+		}
 		return nil, resp, err
 	}
 
@@ -469,11 +474,12 @@ type UpdateIssueOptions struct {
 }
 
 // UpdateIssue updates an existing project issue. This function is also used
-// to mark an issue as closed.
+// to mark an issue as closed. Note that GitLab CE silently ignores the weight
+// of an issue, so setting it on an unlicensed instance does not fail.
 //
 // GitLab API docs: https://docs.gitlab.com/ee/api/issues.html#edit-issues
 func (s *IssuesService) UpdateIssue(pid interface{}, issue int, opt *UpdateIssueOptions, options ...RequestOptionFunc) (*Issue, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -487,6 +493,9 @@ func (s *IssuesService) UpdateIssue(pid interface{}, issue int, opt *UpdateIssue
 	i := new(Issue)
 	resp, err := s.client.Do(req, i)
 	if err != nil {
+		if opt != nil {
+			err = newTierError(err, issueTierFeatures(opt.Weight, opt.EpicID))
+		}
 		return nil, resp, err
 	}
 
@@ -497,7 +506,7 @@ func (s *IssuesService) UpdateIssue(pid interface{}, issue int, opt *UpdateIssue
 //
 // GitLab API docs: https://docs.gitlab.com/ee/api/issues.html#delete-an-issue
 func (s *IssuesService) DeleteIssue(pid interface{}, issue int, options ...RequestOptionFunc) (*Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, err
 	}
@@ -523,7 +532,7 @@ type ReorderIssueOptions struct {
 //
 // GitLab API docs: https://docs.gitlab.com/ee/api/issues.html#reorder-an-issue
 func (s *IssuesService) ReorderIssue(pid interface{}, issue int, opt *ReorderIssueOptions, options ...RequestOptionFunc) (*Issue, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -555,7 +564,7 @@ type MoveIssueOptions struct {
 //
 // GitLab API docs: https://docs.gitlab.com/ee/api/issues.html#move-an-issue
 func (s *IssuesService) MoveIssue(pid interface{}, issue int, opt *MoveIssueOptions, options ...RequestOptionFunc) (*Issue, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -582,7 +591,7 @@ func (s *IssuesService) MoveIssue(pid interface{}, issue int, opt *MoveIssueOpti
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/issues.html#subscribe-to-an-issue
 func (s *IssuesService) SubscribeToIssue(pid interface{}, issue int, options ...RequestOptionFunc) (*Issue, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -609,7 +618,7 @@ func (s *IssuesService) SubscribeToIssue(pid interface{}, issue int, options ...
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/issues.html#unsubscribe-from-an-issue
 func (s *IssuesService) UnsubscribeFromIssue(pid interface{}, issue int, options ...RequestOptionFunc) (*Issue, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -636,7 +645,7 @@ func (s *IssuesService) UnsubscribeFromIssue(pid interface{}, issue int, options
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/issues.html#create-a-to-do-item
 func (s *IssuesService) CreateTodo(pid interface{}, issue int, options ...RequestOptionFunc) (*Todo, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -669,7 +678,7 @@ type ListMergeRequestsClosingIssueOptions ListOptions
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/issues.html#list-merge-requests-that-close-a-particular-issue-on-merge
 func (s *IssuesService) ListMergeRequestsClosingIssue(pid interface{}, issue int, opt *ListMergeRequestsClosingIssueOptions, options ...RequestOptionFunc) ([]*MergeRequest, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -702,7 +711,7 @@ type ListMergeRequestsRelatedToIssueOptions ListOptions
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/issues.html#list-merge-requests-related-to-issue
 func (s *IssuesService) ListMergeRequestsRelatedToIssue(pid interface{}, issue int, opt *ListMergeRequestsRelatedToIssueOptions, options ...RequestOptionFunc) ([]*MergeRequest, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -770,7 +779,7 @@ func (s *IssuesService) GetTimeSpent(pid interface{}, issue int, options ...Requ
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/issues.html#participants-on-issues
 func (s *IssuesService) GetParticipants(pid interface{}, issue int, options ...RequestOptionFunc) ([]*BasicUser, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -789,3 +798,193 @@ func (s *IssuesService) GetParticipants(pid interface{}, issue int, options ...R
 
 	return bu, resp, nil
 }
+
+// tierFeature describes a feature which is only available in a paid tier.
+type tierFeature struct {
+	name string
+	tier string
+}
+
+// issueTierFeatures returns the paid features used by the given issue
+// options.
+func issueTierFeatures(weight, epicID *int) []tierFeature {
+	var features []tierFeature
+	if weight != nil {
+		features = append(features, tierFeature{"weight", "Premium"})
+	}
+	if epicID != nil {
+		features = append(features, tierFeature{"epic", "Premium"})
+	}
+	return features
+}
+
+// tierErrorTerms are the terms GitLab uses in error messages when a feature
+// is not available because of the license of the instance.
+var tierErrorTerms = []string{"license", "licensed", "premium", "ultimate", "subscription"}
+
+// newTierError wraps an error in a TierError if GitLab rejected a request
+// using paid features with a message which explicitly names the license or
+// one of those features as unavailable. Other errors, like plain permission
+// or not found errors, are returned unchanged.
+func newTierError(err error, features []tierFeature) error {
+	if len(features) == 0 {
+		return err
+	}
+
+	var msgs []string
+	var errResp *ErrorResponse
+	var gqlErrs GraphQLErrors
+	switch {
+	case errors.As(err, &errResp):
+		msgs = append(msgs, errResp.Message)
+	case errors.As(err, &gqlErrs):
+		for _, e := range gqlErrs {
+			msgs = append(msgs, e.Message)
+		}
+	default:
+		return err
+	}
+
+	if !mentionsTier(msgs, features) {
+		return err
+	}
+
+	te := &TierError{Tier: features[0].tier, Err: err}
+	for _, f := range features {
+		te.Features = append(te.Features, f.name)
+		if f.tier == "Ultimate" {
+			te.Tier = f.tier
+		}
+	}
+	return te
+}
+
+// mentionsTier reports whether any of the messages blames the license, or
+// reports one of the features as not being available.
+func mentionsTier(msgs []string, features []tierFeature) bool {
+	for _, msg := range msgs {
+		msg = strings.ToLower(msg)
+		for _, term := range tierErrorTerms {
+			if strings.Contains(msg, term) {
+				return true
+			}
+		}
+		if !strings.Contains(msg, "not available") && !strings.Contains(msg, "unavailable") {
+			continue
+		}
+		for _, f := range features {
+			if strings.Contains(msg, f.name) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// HealthStatusValue represents the health status of an issue, as returned in
+// Issue.HealthStatus.
+type HealthStatusValue string
+
+// List of available health status values.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/user/project/issues/managing_issues.html#health-status
+const (
+	HealthStatusOnTrack        HealthStatusValue = "on_track"
+	HealthStatusNeedsAttention HealthStatusValue = "needs_attention"
+	HealthStatusAtRisk         HealthStatusValue = "at_risk"
+)
+
+// graphQLValue returns the value of the HealthStatus GraphQL enum.
+func (v HealthStatusValue) graphQLValue() string {
+	parts := strings.Split(string(v), "_")
+	for i := 1; i < len(parts); i++ {
+		if parts[i] != "" {
+			parts[i] = strings.ToUpper(parts[i][:1]) + parts[i][1:]
+		}
+	}
+	return strings.Join(parts, "")
+}
+
+// SetIssueHealthStatus sets the health status of an issue. A nil status
+// clears the health status. Health status requires GitLab Ultimate, which is
+// reported as a *TierError when the instance rejects it.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/graphql/reference/#mutationupdateissue
+func (s *IssuesService) SetIssueHealthStatus(pid interface{}, issue int, status *HealthStatusValue, options ...RequestOptionFunc) (*Response, error) {
+	projectPath, err := s.client.projectFullPath(pid, options)
+	if err != nil {
+		return nil, err
+	}
+
+	var healthStatus interface{}
+	if status != nil {
+		healthStatus = status.graphQLValue()
+	}
+
+	q := GraphQLQuery{
+		Query: `mutation($input: UpdateIssueInput!) {
+			updateIssue(input: $input) { errors }
+		}`,
+		Variables: map[string]interface{}{"input": map[string]interface{}{
+			"projectPath":  projectPath,
+			"iid":          fmt.Sprint(issue),
+			"healthStatus": healthStatus,
+		}},
+	}
+
+	var data struct {
+		Payload struct {
+			Errors []string `json:"errors"`
+		} `json:"updateIssue"`
+	}
+	resp, err := s.client.GraphQL(q, &data, options...)
+	if err == nil {
+		err = mutationErrors(data.Payload.Errors)
+	}
+
+	return resp, newTierError(err, []tierFeature{{"health status", "Ultimate"}})
+}
+
+// SetIssueIteration assigns an issue to an iteration, which is identified by
+// its numeric ID. An iteration of 0 removes the issue from its iteration.
+// Iterations require GitLab Premium, which is reported as a *TierError when
+// the instance rejects it.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/graphql/reference/#mutationissuesetiteration
+func (s *IssuesService) SetIssueIteration(pid interface{}, issue int, iteration int, options ...RequestOptionFunc) (*Response, error) {
+	projectPath, err := s.client.projectFullPath(pid, options)
+	if err != nil {
+		return nil, err
+	}
+
+	var iterationID interface{}
+	if iteration != 0 {
+		iterationID = globalID("Iteration", iteration)
+	}
+
+	q := GraphQLQuery{
+		Query: `mutation($input: IssueSetIterationInput!) {
+			issueSetIteration(input: $input) { errors }
+		}`,
+		Variables: map[string]interface{}{"input": map[string]interface{}{
+			"projectPath": projectPath,
+			"iid":         fmt.Sprint(issue),
+			"iterationId": iterationID,
+		}},
+	}
+
+	var data struct {
+		Payload struct {
+			Errors []string `json:"errors"`
+		} `json:"issueSetIteration"`
+	}
+	resp, err := s.client.GraphQL(q, &data, options...)
+	if err == nil {
+		err = mutationErrors(data.Payload.Errors)
+	}
+
+	return resp, newTierError(err, []tierFeature{{"iteration", "Premium"}})
+}
@@ -17,6 +17,8 @@
 package gitlab
 
 import (
+	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"reflect"
@@ -959,3 +961,99 @@ func TestGetIssueGroupMilestone(t *testing.T) {
 		t.Errorf("Issues.GetIssue returned %+v, want %+v", issue, want)
 	}
 }
+
+func TestUpdateIssueTierError(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/issues/5", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		w.WriteHeader(http.StatusForbidden)
+		fmt.Fprint(w, `{"message": "403 Forbidden - Epics are not available for the current license"}`)
+	})
+
+	_, _, err := client.Issues.UpdateIssue(1, 5, &UpdateIssueOptions{Weight: Ptr(3), EpicID: Ptr(2)})
+
+	var tierErr *TierError
+	if !errors.As(err, &tierErr) {
+		t.Fatalf("Issues.UpdateIssue returned %v, want a *TierError", err)
+	}
+	assert.Equal(t, []string{"weight", "epic"}, tierErr.Features)
+	assert.Equal(t, "Premium", tierErr.Tier)
+	assert.ErrorIs(t, err, ErrForbidden)
+
+	// Errors unrelated to paid features are returned unchanged.
+	_, _, err = client.Issues.UpdateIssue(1, 5, &UpdateIssueOptions{Title: Ptr("Title")})
+	assert.False(t, errors.As(err, &tierErr))
+}
+
+func TestUpdateIssuePermissionError(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/issues/5", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		w.WriteHeader(http.StatusForbidden)
+		fmt.Fprint(w, `{"message": "403 Forbidden"}`)
+	})
+
+	// A plain permission failure is not blamed on the license.
+	_, _, err := client.Issues.UpdateIssue(1, 5, &UpdateIssueOptions{Weight: Ptr(3)})
+
+	var tierErr *TierError
+	assert.False(t, errors.As(err, &tierErr))
+	assert.ErrorIs(t, err, ErrForbidden)
+}
+
+func TestSetIssueHealthStatus(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"id": 1, "path_with_namespace": "group/project"}`)
+	})
+	mux.HandleFunc("/api/graphql", func(w http.ResponseWriter, r *http.Request) {
+		var q GraphQLQuery
+		if err := json.NewDecoder(r.Body).Decode(&q); err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, map[string]interface{}{
+			"projectPath":  "group/project",
+			"iid":          "5",
+			"healthStatus": "needsAttention",
+		}, q.Variables["input"])
+		fmt.Fprint(w, `{"data": {"updateIssue": {"errors": []}}}`)
+	})
+
+	_, err := client.Issues.SetIssueHealthStatus(1, 5, Ptr(HealthStatusNeedsAttention))
+	assert.NoError(t, err)
+}
+
+func TestSetIssueIterationTierError(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/graphql", func(w http.ResponseWriter, r *http.Request) {
+		var q GraphQLQuery
+		if err := json.NewDecoder(r.Body).Decode(&q); err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, "gid://gitlab/Iteration/7", q.Variables["input"].(map[string]interface{})["iterationId"])
+		if q.Variables["input"].(map[string]interface{})["iid"] == "5" {
+			fmt.Fprint(w, `{"data": {"issueSetIteration": {"errors": ["Iterations are not available for the current license"]}}}`)
+			return
+		}
+		fmt.Fprint(w, `{"data": null, "errors": [{"message": "The resource that you are attempting to access does not exist or you don't have permission to perform this action"}]}`)
+	})
+
+	_, err := client.Issues.SetIssueIteration("group/project", 5, 7)
+
+	var tierErr *TierError
+	if !errors.As(err, &tierErr) {
+		t.Fatalf("Issues.SetIssueIteration returned %v, want a *TierError", err)
+	}
+	assert.Equal(t, []string{"iteration"}, tierErr.Features)
+
+	// Other GraphQL errors are returned unchanged.
+	_, err = client.Issues.SetIssueIteration("group/project", 6, 7)
+	assert.False(t, errors.As(err, &tierErr))
+	var gqlErrs GraphQLErrors
+	assert.True(t, errors.As(err, &gqlErrs))
+}
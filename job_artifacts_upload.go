@@ -0,0 +1,208 @@
+package gitlab
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+
+	"github.com/google/go-querystring/query"
+	retryablehttp "github.com/hashicorp/go-retryablehttp"
+)
+
+// AuthorizeJobArtifactsUploadOptions represents the available
+// AuthorizeJobArtifactsUpload() options.
+type AuthorizeJobArtifactsUploadOptions struct {
+	Filesize       *int64  `url:"filesize,omitempty" json:"filesize,omitempty"`
+	ArtifactType   *string `url:"artifact_type,omitempty" json:"artifact_type,omitempty"`
+	ArtifactFormat *string `url:"artifact_format,omitempty" json:"artifact_format,omitempty"`
+}
+
+// JobArtifactsUploadAuthorization represents the response of an artifacts
+// upload authorization request.
+type JobArtifactsUploadAuthorization struct {
+	TempPath     string                    `json:"TempPath"`
+	RemoteObject *JobArtifactsRemoteObject `json:"RemoteObject"`
+	MaximumSize  int64                     `json:"MaximumSize"`
+}
+
+// JobArtifactsRemoteObject represents the object storage location an
+// artifacts upload is authorized to be stored in.
+type JobArtifactsRemoteObject struct {
+	ID               string                       `json:"ID"`
+	GetURL           string                       `json:"GetURL"`
+	StoreURL         string                       `json:"StoreURL"`
+	DeleteURL        string                       `json:"DeleteURL"`
+	Timeout          int                          `json:"Timeout"`
+	CustomPutHeaders bool                         `json:"CustomPutHeaders"`
+	PutHeaders       map[string]string            `json:"PutHeaders"`
+	MultipartUpload  *JobArtifactsMultipartUpload `json:"MultipartUpload"`
+}
+
+// JobArtifactsMultipartUpload represents the presigned URLs used to upload
+// an artifacts archive to object storage in multiple parts.
+type JobArtifactsMultipartUpload struct {
+	PartSize    int64    `json:"PartSize"`
+	PartURLs    []string `json:"PartURLs"`
+	CompleteURL string   `json:"CompleteURL"`
+	AbortURL    string   `json:"AbortURL"`
+}
+
+// AuthorizeJobArtifactsUpload asks GitLab if an artifacts archive of the
+// given size can be uploaded for a job, and where it should be stored. The
+// request must be authenticated with the token of the job, for example using
+// WithToken(JobToken, token). GitLab only answers authorization requests
+// made through GitLab Workhorse.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/jobs.html
+func (s *JobsService) AuthorizeJobArtifactsUpload(jobID int, opt *AuthorizeJobArtifactsUploadOptions, options ...RequestOptionFunc) (*JobArtifactsUploadAuthorization, *Response, error) {
+	u := fmt.Sprintf("jobs/%d/artifacts/authorize", jobID)
+
+	req, err := s.client.NewRequest(http.MethodPost, u, opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	a := new(JobArtifactsUploadAuthorization)
+	resp, err := s.client.Do(req, a)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return a, resp, nil
+}
+
+// UploadJobArtifactsOptions represents the available UploadJobArtifacts()
+// options.
+type UploadJobArtifactsOptions struct {
+	ArtifactType   *string `url:"artifact_type,omitempty" json:"artifact_type,omitempty"`
+	ArtifactFormat *string `url:"artifact_format,omitempty" json:"artifact_format,omitempty"`
+	ExpireIn       *string `url:"expire_in,omitempty" json:"expire_in,omitempty"`
+}
+
+// UploadJobArtifacts uploads an artifacts archive for a job the same way
+// GitLab Runner does. The request must be authenticated with the token of the
+// job, for example using WithToken(JobToken, token).
+//
+// The archive is streamed using chunked transfer encoding, so it is never
+// buffered in memory. Because of that, the request is only retried if content
+// implements io.Seeker.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/jobs.html
+func (s *JobsService) UploadJobArtifacts(jobID int, content io.Reader, filename string, opt *UploadJobArtifactsOptions, options ...RequestOptionFunc) (*Response, error) {
+	u := fmt.Sprintf("jobs/%d/artifacts", jobID)
+
+	req, err := s.client.NewRequest(http.MethodPost, u, nil, options)
+	if err != nil {
+		return nil, err
+	}
+
+	// The runner passes the artifact options as query parameters.
+	if opt != nil {
+		q, err := query.Values(opt)
+		if err != nil {
+			return nil, err
+		}
+		req.URL.RawQuery = q.Encode()
+	}
+
+	body := newMultipartStream(content, filename)
+	if err := req.SetBody(retryablehttp.ReaderFunc(body.open)); err != nil {
+		return nil, err
+	}
+	req.ContentLength = -1
+	req.Header.Set("Content-Type", "multipart/form-data; boundary="+body.boundary)
+
+	return s.client.Do(req, nil)
+}
+
+// errArtifactsStreamConsumed is returned when an upload of a non-seekable
+// artifacts archive would need to be retried.
+var errArtifactsStreamConsumed = errors.New("artifacts archive was already consumed and cannot be retried")
+
+// multipartStream encodes a file as a multipart form while it is read, so it
+// does not have to be buffered before it is sent.
+type multipartStream struct {
+	content  io.Reader
+	filename string
+	boundary string
+	started  bool
+}
+
+func newMultipartStream(content io.Reader, filename string) *multipartStream {
+	return &multipartStream{
+		content:  content,
+		filename: filename,
+		boundary: multipart.NewWriter(io.Discard).Boundary(),
+	}
+}
+
+// open returns a reader for a new attempt. The encoding only starts on the
+// first read, as the HTTP client opens the body once up front without reading
+// from it.
+func (m *multipartStream) open() (io.Reader, error) {
+	return &lazyReader{open: m.start}, nil
+}
+
+func (m *multipartStream) start() io.ReadCloser {
+	if m.started {
+		seeker, ok := m.content.(io.Seeker)
+		if !ok {
+			return io.NopCloser(&errReader{errArtifactsStreamConsumed})
+		}
+		if _, err := seeker.Seek(0, io.SeekStart); err != nil {
+			return io.NopCloser(&errReader{err})
+		}
+	}
+	m.started = true
+
+	pr, pw := io.Pipe()
+	go func() {
+		w := multipart.NewWriter(pw)
+		if err := w.SetBoundary(m.boundary); err != nil {
+			pw.CloseWithError(err)
+			return
+		}
+		fw, err := w.CreateFormFile(string(UploadFile), m.filename)
+		if err != nil {
+			pw.CloseWithError(err)
+			return
+		}
+		if _, err := io.Copy(fw, m.content); err != nil {
+			pw.CloseWithError(err)
+			return
+		}
+		pw.CloseWithError(w.Close())
+	}()
+
+	return pr
+}
+
+// lazyReader opens the underlying reader on the first read.
+type lazyReader struct {
+	open func() io.ReadCloser
+	r    io.ReadCloser
+}
+
+func (l *lazyReader) Read(p []byte) (int, error) {
+	if l.r == nil {
+		l.r = l.open()
+	}
+	return l.r.Read(p)
+}
+
+func (l *lazyReader) Close() error {
+	if l.r == nil {
+		return nil
+	}
+	return l.r.Close()
+}
+
+type errReader struct {
+	err error
+}
+
+func (r *errReader) Read([]byte) (int, error) {
+	return 0, r.err
+}
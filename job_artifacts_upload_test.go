@@ -0,0 +1,112 @@
+package gitlab
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestJobsService_UploadJobArtifacts(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/jobs/1/artifacts", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		require.Equal(t, "job-token", r.Header.Get("JOB-TOKEN"))
+		require.Equal(t, "zip", r.URL.Query().Get("artifact_format"))
+		require.Equal(t, "archive", r.URL.Query().Get("artifact_type"))
+		require.Equal(t, []string{"chunked"}, r.TransferEncoding)
+
+		f, fh, err := r.FormFile("file")
+		require.NoError(t, err)
+		require.Equal(t, "artifacts.zip", fh.Filename)
+
+		b, err := io.ReadAll(f)
+		require.NoError(t, err)
+		require.Equal(t, "artifact content", string(b))
+
+		w.WriteHeader(http.StatusCreated)
+	})
+
+	opt := &UploadJobArtifactsOptions{
+		ArtifactType:   Ptr("archive"),
+		ArtifactFormat: Ptr("zip"),
+	}
+	content := io.MultiReader(strings.NewReader("artifact "), strings.NewReader("content"))
+
+	resp, err := client.Jobs.UploadJobArtifacts(1, content, "artifacts.zip", opt, WithToken(JobToken, "job-token"))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+}
+
+func TestJobsService_UploadJobArtifactsRetry(t *testing.T) {
+	mux, client := setup(t)
+
+	attempts := 0
+	mux.HandleFunc("/api/v4/jobs/1/artifacts", func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+
+		f, _, err := r.FormFile("file")
+		require.NoError(t, err)
+		b, err := io.ReadAll(f)
+		require.NoError(t, err)
+		require.Equal(t, "artifact content", string(b))
+
+		if attempts == 1 {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+	})
+
+	retry := WithRequestRetry(RetryPolicy{
+		MaxAttempts:        2,
+		WaitMin:            time.Millisecond,
+		WaitMax:            time.Millisecond,
+		RetryNonIdempotent: true,
+	})
+
+	// A seekable archive is rewound and sent again.
+	_, err := client.Jobs.UploadJobArtifacts(1, bytes.NewReader([]byte("artifact content")), "artifacts.zip", nil, retry)
+	require.NoError(t, err)
+	require.Equal(t, 2, attempts)
+
+	// Other archives cannot be sent again.
+	attempts = 0
+	_, err = client.Jobs.UploadJobArtifacts(1, io.LimitReader(strings.NewReader("artifact content"), 1<<20), "artifacts.zip", nil, retry)
+	require.True(t, errors.Is(err, errArtifactsStreamConsumed), "unexpected error: %v", err)
+	require.Equal(t, 1, attempts)
+}
+
+func TestJobsService_AuthorizeJobArtifactsUpload(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/jobs/1/artifacts/authorize", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testBody(t, r, `{"filesize":1024}`)
+		w.Write([]byte(`{
+			"TempPath": "/tmp/uploads",
+			"MaximumSize": 104857600,
+			"RemoteObject": {
+				"ID": "1",
+				"StoreURL": "https://storage.example.com/store",
+				"MultipartUpload": {
+					"PartSize": 5242880,
+					"PartURLs": ["https://storage.example.com/part/1"],
+					"CompleteURL": "https://storage.example.com/complete",
+					"AbortURL": "https://storage.example.com/abort"
+				}
+			}
+		}`))
+	})
+
+	a, _, err := client.Jobs.AuthorizeJobArtifactsUpload(1, &AuthorizeJobArtifactsUploadOptions{Filesize: Ptr(int64(1024))})
+	require.NoError(t, err)
+	require.Equal(t, int64(104857600), a.MaximumSize)
+	require.Equal(t, int64(5242880), a.RemoteObject.MultipartUpload.PartSize)
+}
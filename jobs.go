@@ -20,6 +20,7 @@ import (
 	"bytes"
 	"fmt"
 	"net/http"
+	"net/url"
 	"time"
 )
 
@@ -126,7 +127,7 @@ type ListJobsOptions struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/jobs.html#list-project-jobs
 func (s *JobsService) ListProjectJobs(pid interface{}, opts *ListJobsOptions, options ...RequestOptionFunc) ([]*Job, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -152,7 +153,7 @@ func (s *JobsService) ListProjectJobs(pid interface{}, opts *ListJobsOptions, op
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/jobs.html#list-pipeline-jobs
 func (s *JobsService) ListPipelineJobs(pid interface{}, pipelineID int, opts *ListJobsOptions, options ...RequestOptionFunc) ([]*Job, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -178,7 +179,7 @@ func (s *JobsService) ListPipelineJobs(pid interface{}, pipelineID int, opts *Li
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/jobs.html#list-pipeline-jobs
 func (s *JobsService) ListPipelineBridges(pid interface{}, pipelineID int, opts *ListJobsOptions, options ...RequestOptionFunc) ([]*Bridge, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -228,7 +229,7 @@ func (s *JobsService) GetJobTokensJob(opts *GetJobTokensJobOptions, options ...R
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/jobs.html#get-a-single-job
 func (s *JobsService) GetJob(pid interface{}, jobID int, options ...RequestOptionFunc) (*Job, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -253,7 +254,7 @@ func (s *JobsService) GetJob(pid interface{}, jobID int, options ...RequestOptio
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/job_artifacts.html#get-job-artifacts
 func (s *JobsService) GetJobArtifacts(pid interface{}, jobID int, options ...RequestOptionFunc) (*bytes.Reader, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -288,11 +289,11 @@ type DownloadArtifactsFileOptions struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/job_artifacts.html#download-the-artifacts-archive
 func (s *JobsService) DownloadArtifactsFile(pid interface{}, refName string, opt *DownloadArtifactsFileOptions, options ...RequestOptionFunc) (*bytes.Reader, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("projects/%s/jobs/artifacts/%s/download", PathEscape(project), refName)
+	u := fmt.Sprintf("projects/%s/jobs/artifacts/%s/download", PathEscape(project), url.PathEscape(refName))
 
 	req, err := s.client.NewRequest(http.MethodGet, u, opt, options)
 	if err != nil {
@@ -316,7 +317,7 @@ func (s *JobsService) DownloadArtifactsFile(pid interface{}, refName string, opt
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/job_artifacts.html#download-a-single-artifact-file-by-job-id
 func (s *JobsService) DownloadSingleArtifactsFile(pid interface{}, jobID int, artifactPath string, options ...RequestOptionFunc) (*bytes.Reader, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -350,7 +351,7 @@ func (s *JobsService) DownloadSingleArtifactsFile(pid interface{}, jobID int, ar
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/job_artifacts.html#download-a-single-artifact-file-from-specific-tag-or-branch
 func (s *JobsService) DownloadSingleArtifactsFileByTagOrBranch(pid interface{}, refName string, artifactPath string, opt *DownloadArtifactsFileOptions, options ...RequestOptionFunc) (*bytes.Reader, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -381,7 +382,7 @@ func (s *JobsService) DownloadSingleArtifactsFileByTagOrBranch(pid interface{},
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/jobs.html#get-a-log-file
 func (s *JobsService) GetTraceFile(pid interface{}, jobID int, options ...RequestOptionFunc) (*bytes.Reader, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -406,7 +407,7 @@ func (s *JobsService) GetTraceFile(pid interface{}, jobID int, options ...Reques
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/jobs.html#cancel-a-job
 func (s *JobsService) CancelJob(pid interface{}, jobID int, options ...RequestOptionFunc) (*Job, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -431,7 +432,7 @@ func (s *JobsService) CancelJob(pid interface{}, jobID int, options ...RequestOp
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/jobs.html#retry-a-job
 func (s *JobsService) RetryJob(pid interface{}, jobID int, options ...RequestOptionFunc) (*Job, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -457,7 +458,7 @@ func (s *JobsService) RetryJob(pid interface{}, jobID int, options ...RequestOpt
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/jobs.html#erase-a-job
 func (s *JobsService) EraseJob(pid interface{}, jobID int, options ...RequestOptionFunc) (*Job, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -483,7 +484,7 @@ func (s *JobsService) EraseJob(pid interface{}, jobID int, options ...RequestOpt
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/job_artifacts.html#keep-artifacts
 func (s *JobsService) KeepArtifacts(pid interface{}, jobID int, options ...RequestOptionFunc) (*Job, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -526,7 +527,7 @@ type JobVariableOptions struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/jobs.html#run-a-job
 func (s *JobsService) PlayJob(pid interface{}, jobID int, opt *PlayJobOptions, options ...RequestOptionFunc) (*Job, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -551,7 +552,7 @@ func (s *JobsService) PlayJob(pid interface{}, jobID int, opt *PlayJobOptions, o
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/job_artifacts.html#delete-job-artifacts
 func (s *JobsService) DeleteArtifacts(pid interface{}, jobID int, options ...RequestOptionFunc) (*Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, err
 	}
@@ -570,7 +571,7 @@ func (s *JobsService) DeleteArtifacts(pid interface{}, jobID int, options ...Req
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/job_artifacts.html#delete-project-artifacts
 func (s *JobsService) DeleteProjectArtifacts(pid interface{}, options ...RequestOptionFunc) (*Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,162 @@
+package gitlab
+
+import "time"
+
+// LabelUsage represents how much a group label is used by the issues and
+// merge requests of a group and its subgroups.
+type LabelUsage struct {
+	Label *GroupLabel
+
+	OpenIssues          int
+	ClosedIssues        int
+	OpenMergeRequests   int
+	ClosedMergeRequests int
+
+	// CountsUnknown is set if GitLab did not report (some of) the counts,
+	// which it omits for more than 10,000 results. The missing counts are 0.
+	CountsUnknown bool
+
+	// LastUsedAt is the last update of any issue or merge request with the
+	// label, or nil if the label is not used. GitLab does not report when a
+	// label was added, so this is an approximation.
+	LastUsedAt *time.Time
+}
+
+// Unused reports whether no issue or merge request has the label. A label
+// which was seen on any issue or merge request is never unused, even if its
+// counts are unknown.
+func (u *LabelUsage) Unused() bool {
+	if u.CountsUnknown || u.LastUsedAt != nil {
+		return false
+	}
+	return u.OpenIssues+u.ClosedIssues+u.OpenMergeRequests+u.ClosedMergeRequests == 0
+}
+
+// GetGroupLabelUsage reports the number of open and closed issues and merge
+// requests of a group and its subgroups using each label of the group, and
+// when each label was last used. The labels are listed using the given
+// options. Merged merge requests count as closed.
+//
+// The counts are based on the X-Total header, which GitLab omits for more
+// than 10,000 results, in which case they are 0 and CountsUnknown is set.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/group_labels.html#list-group-labels
+// https://docs.gitlab.com/ee/api/issues.html#list-group-issues
+// https://docs.gitlab.com/ee/api/merge_requests.html#list-group-merge-requests
+func (s *GroupLabelsService) GetGroupLabelUsage(gid interface{}, opt *ListGroupLabelsOptions, options ...RequestOptionFunc) ([]*LabelUsage, error) {
+	lopt := ListGroupLabelsOptions{}
+	if opt != nil {
+		lopt = *opt
+	}
+	lopt.PerPage = 100
+
+	var usage []*LabelUsage
+	for {
+		labels, resp, err := s.ListGroupLabels(gid, &lopt, options...)
+		if err != nil {
+			return nil, err
+		}
+		for _, l := range labels {
+			u, err := s.labelUsage(gid, l, options)
+			if err != nil {
+				return nil, err
+			}
+			usage = append(usage, u)
+		}
+		if resp.NextPage == 0 {
+			break
+		}
+		lopt.Page = resp.NextPage
+	}
+
+	return usage, nil
+}
+
+// labelUsage gets the usage of a single label. Listing all issues or merge
+// requests with the label, most recently updated first, yields both the
+// total and the last use. Listing the open ones yields the open count, the
+// closed count is the difference.
+func (s *GroupLabelsService) labelUsage(gid interface{}, l *GroupLabel, options []RequestOptionFunc) (*LabelUsage, error) {
+	u := &LabelUsage{Label: l}
+	labels := &LabelOptions{l.Name}
+	first := ListOptions{PerPage: 1}
+
+	lastUsed := func(t *time.Time) {
+		if t != nil && (u.LastUsedAt == nil || t.After(*u.LastUsedAt)) {
+			u.LastUsedAt = t
+		}
+	}
+
+	// GitLab omits the total for large results, which shows as a total of
+	// 0 for a page with items.
+	total := func(resp *Response, items int) int {
+		if resp.TotalItems == 0 && items > 0 {
+			u.CountsUnknown = true
+		}
+		return resp.TotalItems
+	}
+
+	issues, resp, err := s.client.Issues.ListGroupIssues(gid, &ListGroupIssuesOptions{
+		ListOptions: first,
+		State:       Ptr("all"),
+		Labels:      labels,
+		OrderBy:     Ptr("updated_at"),
+		Sort:        Ptr("desc"),
+	}, options...)
+	if err != nil {
+		return nil, err
+	}
+	allIssues := total(resp, len(issues))
+	if len(issues) > 0 {
+		lastUsed(issues[0].UpdatedAt)
+	}
+
+	issues, resp, err = s.client.Issues.ListGroupIssues(gid, &ListGroupIssuesOptions{
+		ListOptions: first,
+		State:       Ptr("opened"),
+		Labels:      labels,
+	}, options...)
+	if err != nil {
+		return nil, err
+	}
+	u.OpenIssues = total(resp, len(issues))
+	u.ClosedIssues = closedCount(allIssues, u.OpenIssues)
+
+	mrs, resp, err := s.client.MergeRequests.ListGroupMergeRequests(gid, &ListGroupMergeRequestsOptions{
+		ListOptions: first,
+		State:       Ptr("all"),
+		Labels:      labels,
+		OrderBy:     Ptr("updated_at"),
+		Sort:        Ptr("desc"),
+	}, options...)
+	if err != nil {
+		return nil, err
+	}
+	allMRs := total(resp, len(mrs))
+	if len(mrs) > 0 {
+		lastUsed(mrs[0].UpdatedAt)
+	}
+
+	mrs, resp, err = s.client.MergeRequests.ListGroupMergeRequests(gid, &ListGroupMergeRequestsOptions{
+		ListOptions: first,
+		State:       Ptr("opened"),
+		Labels:      labels,
+	}, options...)
+	if err != nil {
+		return nil, err
+	}
+	u.OpenMergeRequests = total(resp, len(mrs))
+	u.ClosedMergeRequests = closedCount(allMRs, u.OpenMergeRequests)
+
+	return u, nil
+}
+
+// closedCount returns the number of closed items, or 0 if the total is
+// unknown.
+func closedCount(all, open int) int {
+	if all < open {
+		return 0
+	}
+	return all - open
+}
@@ -0,0 +1,75 @@
+package gitlab
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetGroupLabelUsage(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/groups/1/labels", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `[{"id": 1, "name": "bug"}, {"id": 2, "name": "stale"}, {"id": 3, "name": "busy"}]`)
+	})
+	list := func(all, open int, updatedAt string) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			testMethod(t, r, http.MethodGet)
+			q := r.URL.Query()
+			require.Equal(t, "1", q.Get("per_page"))
+
+			var total int
+			switch q.Get("labels") {
+			case "bug":
+				total = all
+				if q.Get("state") == "opened" {
+					total = open
+				}
+			case "stale":
+			case "busy":
+				// GitLab omits the total for more than 10,000 results.
+				fmt.Fprintf(w, `[{"id": 1, "updated_at": %q}]`, updatedAt)
+				return
+			default:
+				t.Errorf("unexpected labels %q", q.Get("labels"))
+			}
+			w.Header().Set("X-Total", fmt.Sprint(total))
+
+			if total == 0 {
+				fmt.Fprint(w, `[]`)
+				return
+			}
+			if q.Get("state") == "all" {
+				require.Equal(t, "updated_at", q.Get("order_by"))
+			}
+			fmt.Fprintf(w, `[{"id": 1, "updated_at": %q}]`, updatedAt)
+		}
+	}
+	mux.HandleFunc("/api/v4/groups/1/issues", list(5, 2, "2024-01-02T00:00:00Z"))
+	mux.HandleFunc("/api/v4/groups/1/merge_requests", list(3, 1, "2024-02-03T00:00:00Z"))
+
+	usage, err := client.GroupLabels.GetGroupLabelUsage(1, nil)
+	require.NoError(t, err)
+	require.Len(t, usage, 3)
+
+	require.Equal(t, "bug", usage[0].Label.Name)
+	require.Equal(t, 2, usage[0].OpenIssues)
+	require.Equal(t, 3, usage[0].ClosedIssues)
+	require.Equal(t, 1, usage[0].OpenMergeRequests)
+	require.Equal(t, 2, usage[0].ClosedMergeRequests)
+	require.Equal(t, time.Date(2024, 2, 3, 0, 0, 0, 0, time.UTC), *usage[0].LastUsedAt)
+	require.False(t, usage[0].Unused())
+
+	require.Equal(t, "stale", usage[1].Label.Name)
+	require.Nil(t, usage[1].LastUsedAt)
+	require.True(t, usage[1].Unused())
+
+	require.Equal(t, "busy", usage[2].Label.Name)
+	require.True(t, usage[2].CountsUnknown)
+	require.NotNil(t, usage[2].LastUsedAt)
+	require.False(t, usage[2].Unused())
+}
@@ -85,7 +85,7 @@ type ListLabelsOptions struct {
 //
 // GitLab API docs: https://docs.gitlab.com/ee/api/labels.html#list-labels
 func (s *LabelsService) ListLabels(pid interface{}, opt *ListLabelsOptions, options ...RequestOptionFunc) ([]*Label, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -109,7 +109,7 @@ func (s *LabelsService) ListLabels(pid interface{}, opt *ListLabelsOptions, opti
 //
 // GitLab API docs: https://docs.gitlab.com/ee/api/labels.html#get-a-single-project-label
 func (s *LabelsService) GetLabel(pid interface{}, lid interface{}, options ...RequestOptionFunc) (*Label, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -148,7 +148,7 @@ type CreateLabelOptions struct {
 //
 // GitLab API docs: https://docs.gitlab.com/ee/api/labels.html#create-a-new-label
 func (s *LabelsService) CreateLabel(pid interface{}, opt *CreateLabelOptions, options ...RequestOptionFunc) (*Label, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -179,7 +179,7 @@ type DeleteLabelOptions struct {
 //
 // GitLab API docs: https://docs.gitlab.com/ee/api/labels.html#delete-a-label
 func (s *LabelsService) DeleteLabel(pid interface{}, lid interface{}, opt *DeleteLabelOptions, options ...RequestOptionFunc) (*Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, err
 	}
@@ -217,7 +217,7 @@ type UpdateLabelOptions struct {
 //
 // GitLab API docs: https://docs.gitlab.com/ee/api/labels.html#edit-an-existing-label
 func (s *LabelsService) UpdateLabel(pid interface{}, lid interface{}, opt *UpdateLabelOptions, options ...RequestOptionFunc) (*Label, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -252,7 +252,7 @@ func (s *LabelsService) UpdateLabel(pid interface{}, lid interface{}, opt *Updat
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/labels.html#subscribe-to-a-label
 func (s *LabelsService) SubscribeToLabel(pid interface{}, lid interface{}, options ...RequestOptionFunc) (*Label, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -283,7 +283,7 @@ func (s *LabelsService) SubscribeToLabel(pid interface{}, lid interface{}, optio
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/labels.html#unsubscribe-from-a-label
 func (s *LabelsService) UnsubscribeFromLabel(pid interface{}, lid interface{}, options ...RequestOptionFunc) (*Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, err
 	}
@@ -306,7 +306,7 @@ func (s *LabelsService) UnsubscribeFromLabel(pid interface{}, lid interface{}, o
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/labels.html#promote-a-project-label-to-a-group-label
 func (s *LabelsService) PromoteLabel(pid interface{}, lid interface{}, options ...RequestOptionFunc) (*Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, err
 	}
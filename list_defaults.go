@@ -0,0 +1,92 @@
+package gitlab
+
+import (
+	"fmt"
+	"net/url"
+	"reflect"
+
+	"github.com/google/go-querystring/query"
+	retryablehttp "github.com/hashicorp/go-retryablehttp"
+)
+
+var listOptionsType = reflect.TypeOf(ListOptions{})
+
+// listDefaults contains the default query parameters of list requests, by
+// the type of the options of the list call.
+type listDefaults map[reflect.Type]url.Values
+
+// isListOptionsType reports whether t is a pointer to the options of a list
+// call: ListOptions, a type defined as ListOptions, or a struct embedding
+// ListOptions.
+func isListOptionsType(t reflect.Type) bool {
+	if t == nil || t.Kind() != reflect.Ptr || t.Elem().Kind() != reflect.Struct {
+		return false
+	}
+	t = t.Elem()
+	if t.ConvertibleTo(listOptionsType) {
+		return true
+	}
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if f.Anonymous && (f.Type == listOptionsType || f.Type == reflect.PtrTo(listOptionsType)) {
+			return true
+		}
+	}
+	return false
+}
+
+// add registers the set fields of the given list options as defaults.
+func (d listDefaults) add(defaults interface{}) error {
+	t := reflect.TypeOf(defaults)
+	if !isListOptionsType(t) {
+		return fmt.Errorf("invalid list defaults type %T, expected a pointer to list options", defaults)
+	}
+	q, err := query.Values(defaults)
+	if err != nil {
+		return err
+	}
+	if d[t] == nil {
+		d[t] = make(url.Values)
+	}
+	for k, v := range q {
+		d[t][k] = v
+	}
+	return nil
+}
+
+// apply adds the default query parameters of a list call using the given
+// options, which are not set by the request itself. Defaults registered for
+// the type of the options take precedence over defaults registered for
+// ListOptions. Requests of other calls are left alone.
+func (d listDefaults) apply(req *retryablehttp.Request, opt interface{}) {
+	t := reflect.TypeOf(opt)
+	if !isListOptionsType(t) {
+		return
+	}
+
+	q := req.URL.Query()
+	add := make(url.Values)
+
+	for _, key := range []reflect.Type{t, reflect.PtrTo(listOptionsType)} {
+		for k, v := range d[key] {
+			if _, ok := q[k]; ok {
+				continue
+			}
+			if _, ok := add[k]; ok {
+				continue
+			}
+			add[k] = v
+		}
+	}
+	if len(add) == 0 {
+		return
+	}
+
+	// Append the values instead of re-encoding the query, to keep the query
+	// exactly as it was encoded by the request options.
+	if req.URL.RawQuery == "" {
+		req.URL.RawQuery = add.Encode()
+	} else {
+		req.URL.RawQuery += "&" + add.Encode()
+	}
+}
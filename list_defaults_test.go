@@ -0,0 +1,66 @@
+package gitlab
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithListDefaults(t *testing.T) {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	var queries []string
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		queries = append(queries, r.URL.RawQuery)
+		fmt.Fprint(w, `[]`)
+	}
+	mux.HandleFunc("/api/v4/projects/1/merge_requests", handler)
+	mux.HandleFunc("/api/v4/groups/2/merge_requests", handler)
+	mux.HandleFunc("/api/v4/projects/1/issues", handler)
+	mux.HandleFunc("/api/v4/groups/2/epics/3/issues", handler)
+	mux.HandleFunc("/api/v4/projects/1/issues/4", func(w http.ResponseWriter, r *http.Request) {
+		queries = append(queries, r.URL.RawQuery)
+		fmt.Fprint(w, `{"id": 4}`)
+	})
+
+	client, err := NewClient("",
+		WithBaseURL(server.URL),
+		WithListDefaults(&ListOptions{PerPage: 100}),
+		WithListDefaults(&ListProjectMergeRequestsOptions{OrderBy: Ptr("updated_at"), ListOptions: ListOptions{PerPage: 50}}),
+		WithListDefaults(&ListGroupMergeRequestsOptions{OrderBy: Ptr("updated_at"), ListOptions: ListOptions{PerPage: 50}}),
+		WithListDefaults(&ListProjectIssuesOptions{Labels: &LabelOptions{"bug"}}),
+	)
+	require.NoError(t, err)
+
+	_, _, err = client.MergeRequests.ListProjectMergeRequests(1, nil)
+	require.NoError(t, err)
+	_, _, err = client.MergeRequests.ListGroupMergeRequests(2, &ListGroupMergeRequestsOptions{OrderBy: Ptr("created_at")})
+	require.NoError(t, err)
+	_, _, err = client.Issues.ListProjectIssues(1, &ListProjectIssuesOptions{ListOptions: ListOptions{PerPage: 20}})
+	require.NoError(t, err)
+	_, _, err = client.Issues.ListProjectIssues(1, nil)
+	require.NoError(t, err)
+	_, _, err = client.EpicIssues.ListEpicIssues(2, 3, nil)
+	require.NoError(t, err)
+	_, _, err = client.Issues.GetIssue(1, 4)
+	require.NoError(t, err)
+
+	require.Equal(t, []string{
+		"order_by=updated_at&per_page=50",
+		"order_by=created_at&per_page=50",
+		"per_page=20&labels=bug",
+		"labels=bug&per_page=100",
+		"per_page=100",
+		"",
+	}, queries)
+}
+
+func TestWithListDefaultsInvalidType(t *testing.T) {
+	_, err := NewClient("", WithListDefaults(&CreateIssueOptions{}))
+	require.Error(t, err)
+}
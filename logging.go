@@ -0,0 +1,191 @@
+package gitlab
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"mime"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	retryablehttp "github.com/hashicorp/go-retryablehttp"
+)
+
+const redacted = "[REDACTED]"
+
+// maxLoggedBodySize is the maximum number of bytes of a body that is logged.
+const maxLoggedBodySize = 64 * 1024
+
+// secretHeaders contains the (canonical) names of the headers that are never
+// logged in plain text.
+var secretHeaders = map[string]bool{
+	"Authorization": true,
+	"Cookie":        true,
+	"Job-Token":     true,
+	"Private-Token": true,
+	"Set-Cookie":    true,
+}
+
+// secretQueryParams contains the names of query parameters that are never
+// logged in plain text.
+var secretQueryParams = map[string]bool{
+	"access_token":  true,
+	"job_token":     true,
+	"private_token": true,
+	"token":         true,
+}
+
+// secretFields contains the names of JSON fields that contain secrets, like
+// the value of CI/CD variables or the token of a runner.
+var secretFields = map[string]bool{
+	"access_token":       true,
+	"password":           true,
+	"private_token":      true,
+	"registration_token": true,
+	"runners_token":      true,
+	"secret":             true,
+	"token":              true,
+	"value":              true,
+	"webhook_secret":     true,
+}
+
+// isSecretField reports whether a JSON field with the given name contains a
+// secret.
+func isSecretField(name string) bool {
+	name = strings.ToLower(name)
+	return secretFields[name] || strings.HasSuffix(name, "_token") ||
+		strings.HasSuffix(name, "_password") || strings.HasSuffix(name, "_secret")
+}
+
+// redactHeaders returns a copy of the headers with all secrets redacted.
+func redactHeaders(h http.Header) http.Header {
+	r := make(http.Header, len(h))
+	for k, v := range h {
+		if secretHeaders[http.CanonicalHeaderKey(k)] {
+			r[k] = []string{redacted}
+			continue
+		}
+		r[k] = v
+	}
+	return r
+}
+
+// redactURL returns the URL as a string with all secret query parameters
+// redacted.
+func redactURL(u *url.URL) string {
+	if u.RawQuery == "" {
+		return u.String()
+	}
+
+	q := u.Query()
+	for k := range q {
+		if secretQueryParams[strings.ToLower(k)] {
+			q.Set(k, redacted)
+		}
+	}
+
+	r := *u
+	r.RawQuery = q.Encode()
+	return r.String()
+}
+
+// redactJSON returns the given JSON document with the values of all secret
+// fields redacted. Documents that are not valid JSON are omitted entirely,
+// as there is no way to tell if they contain secrets.
+func redactJSON(data []byte) string {
+	var v interface{}
+	if err := json.Unmarshal(data, &v); err != nil {
+		return "[non-JSON body omitted]"
+	}
+
+	b, err := json.Marshal(redactValue(v))
+	if err != nil {
+		return "[body omitted]"
+	}
+	return string(b)
+}
+
+func redactValue(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for k, fv := range v {
+			if isSecretField(k) {
+				if _, ok := fv.(string); ok {
+					v[k] = redacted
+				}
+				continue
+			}
+			v[k] = redactValue(fv)
+		}
+	case []interface{}:
+		for i, ev := range v {
+			v[i] = redactValue(ev)
+		}
+	}
+	return v
+}
+
+// isJSON reports whether the content type denotes a JSON document.
+func isJSON(contentType string) bool {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	return err == nil && (mediaType == "application/json" || strings.HasSuffix(mediaType, "+json"))
+}
+
+// logRequest logs the outcome of a request if a logger is configured. When
+// bodies are logged as well, the response body is buffered and replaced so it
+// can still be decoded afterwards.
+func (c *Client) logRequest(req *retryablehttp.Request, resp *http.Response, err error, latency time.Duration) {
+	if c.logger == nil {
+		return
+	}
+
+	ctx := req.Context()
+	if !c.logger.Enabled(ctx, slog.LevelDebug) {
+		return
+	}
+
+	attrs := []slog.Attr{
+		slog.String("method", req.Method),
+		slog.String("url", redactURL(req.URL)),
+		slog.Duration("latency", latency),
+	}
+
+	if c.logBodies {
+		attrs = append(attrs, slog.Any("request_headers", redactHeaders(req.Header)))
+		if isJSON(req.Header.Get("Content-Type")) {
+			if body, err := req.BodyBytes(); err == nil && len(body) > 0 {
+				attrs = append(attrs, slog.String("request_body", redactJSON(body)))
+			}
+		}
+	}
+
+	if err != nil {
+		attrs = append(attrs, slog.String("error", err.Error()))
+		c.logger.LogAttrs(ctx, slog.LevelDebug, "GitLab API request failed", attrs...)
+		return
+	}
+
+	attrs = append(attrs, slog.Int("status", resp.StatusCode))
+
+	if c.logBodies {
+		attrs = append(attrs, slog.Any("response_headers", redactHeaders(resp.Header)))
+		if isJSON(resp.Header.Get("Content-Type")) && resp.Body != nil {
+			body, readErr := io.ReadAll(resp.Body)
+			resp.Body.Close()
+			resp.Body = io.NopCloser(bytes.NewReader(body))
+
+			if readErr == nil && len(body) > 0 {
+				if len(body) > maxLoggedBodySize {
+					attrs = append(attrs, slog.String("response_body", "[body too large to log]"))
+				} else {
+					attrs = append(attrs, slog.String("response_body", redactJSON(body)))
+				}
+			}
+		}
+	}
+
+	c.logger.LogAttrs(ctx, slog.LevelDebug, "GitLab API request", attrs...)
+}
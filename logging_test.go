@@ -0,0 +1,85 @@
+package gitlab
+
+import (
+	"bytes"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithLogger(t *testing.T) {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+
+	client, err := NewClient("secret-private-token",
+		WithBaseURL(server.URL),
+		WithLogger(logger),
+		WithLogBodies(),
+	)
+	require.NoError(t, err)
+
+	mux.HandleFunc("/api/v4/projects/1/variables", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"key": "DEPLOY_KEY", "value": "secret-variable-value", "masked": true}`)
+	})
+
+	v, _, err := client.ProjectVariables.CreateVariable(1, &CreateProjectVariableOptions{
+		Key:   Ptr("DEPLOY_KEY"),
+		Value: Ptr("secret-variable-value"),
+	})
+	require.NoError(t, err)
+
+	// The response body must still be decoded after logging it.
+	assert.Equal(t, "secret-variable-value", v.Value)
+
+	out := buf.String()
+	assert.Contains(t, out, `"method":"POST"`)
+	assert.Contains(t, out, `"status":200`)
+	assert.Contains(t, out, "DEPLOY_KEY")
+	assert.Contains(t, out, redacted)
+	assert.NotContains(t, out, "secret-private-token")
+	assert.NotContains(t, out, "secret-variable-value")
+}
+
+func TestWithLoggerWithoutBodies(t *testing.T) {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+
+	client, err := NewClient("", WithBaseURL(server.URL), WithLogger(logger))
+	require.NoError(t, err)
+
+	mux.HandleFunc("/api/v4/projects/1", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"id": 1, "runners_token": "secret-runners-token"}`)
+	})
+
+	_, _, err = client.Projects.GetProject(1, nil, WithKeysetPaginationParameters("https://example.com?private_token=secret-query-token"))
+	require.NoError(t, err)
+
+	out := buf.String()
+	assert.Contains(t, out, "method=GET")
+	assert.Contains(t, out, "status=200")
+	assert.NotContains(t, out, "secret-query-token")
+	assert.NotContains(t, out, "secret-runners-token")
+}
+
+func TestRedactJSON(t *testing.T) {
+	got := redactJSON([]byte(`[{"name": "runner", "token": "t", "nested": {"webhook_secret": "s", "trigger_token": "x"}, "expires_at": null}]`))
+	want := `[{"expires_at":null,"name":"runner","nested":{"trigger_token":"[REDACTED]","webhook_secret":"[REDACTED]"},"token":"[REDACTED]"}]`
+	assert.Equal(t, want, got)
+
+	assert.Equal(t, "[non-JSON body omitted]", redactJSON([]byte("plain text")))
+}
@@ -49,7 +49,7 @@ type MemberRole struct {
 // Gitlab API docs:
 // https://docs.gitlab.com/ee/api/member_roles.html#list-all-member-roles-of-a-group
 func (s *MemberRolesService) ListMemberRoles(gid interface{}, options ...RequestOptionFunc) ([]*MemberRole, *Response, error) {
-	group, err := parseID(gid)
+	group, err := parseGroupID(gid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -104,7 +104,7 @@ type CreateMemberRoleOptions struct {
 // Gitlab API docs:
 // https://docs.gitlab.com/ee/api/member_roles.html#add-a-member-role-to-a-group
 func (s *MemberRolesService) CreateMemberRole(gid interface{}, opt *CreateMemberRoleOptions, options ...RequestOptionFunc) (*MemberRole, *Response, error) {
-	group, err := parseID(gid)
+	group, err := parseGroupID(gid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -129,7 +129,7 @@ func (s *MemberRolesService) CreateMemberRole(gid interface{}, opt *CreateMember
 // Gitlab API docs:
 // https://docs.gitlab.com/ee/api/member_roles.html#remove-member-role-of-a-group
 func (s *MemberRolesService) DeleteMemberRole(gid interface{}, memberRole int, options ...RequestOptionFunc) (*Response, error) {
-	group, err := parseID(gid)
+	group, err := parseGroupID(gid)
 	if err != nil {
 		return nil, err
 	}
@@ -104,6 +104,7 @@ type MergeRequestApprovalRule struct {
 	Section              string               `json:"section"`
 	ApprovedBy           []*BasicUser         `json:"approved_by"`
 	Approved             bool                 `json:"approved"`
+	Overridden           bool                 `json:"overridden"`
 }
 
 // MergeRequestApprovalState represents a GitLab merge request approval state.
@@ -115,6 +116,38 @@ type MergeRequestApprovalState struct {
 	Rules                    []*MergeRequestApprovalRule `json:"rules"`
 }
 
+// EligibleApprovers returns the users who are eligible to approve the merge
+// request for any of the rules, without duplicates. If pendingOnly is true,
+// only the rules which are not approved yet are considered.
+func (s *MergeRequestApprovalState) EligibleApprovers(pendingOnly bool) []*BasicUser {
+	seen := make(map[int]bool)
+	var users []*BasicUser
+	for _, rule := range s.Rules {
+		if pendingOnly && rule.Approved {
+			continue
+		}
+		for _, u := range rule.EligibleApprovers {
+			if u == nil || seen[u.ID] {
+				continue
+			}
+			seen[u.ID] = true
+			users = append(users, u)
+		}
+	}
+	return users
+}
+
+// List of available approval rule types.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/merge_request_approvals.html#create-project-level-rule
+const (
+	AnyApproverRuleType    = "any_approver"
+	CodeOwnerRuleType      = "code_owner"
+	RegularRuleType        = "regular"
+	ReportApproverRuleType = "report_approver"
+)
+
 // String is a stringify for MergeRequestApprovalRule
 func (s MergeRequestApprovalRule) String() string {
 	return Stringify(s)
@@ -133,7 +166,8 @@ type MergeRequestApproverUser struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/merge_request_approvals.html#approve-merge-request
 type ApproveMergeRequestOptions struct {
-	SHA *string `url:"sha,omitempty" json:"sha,omitempty"`
+	SHA              *string `url:"sha,omitempty" json:"sha,omitempty"`
+	ApprovalPassword *string `url:"approval_password,omitempty" json:"approval_password,omitempty"`
 }
 
 // ApproveMergeRequest approves a merge request on GitLab. If a non-empty sha
@@ -142,7 +176,7 @@ type ApproveMergeRequestOptions struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/merge_request_approvals.html#approve-merge-request
 func (s *MergeRequestApprovalsService) ApproveMergeRequest(pid interface{}, mr int, opt *ApproveMergeRequestOptions, options ...RequestOptionFunc) (*MergeRequestApprovals, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -167,7 +201,7 @@ func (s *MergeRequestApprovalsService) ApproveMergeRequest(pid interface{}, mr i
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/merge_request_approvals.html#unapprove-merge-request
 func (s *MergeRequestApprovalsService) UnapproveMergeRequest(pid interface{}, mr int, options ...RequestOptionFunc) (*Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, err
 	}
@@ -187,7 +221,7 @@ func (s *MergeRequestApprovalsService) UnapproveMergeRequest(pid interface{}, mr
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/merge_request_approvals.html#reset-approvals-of-a-merge-request
 func (s *MergeRequestApprovalsService) ResetApprovalsOfMergeRequest(pid interface{}, mr int, options ...RequestOptionFunc) (*Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, err
 	}
@@ -215,7 +249,7 @@ type ChangeMergeRequestApprovalConfigurationOptions struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/merge_request_approvals.html#get-configuration-1
 func (s *MergeRequestApprovalsService) GetConfiguration(pid interface{}, mr int, options ...RequestOptionFunc) (*MergeRequestApprovals, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -240,7 +274,7 @@ func (s *MergeRequestApprovalsService) GetConfiguration(pid interface{}, mr int,
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/merge_request_approvals.html#change-approval-configuration-deprecated
 func (s *MergeRequestApprovalsService) ChangeApprovalConfiguration(pid interface{}, mergeRequest int, opt *ChangeMergeRequestApprovalConfigurationOptions, options ...RequestOptionFunc) (*MergeRequest, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -275,7 +309,7 @@ type ChangeMergeRequestAllowedApproversOptions struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/merge_request_approvals.html#change-allowed-approvers-for-merge-request
 func (s *MergeRequestApprovalsService) ChangeAllowedApprovers(pid interface{}, mergeRequest int, opt *ChangeMergeRequestAllowedApproversOptions, options ...RequestOptionFunc) (*MergeRequest, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -300,7 +334,7 @@ func (s *MergeRequestApprovalsService) ChangeAllowedApprovers(pid interface{}, m
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/merge_request_approvals.html#get-merge-request-level-rules
 func (s *MergeRequestApprovalsService) GetApprovalRules(pid interface{}, mergeRequest int, options ...RequestOptionFunc) ([]*MergeRequestApprovalRule, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -320,12 +354,38 @@ func (s *MergeRequestApprovalsService) GetApprovalRules(pid interface{}, mergeRe
 	return par, resp, nil
 }
 
+// GetApprovalRule requests information about a single merge request approval
+// rule.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/merge_request_approvals.html#get-a-single-merge-request-level-rule
+func (s *MergeRequestApprovalsService) GetApprovalRule(pid interface{}, mergeRequest int, approvalRule int, options ...RequestOptionFunc) (*MergeRequestApprovalRule, *Response, error) {
+	project, err := parseProjectID(pid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("projects/%s/merge_requests/%d/approval_rules/%d", PathEscape(project), mergeRequest, approvalRule)
+
+	req, err := s.client.NewRequest(http.MethodGet, u, nil, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	par := new(MergeRequestApprovalRule)
+	resp, err := s.client.Do(req, par)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return par, resp, nil
+}
+
 // GetApprovalState requests information about a merge request’s approval state
 //
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/merge_request_approvals.html#get-the-approval-state-of-merge-requests
 func (s *MergeRequestApprovalsService) GetApprovalState(pid interface{}, mergeRequest int, options ...RequestOptionFunc) (*MergeRequestApprovalState, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -351,11 +411,12 @@ func (s *MergeRequestApprovalsService) GetApprovalState(pid interface{}, mergeRe
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/merge_request_approvals.html#create-merge-request-level-rule
 type CreateMergeRequestApprovalRuleOptions struct {
-	Name                  *string `url:"name,omitempty" json:"name,omitempty"`
-	ApprovalsRequired     *int    `url:"approvals_required,omitempty" json:"approvals_required,omitempty"`
-	ApprovalProjectRuleID *int    `url:"approval_project_rule_id,omitempty" json:"approval_project_rule_id,omitempty"`
-	UserIDs               *[]int  `url:"user_ids,omitempty" json:"user_ids,omitempty"`
-	GroupIDs              *[]int  `url:"group_ids,omitempty" json:"group_ids,omitempty"`
+	Name                  *string   `url:"name,omitempty" json:"name,omitempty"`
+	ApprovalsRequired     *int      `url:"approvals_required,omitempty" json:"approvals_required,omitempty"`
+	ApprovalProjectRuleID *int      `url:"approval_project_rule_id,omitempty" json:"approval_project_rule_id,omitempty"`
+	UserIDs               *[]int    `url:"user_ids,omitempty" json:"user_ids,omitempty"`
+	GroupIDs              *[]int    `url:"group_ids,omitempty" json:"group_ids,omitempty"`
+	Usernames             *[]string `url:"usernames,omitempty" json:"usernames,omitempty"`
 }
 
 // CreateApprovalRule creates a new MR level approval rule.
@@ -363,7 +424,7 @@ type CreateMergeRequestApprovalRuleOptions struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/merge_request_approvals.html#create-merge-request-level-rule
 func (s *MergeRequestApprovalsService) CreateApprovalRule(pid interface{}, mergeRequest int, opt *CreateMergeRequestApprovalRuleOptions, options ...RequestOptionFunc) (*MergeRequestApprovalRule, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -389,10 +450,12 @@ func (s *MergeRequestApprovalsService) CreateApprovalRule(pid interface{}, merge
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/merge_request_approvals.html#update-merge-request-level-rule
 type UpdateMergeRequestApprovalRuleOptions struct {
-	Name              *string `url:"name,omitempty" json:"name,omitempty"`
-	ApprovalsRequired *int    `url:"approvals_required,omitempty" json:"approvals_required,omitempty"`
-	UserIDs           *[]int  `url:"user_ids,omitempty" json:"user_ids,omitempty"`
-	GroupIDs          *[]int  `url:"group_ids,omitempty" json:"group_ids,omitempty"`
+	Name               *string   `url:"name,omitempty" json:"name,omitempty"`
+	ApprovalsRequired  *int      `url:"approvals_required,omitempty" json:"approvals_required,omitempty"`
+	UserIDs            *[]int    `url:"user_ids,omitempty" json:"user_ids,omitempty"`
+	GroupIDs           *[]int    `url:"group_ids,omitempty" json:"group_ids,omitempty"`
+	Usernames          *[]string `url:"usernames,omitempty" json:"usernames,omitempty"`
+	RemoveHiddenGroups *bool     `url:"remove_hidden_groups,omitempty" json:"remove_hidden_groups,omitempty"`
 }
 
 // UpdateApprovalRule updates an existing approval rule with new options.
@@ -400,7 +463,7 @@ type UpdateMergeRequestApprovalRuleOptions struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/merge_request_approvals.html#update-merge-request-level-rule
 func (s *MergeRequestApprovalsService) UpdateApprovalRule(pid interface{}, mergeRequest int, approvalRule int, opt *UpdateMergeRequestApprovalRuleOptions, options ...RequestOptionFunc) (*MergeRequestApprovalRule, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -425,7 +488,7 @@ func (s *MergeRequestApprovalsService) UpdateApprovalRule(pid interface{}, merge
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/merge_request_approvals.html#delete-merge-request-level-rule
 func (s *MergeRequestApprovalsService) DeleteApprovalRule(pid interface{}, mergeRequest int, approvalRule int, options ...RequestOptionFunc) (*Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, err
 	}
@@ -432,3 +432,56 @@ func TestCreateApprovalRules(t *testing.T) {
 		t.Errorf("MergeRequestApprovals.CreateApprovalRule returned %+v, want %+v", rule, want)
 	}
 }
+
+func TestGetApprovalRule(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/merge_requests/1/approval_rules/2", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{
+			"id": 2,
+			"name": "All Members",
+			"rule_type": "any_approver",
+			"approvals_required": 1,
+			"approved": false,
+			"overridden": true
+		}`)
+	})
+
+	rule, _, err := client.MergeRequestApprovals.GetApprovalRule(1, 1, 2)
+	if err != nil {
+		t.Fatalf("MergeRequestApprovals.GetApprovalRule returned error: %v", err)
+	}
+
+	want := &MergeRequestApprovalRule{
+		ID:                2,
+		Name:              "All Members",
+		RuleType:          AnyApproverRuleType,
+		ApprovalsRequired: 1,
+		Overridden:        true,
+	}
+
+	if !reflect.DeepEqual(want, rule) {
+		t.Errorf("MergeRequestApprovals.GetApprovalRule returned %+v, want %+v", rule, want)
+	}
+}
+
+func TestMergeRequestApprovalStateEligibleApprovers(t *testing.T) {
+	jdoe := &BasicUser{ID: 5, Username: "jdoe"}
+	member := &BasicUser{ID: 50, Username: "group_member_1"}
+	admin := &BasicUser{ID: 1, Username: "root"}
+
+	state := &MergeRequestApprovalState{
+		Rules: []*MergeRequestApprovalRule{
+			{ID: 1, EligibleApprovers: []*BasicUser{jdoe, member}},
+			{ID: 2, EligibleApprovers: []*BasicUser{member, admin}, Approved: true},
+		},
+	}
+
+	if got, want := state.EligibleApprovers(false), []*BasicUser{jdoe, member, admin}; !reflect.DeepEqual(want, got) {
+		t.Errorf("EligibleApprovers(false) returned %+v, want %+v", got, want)
+	}
+	if got, want := state.EligibleApprovers(true), []*BasicUser{jdoe, member}; !reflect.DeepEqual(want, got) {
+		t.Errorf("EligibleApprovers(true) returned %+v, want %+v", got, want)
+	}
+}
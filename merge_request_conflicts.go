@@ -0,0 +1,103 @@
+package gitlab
+
+import (
+	"fmt"
+	"sort"
+)
+
+// MergeRequestConflicts represents the conflicts of a merge request.
+type MergeRequestConflicts struct {
+	// HasConflicts reports whether the merge request has conflicts with its
+	// target branch.
+	HasConflicts bool
+
+	// Files contains the files changed both by the merge request and on the
+	// target branch since the merge request branched off, sorted by path.
+	// The conflicts are in these files, but not every file needs to
+	// conflict. It is only set if the merge request has conflicts.
+	Files []string
+}
+
+// GetMergeRequestConflicts reports whether a merge request has conflicts
+// and, if so, which files can contain them. GitLab does not expose the
+// conflicting files through its APIs, so they are determined by comparing
+// the files changed by the merge request, as reported by the GraphQL API,
+// with the files changed on the target branch since the merge base.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/graphql/reference/#mergerequest
+// https://docs.gitlab.com/ee/api/repositories.html#compare-branches-tags-or-commits
+func (s *MergeRequestsService) GetMergeRequestConflicts(pid interface{}, mergeRequest int, options ...RequestOptionFunc) (*MergeRequestConflicts, *Response, error) {
+	fullPath, err := s.client.projectFullPath(pid, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	q := GraphQLQuery{
+		Query: `query($fullPath: ID!, $iid: String!) {
+			project(fullPath: $fullPath) {
+				mergeRequest(iid: $iid) {
+					conflicts
+					targetBranch
+					diffRefs { baseSha }
+					diffStats { path }
+				}
+			}
+		}`,
+		Variables: map[string]interface{}{
+			"fullPath": fullPath,
+			"iid":      fmt.Sprint(mergeRequest),
+		},
+	}
+
+	var data struct {
+		Project *struct {
+			MergeRequest *struct {
+				Conflicts    bool   `json:"conflicts"`
+				TargetBranch string `json:"targetBranch"`
+				DiffRefs     *struct {
+					BaseSha string `json:"baseSha"`
+				} `json:"diffRefs"`
+				DiffStats []struct {
+					Path string `json:"path"`
+				} `json:"diffStats"`
+			} `json:"mergeRequest"`
+		} `json:"project"`
+	}
+	resp, err := s.client.GraphQL(q, &data, options...)
+	if err != nil {
+		return nil, resp, err
+	}
+	if data.Project == nil || data.Project.MergeRequest == nil {
+		return nil, resp, ErrNotFound
+	}
+
+	mr := data.Project.MergeRequest
+	conflicts := &MergeRequestConflicts{HasConflicts: mr.Conflicts}
+	if !mr.Conflicts || mr.DiffRefs == nil || mr.DiffRefs.BaseSha == "" {
+		return conflicts, resp, nil
+	}
+
+	compare, resp, err := s.client.Repositories.Compare(fullPath, &CompareOptions{
+		From:     Ptr(mr.DiffRefs.BaseSha),
+		To:       Ptr(mr.TargetBranch),
+		Straight: Ptr(true),
+	}, options...)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	changed := make(map[string]bool)
+	for _, d := range compare.Diffs {
+		changed[d.OldPath] = true
+		changed[d.NewPath] = true
+	}
+	for _, st := range mr.DiffStats {
+		if changed[st.Path] {
+			conflicts.Files = append(conflicts.Files, st.Path)
+		}
+	}
+	sort.Strings(conflicts.Files)
+
+	return conflicts, resp, nil
+}
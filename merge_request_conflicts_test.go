@@ -0,0 +1,60 @@
+package gitlab
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetMergeRequestConflicts(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/graphql", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		var q GraphQLQuery
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&q))
+		require.Equal(t, "group/project", q.Variables["fullPath"])
+		require.Equal(t, "7", q.Variables["iid"])
+		fmt.Fprint(w, `{"data": {"project": {"mergeRequest": {
+			"conflicts": true,
+			"targetBranch": "main",
+			"diffRefs": {"baseSha": "abc"},
+			"diffStats": [{"path": "README.md"}, {"path": "main.go"}, {"path": "go.mod"}]
+		}}}}`)
+	})
+	mux.HandleFunc("/api/v4/projects/group/project/repository/compare", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		q := r.URL.Query()
+		require.Equal(t, "abc", q.Get("from"))
+		require.Equal(t, "main", q.Get("to"))
+		require.Equal(t, "true", q.Get("straight"))
+		fmt.Fprint(w, `{"diffs": [
+			{"old_path": "main.go", "new_path": "main.go"},
+			{"old_path": "docs/old.md", "new_path": "README.md"},
+			{"old_path": "LICENSE", "new_path": "LICENSE"}
+		]}`)
+	})
+
+	conflicts, _, err := client.MergeRequests.GetMergeRequestConflicts("group/project", 7)
+	require.NoError(t, err)
+	require.Equal(t, &MergeRequestConflicts{
+		HasConflicts: true,
+		Files:        []string{"README.md", "main.go"},
+	}, conflicts)
+}
+
+func TestGetMergeRequestConflictsWithoutConflicts(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/graphql", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		fmt.Fprint(w, `{"data": {"project": {"mergeRequest": {"conflicts": false, "diffStats": [{"path": "main.go"}]}}}}`)
+	})
+
+	conflicts, _, err := client.MergeRequests.GetMergeRequestConflicts("group/project", 7)
+	require.NoError(t, err)
+	require.Equal(t, &MergeRequestConflicts{}, conflicts)
+}
@@ -0,0 +1,97 @@
+package gitlab
+
+import (
+	"fmt"
+	"strings"
+)
+
+// MergeErrorReason classifies why GitLab failed to merge a merge request.
+type MergeErrorReason string
+
+// These constants represent the reasons a merge can fail.
+const (
+	// MergeErrorConflict means the merge request has conflicts.
+	MergeErrorConflict MergeErrorReason = "conflict"
+
+	// MergeErrorNeedRebase means the source branch must be rebased first,
+	// for example because the project only allows fast-forward merges.
+	MergeErrorNeedRebase MergeErrorReason = "need_rebase"
+
+	// MergeErrorBranchUpdated means the source branch changed after the
+	// merge was requested.
+	MergeErrorBranchUpdated MergeErrorReason = "branch_updated"
+
+	// MergeErrorSquashFailed means squashing the commits failed.
+	MergeErrorSquashFailed MergeErrorReason = "squash_failed"
+
+	// MergeErrorHookDeclined means a server hook or push rule rejected the
+	// merge.
+	MergeErrorHookDeclined MergeErrorReason = "hook_declined"
+
+	// MergeErrorPipelineFailed means the pipeline did not succeed.
+	MergeErrorPipelineFailed MergeErrorReason = "pipeline_failed"
+
+	// MergeErrorNotMergeable means one of the merge checks did not pass.
+	MergeErrorNotMergeable MergeErrorReason = "not_mergeable"
+
+	// MergeErrorUnknown is used for all other failures.
+	MergeErrorUnknown MergeErrorReason = "unknown"
+)
+
+// mergeErrorReasons maps parts of the merge errors reported by GitLab to
+// their reasons. The first match wins.
+var mergeErrorReasons = []struct {
+	substr string
+	reason MergeErrorReason
+}{
+	{"squash", MergeErrorSquashFailed},
+	{"conflict", MergeErrorConflict},
+	{"fast-forward", MergeErrorNeedRebase},
+	{"rebase", MergeErrorNeedRebase},
+	{"has been updated", MergeErrorBranchUpdated},
+	{"does not match head", MergeErrorBranchUpdated},
+	{"hook", MergeErrorHookDeclined},
+	{"push rule", MergeErrorHookDeclined},
+	{"pipeline", MergeErrorPipelineFailed},
+	{"not mergeable", MergeErrorNotMergeable},
+	{"cannot be merged", MergeErrorNotMergeable},
+}
+
+// MergeError is the error a merge request failed to be merged with, for
+// example when merging automatically after the pipeline succeeded.
+type MergeError struct {
+	ProjectID    int
+	MergeRequest int
+	Reason       MergeErrorReason
+
+	// Message is the error message reported by GitLab.
+	Message string
+}
+
+func (e *MergeError) Error() string {
+	return fmt.Sprintf("merge request !%d of project %d failed to merge (%s): %s", e.MergeRequest, e.ProjectID, e.Reason, e.Message)
+}
+
+// MergeFailure returns the error the merge request last failed to be merged
+// with as a *MergeError, or nil if there is no merge error.
+func (m *MergeRequest) MergeFailure() error {
+	if m.MergeError == "" {
+		return nil
+	}
+
+	reason := MergeErrorUnknown
+	msg := strings.ToLower(m.MergeError)
+	for _, r := range mergeErrorReasons {
+		if strings.Contains(msg, r.substr) {
+			reason = r.reason
+			break
+		}
+	}
+
+	return &MergeError{
+		ProjectID:    m.ProjectID,
+		MergeRequest: m.IID,
+		Reason:       reason,
+		Message:      m.MergeError,
+	}
+}
@@ -61,6 +61,8 @@ type MergeRequest struct {
 	Milestone                 *Milestone          `json:"milestone"`
 	MergeWhenPipelineSucceeds bool                `json:"merge_when_pipeline_succeeds"`
 	DetailedMergeStatus       string              `json:"detailed_merge_status"`
+	MergeAfter                *time.Time          `json:"merge_after"`
+	PreparedAt                *time.Time          `json:"prepared_at"`
 	MergeError                string              `json:"merge_error"`
 	MergedBy                  *BasicUser          `json:"merged_by"`
 	MergedAt                  *time.Time          `json:"merged_at"`
@@ -109,6 +111,12 @@ func (m MergeRequest) String() string {
 	return Stringify(m)
 }
 
+// DetailedMergeStatusValue returns the detailed merge status of the merge
+// request as a typed value.
+func (m *MergeRequest) DetailedMergeStatusValue() DetailedMergeStatusValue {
+	return DetailedMergeStatusValue(m.DetailedMergeStatus)
+}
+
 func (m *MergeRequest) UnmarshalJSON(data []byte) error {
 	type alias MergeRequest
 
@@ -283,7 +291,7 @@ type ListProjectMergeRequestsOptions struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/merge_requests.html#list-project-merge-requests
 func (s *MergeRequestsService) ListProjectMergeRequests(pid interface{}, opt *ListProjectMergeRequestsOptions, options ...RequestOptionFunc) ([]*MergeRequest, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -346,7 +354,7 @@ type ListGroupMergeRequestsOptions struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/merge_requests.html#list-group-merge-requests
 func (s *MergeRequestsService) ListGroupMergeRequests(gid interface{}, opt *ListGroupMergeRequestsOptions, options ...RequestOptionFunc) ([]*MergeRequest, *Response, error) {
-	group, err := parseID(gid)
+	group, err := parseGroupID(gid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -382,7 +390,7 @@ type GetMergeRequestsOptions struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/merge_requests.html#get-single-mr
 func (s *MergeRequestsService) GetMergeRequest(pid interface{}, mergeRequest int, opt *GetMergeRequestsOptions, options ...RequestOptionFunc) (*MergeRequest, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -407,7 +415,7 @@ func (s *MergeRequestsService) GetMergeRequest(pid interface{}, mergeRequest int
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/merge_request_approvals.html#merge-request-level-mr-approvals
 func (s *MergeRequestsService) GetMergeRequestApprovals(pid interface{}, mergeRequest int, options ...RequestOptionFunc) (*MergeRequestApprovals, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -439,7 +447,7 @@ type GetMergeRequestCommitsOptions ListOptions
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/merge_requests.html#get-single-merge-request-commits
 func (s *MergeRequestsService) GetMergeRequestCommits(pid interface{}, mergeRequest int, opt *GetMergeRequestCommitsOptions, options ...RequestOptionFunc) ([]*Commit, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -478,7 +486,7 @@ type GetMergeRequestChangesOptions struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/merge_requests.html#get-single-merge-request-changes
 func (s *MergeRequestsService) GetMergeRequestChanges(pid interface{}, mergeRequest int, opt *GetMergeRequestChangesOptions, options ...RequestOptionFunc) (*MergeRequest, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -513,7 +521,7 @@ type ListMergeRequestDiffsOptions struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/merge_requests.html#list-merge-request-diffs
 func (s *MergeRequestsService) ListMergeRequestDiffs(pid interface{}, mergeRequest int, opt *ListMergeRequestDiffsOptions, options ...RequestOptionFunc) ([]*MergeRequestDiff, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -538,7 +546,7 @@ func (s *MergeRequestsService) ListMergeRequestDiffs(pid interface{}, mergeReque
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/merge_requests.html#get-single-merge-request-participants
 func (s *MergeRequestsService) GetMergeRequestParticipants(pid interface{}, mergeRequest int, options ...RequestOptionFunc) ([]*BasicUser, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -573,7 +581,7 @@ type MergeRequestReviewer struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/merge_requests.html#get-single-merge-request-reviewers
 func (s *MergeRequestsService) GetMergeRequestReviewers(pid interface{}, mergeRequest int, options ...RequestOptionFunc) ([]*MergeRequestReviewer, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -598,7 +606,7 @@ func (s *MergeRequestsService) GetMergeRequestReviewers(pid interface{}, mergeRe
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/merge_requests.html#list-merge-request-pipelines
 func (s *MergeRequestsService) ListMergeRequestPipelines(pid interface{}, mergeRequest int, options ...RequestOptionFunc) ([]*PipelineInfo, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -623,7 +631,7 @@ func (s *MergeRequestsService) ListMergeRequestPipelines(pid interface{}, mergeR
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/merge_requests.html#create-merge-request-pipeline
 func (s *MergeRequestsService) CreateMergeRequestPipeline(pid interface{}, mergeRequest int, options ...RequestOptionFunc) (*PipelineInfo, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -656,7 +664,7 @@ type GetIssuesClosedOnMergeOptions ListOptions
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/merge_requests.html#list-issues-that-close-on-merge
 func (s *MergeRequestsService) GetIssuesClosedOnMerge(pid interface{}, mergeRequest int, opt *GetIssuesClosedOnMergeOptions, options ...RequestOptionFunc) ([]*Issue, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -696,6 +704,7 @@ type CreateMergeRequestOptions struct {
 	Squash               *bool         `url:"squash,omitempty" json:"squash,omitempty"`
 	AllowCollaboration   *bool         `url:"allow_collaboration,omitempty" json:"allow_collaboration,omitempty"`
 	ApprovalsBeforeMerge *int          `url:"approvals_before_merge,omitempty" json:"approvals_before_merge,omitempty"`
+	MergeAfter           *time.Time    `url:"merge_after,omitempty" json:"merge_after,omitempty"`
 }
 
 // CreateMergeRequest creates a new merge request.
@@ -703,7 +712,7 @@ type CreateMergeRequestOptions struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/merge_requests.html#create-mr
 func (s *MergeRequestsService) CreateMergeRequest(pid interface{}, opt *CreateMergeRequestOptions, options ...RequestOptionFunc) (*MergeRequest, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -744,6 +753,7 @@ type UpdateMergeRequestOptions struct {
 	Squash             *bool         `url:"squash,omitempty" json:"squash,omitempty"`
 	DiscussionLocked   *bool         `url:"discussion_locked,omitempty" json:"discussion_locked,omitempty"`
 	AllowCollaboration *bool         `url:"allow_collaboration,omitempty" json:"allow_collaboration,omitempty"`
+	MergeAfter         *time.Time    `url:"merge_after,omitempty" json:"merge_after,omitempty"`
 }
 
 // UpdateMergeRequest updates an existing project milestone.
@@ -751,7 +761,7 @@ type UpdateMergeRequestOptions struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/merge_requests.html#update-mr
 func (s *MergeRequestsService) UpdateMergeRequest(pid interface{}, mergeRequest int, opt *UpdateMergeRequestOptions, options ...RequestOptionFunc) (*MergeRequest, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -776,7 +786,7 @@ func (s *MergeRequestsService) UpdateMergeRequest(pid interface{}, mergeRequest
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/merge_requests.html#delete-a-merge-request
 func (s *MergeRequestsService) DeleteMergeRequest(pid interface{}, mergeRequest int, options ...RequestOptionFunc) (*Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, err
 	}
@@ -812,7 +822,7 @@ type AcceptMergeRequestOptions struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/merge_requests.html#merge-a-merge-request
 func (s *MergeRequestsService) AcceptMergeRequest(pid interface{}, mergeRequest int, opt *AcceptMergeRequestOptions, options ...RequestOptionFunc) (*MergeRequest, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -836,12 +846,13 @@ func (s *MergeRequestsService) AcceptMergeRequest(pid interface{}, mergeRequest
 // you don't have permissions to accept this merge request - you'll get a 401.
 // If the merge request is already merged or closed - you get 405 and error
 // message 'Method Not Allowed'. In case the merge request is not set to be
-// merged when the pipeline succeeds, you'll also get a 406 error.
+// merged when the pipeline succeeds, you'll also get a 406 error. Use
+// MergeRequest.MergeFailure to find out why an automatic merge failed.
 //
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/merge_requests.html#cancel-merge-when-pipeline-succeeds
 func (s *MergeRequestsService) CancelMergeWhenPipelineSucceeds(pid interface{}, mergeRequest int, options ...RequestOptionFunc) (*MergeRequest, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -877,7 +888,7 @@ type RebaseMergeRequestOptions struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/merge_requests.html#rebase-a-merge-request
 func (s *MergeRequestsService) RebaseMergeRequest(pid interface{}, mergeRequest int, opt *RebaseMergeRequestOptions, options ...RequestOptionFunc) (*Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, err
 	}
@@ -903,7 +914,7 @@ type GetMergeRequestDiffVersionsOptions ListOptions
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/merge_requests.html#get-merge-request-diff-versions
 func (s *MergeRequestsService) GetMergeRequestDiffVersions(pid interface{}, mergeRequest int, opt *GetMergeRequestDiffVersionsOptions, options ...RequestOptionFunc) ([]*MergeRequestDiffVersion, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -937,7 +948,7 @@ type GetSingleMergeRequestDiffVersionOptions struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/merge_requests.html#get-a-single-merge-request-diff-version
 func (s *MergeRequestsService) GetSingleMergeRequestDiffVersion(pid interface{}, mergeRequest, version int, opt *GetSingleMergeRequestDiffVersionOptions, options ...RequestOptionFunc) (*MergeRequestDiffVersion, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -964,7 +975,7 @@ func (s *MergeRequestsService) GetSingleMergeRequestDiffVersion(pid interface{},
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/merge_requests.html#subscribe-to-a-merge-request
 func (s *MergeRequestsService) SubscribeToMergeRequest(pid interface{}, mergeRequest int, options ...RequestOptionFunc) (*MergeRequest, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -992,7 +1003,7 @@ func (s *MergeRequestsService) SubscribeToMergeRequest(pid interface{}, mergeReq
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/merge_requests.html#unsubscribe-from-a-merge-request
 func (s *MergeRequestsService) UnsubscribeFromMergeRequest(pid interface{}, mergeRequest int, options ...RequestOptionFunc) (*MergeRequest, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -1019,7 +1030,7 @@ func (s *MergeRequestsService) UnsubscribeFromMergeRequest(pid interface{}, merg
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/merge_requests.html#create-a-to-do-item
 func (s *MergeRequestsService) CreateTodo(pid interface{}, mergeRequest int, options ...RequestOptionFunc) (*Todo, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
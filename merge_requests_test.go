@@ -500,3 +500,51 @@ func TestAssigneeIDMarshalling(t *testing.T) {
 		assert.Equal(t, `{"assignee_id":5}`, string(js))
 	})
 }
+
+func TestGetMergeRequestDetailedMergeStatus(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/merge_requests/2", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{
+			"id": 20,
+			"iid": 2,
+			"detailed_merge_status": "merge_time",
+			"merge_after": "2024-05-01T10:00:00Z",
+			"prepared_at": "2024-04-30T08:00:00Z"
+		}`)
+	})
+
+	mr, _, err := client.MergeRequests.GetMergeRequest(1, 2, nil)
+	require.NoError(t, err)
+	require.Equal(t, DetailedMergeStatusMergeTime, mr.DetailedMergeStatusValue())
+	require.True(t, mr.DetailedMergeStatusValue().Final())
+	require.Equal(t, time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC), *mr.MergeAfter)
+	require.Equal(t, time.Date(2024, 4, 30, 8, 0, 0, 0, time.UTC), *mr.PreparedAt)
+
+	require.False(t, DetailedMergeStatusChecking.Final())
+	require.False(t, DetailedMergeStatusPreparing.Final())
+}
+
+func TestMergeRequestMergeFailure(t *testing.T) {
+	require.NoError(t, (&MergeRequest{}).MergeFailure())
+
+	tests := map[string]MergeErrorReason{
+		"Merge request has merge conflicts":                                                          MergeErrorConflict,
+		"Only fast-forward merge is allowed for your project. Please update your source branch":      MergeErrorNeedRebase,
+		"Branch has been updated since the merge was requested. Please review the changes.":          MergeErrorBranchUpdated,
+		"Squashing failed: Squash the commits locally, resolve any conflicts, then push the branch.": MergeErrorSquashFailed,
+		"Something went wrong during merge pre-receive hook.":                                        MergeErrorHookDeclined,
+		"Merge request is not mergeable":                                                             MergeErrorNotMergeable,
+		"Something went wrong":                                                                       MergeErrorUnknown,
+	}
+	for msg, reason := range tests {
+		err := (&MergeRequest{ProjectID: 1, IID: 2, MergeError: msg}).MergeFailure()
+
+		var mergeErr *MergeError
+		require.ErrorAs(t, err, &mergeErr)
+		require.Equal(t, reason, mergeErr.Reason, msg)
+		require.Equal(t, msg, mergeErr.Message)
+		require.Equal(t, 2, mergeErr.MergeRequest)
+	}
+}
@@ -3,6 +3,7 @@ package gitlab
 import (
 	"fmt"
 	"net/http"
+	"net/url"
 	"time"
 )
 
@@ -60,7 +61,7 @@ type ListMergeTrainsOptions struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/merge_trains.html#list-merge-trains-for-a-project
 func (s *MergeTrainsService) ListProjectMergeTrains(pid interface{}, opt *ListMergeTrainsOptions, options ...RequestOptionFunc) ([]*MergeTrain, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -86,11 +87,11 @@ func (s *MergeTrainsService) ListProjectMergeTrains(pid interface{}, opt *ListMe
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/merge_trains.html#list-merge-requests-in-a-merge-train
 func (s *MergeTrainsService) ListMergeRequestInMergeTrain(pid interface{}, targetBranch string, opts *ListMergeTrainsOptions, options ...RequestOptionFunc) ([]*MergeTrain, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("projects/%s/merge_trains/%s", PathEscape(project), targetBranch)
+	u := fmt.Sprintf("projects/%s/merge_trains/%s", PathEscape(project), url.PathEscape(targetBranch))
 
 	req, err := s.client.NewRequest(http.MethodGet, u, opts, options)
 	if err != nil {
@@ -112,7 +113,7 @@ func (s *MergeTrainsService) ListMergeRequestInMergeTrain(pid interface{}, targe
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/merge_trains.html#get-the-status-of-a-merge-request-on-a-merge-train
 func (s *MergeTrainsService) GetMergeRequestOnAMergeTrain(pid interface{}, mergeRequest int, options ...RequestOptionFunc) (*MergeTrain, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -149,7 +150,7 @@ type AddMergeRequestToMergeTrainOptions struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/merge_trains.html#add-a-merge-request-to-a-merge-train
 func (s *MergeTrainsService) AddMergeRequestToMergeTrain(pid interface{}, mergeRequest int, opts *AddMergeRequestToMergeTrainOptions, options ...RequestOptionFunc) ([]*MergeTrain, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -48,6 +48,10 @@ type Namespace struct {
 	Trial                       bool     `json:"trial"`
 	MaxSeatsUsed                *int     `json:"max_seats_used"`
 	SeatsInUse                  *int     `json:"seats_in_use"`
+
+	// Compute quota settings, only returned to administrators.
+	SharedRunnersMinutesLimit      *int `json:"shared_runners_minutes_limit"`
+	ExtraSharedRunnersMinutesLimit *int `json:"extra_shared_runners_minutes_limit"`
 }
 
 func (n Namespace) String() string {
@@ -131,6 +135,41 @@ func (s *NamespacesService) GetNamespace(id interface{}, options ...RequestOptio
 	return n, resp, nil
 }
 
+// UpdateNamespaceOptions represents the available UpdateNamespace() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/development/internal_api/index.html#update-a-namespace
+type UpdateNamespaceOptions struct {
+	SharedRunnersMinutesLimit      *int `url:"shared_runners_minutes_limit,omitempty" json:"shared_runners_minutes_limit,omitempty"`
+	ExtraSharedRunnersMinutesLimit *int `url:"extra_shared_runners_minutes_limit,omitempty" json:"extra_shared_runners_minutes_limit,omitempty"`
+}
+
+// UpdateNamespace updates the compute quota of a namespace. Authentication as
+// Administrator is required.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/development/internal_api/index.html#update-a-namespace
+func (s *NamespacesService) UpdateNamespace(id interface{}, opt *UpdateNamespaceOptions, options ...RequestOptionFunc) (*Namespace, *Response, error) {
+	namespace, err := parseID(id)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("namespaces/%s", PathEscape(namespace))
+
+	req, err := s.client.NewRequest(http.MethodPut, u, opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	n := new(Namespace)
+	resp, err := s.client.Do(req, n)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return n, resp, nil
+}
+
 // NamespaceExistance represents a namespace exists result.
 //
 // GitLab API docs:
@@ -172,3 +211,71 @@ func (s *NamespacesService) NamespaceExists(id interface{}, opt *NamespaceExists
 
 	return n, resp, nil
 }
+
+// NamespaceSubscription represents the GitLab subscription of a namespace.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/namespaces.html#get-subscription-details
+type NamespaceSubscription struct {
+	Plan    NamespaceSubscriptionPlan    `json:"plan"`
+	Usage   NamespaceSubscriptionUsage   `json:"usage"`
+	Billing NamespaceSubscriptionBilling `json:"billing"`
+}
+
+// NamespaceSubscriptionPlan represents the plan of a namespace subscription.
+type NamespaceSubscriptionPlan struct {
+	Code          string `json:"code"`
+	Name          string `json:"name"`
+	Trial         bool   `json:"trial"`
+	AutoRenew     *bool  `json:"auto_renew"`
+	Upgradable    bool   `json:"upgradable"`
+	ExcludeGuests bool   `json:"exclude_guests"`
+}
+
+// NamespaceSubscriptionUsage represents the seat usage of a namespace
+// subscription.
+type NamespaceSubscriptionUsage struct {
+	SeatsInSubscription int `json:"seats_in_subscription"`
+	SeatsInUse          int `json:"seats_in_use"`
+	MaxSeatsUsed        int `json:"max_seats_used"`
+	SeatsOwed           int `json:"seats_owed"`
+}
+
+// NamespaceSubscriptionBilling represents the billing period of a namespace
+// subscription.
+type NamespaceSubscriptionBilling struct {
+	SubscriptionStartDate *ISOTime `json:"subscription_start_date"`
+	SubscriptionEndDate   *ISOTime `json:"subscription_end_date"`
+	TrialEndsOn           *ISOTime `json:"trial_ends_on"`
+}
+
+func (n NamespaceSubscription) String() string {
+	return Stringify(n)
+}
+
+// GetNamespaceSubscription gets the GitLab subscription of a top-level
+// namespace, including its plan, seat usage and billing period. Only
+// available on GitLab.com.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/namespaces.html#get-subscription-details
+func (s *NamespacesService) GetNamespaceSubscription(id interface{}, options ...RequestOptionFunc) (*NamespaceSubscription, *Response, error) {
+	namespace, err := parseID(id)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("namespaces/%s/gitlab_subscription", PathEscape(namespace))
+
+	req, err := s.client.NewRequest(http.MethodGet, u, nil, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	n := new(NamespaceSubscription)
+	resp, err := s.client.Do(req, n)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return n, resp, nil
+}
@@ -303,3 +303,63 @@ func TestSearchNamespace(t *testing.T) {
 		t.Errorf("Namespaces.SearchNamespaces returned \ngot:\n%v\nwant:\n%v", Stringify(namespaces), Stringify(want))
 	}
 }
+
+func TestUpdateNamespace(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/namespaces/2", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		testBody(t, r, `{"shared_runners_minutes_limit":400,"extra_shared_runners_minutes_limit":100}`)
+		fmt.Fprint(w, `{"id": 2, "path": "group1", "shared_runners_minutes_limit": 400, "extra_shared_runners_minutes_limit": 100}`)
+	})
+
+	namespace, _, err := client.Namespaces.UpdateNamespace(2, &UpdateNamespaceOptions{
+		SharedRunnersMinutesLimit:      Ptr(400),
+		ExtraSharedRunnersMinutesLimit: Ptr(100),
+	})
+	if err != nil {
+		t.Errorf("Namespaces.UpdateNamespace returned error: %v", err)
+	}
+
+	want := &Namespace{
+		ID:                             2,
+		Path:                           "group1",
+		SharedRunnersMinutesLimit:      Ptr(400),
+		ExtraSharedRunnersMinutesLimit: Ptr(100),
+	}
+	if !reflect.DeepEqual(namespace, want) {
+		t.Errorf("Namespaces.UpdateNamespace returned \ngot:\n%v\nwant:\n%v", Stringify(namespace), Stringify(want))
+	}
+}
+
+func TestGetNamespaceSubscription(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/namespaces/1/gitlab_subscription", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{
+			"plan": {"code": "ultimate", "name": "Ultimate", "trial": false, "auto_renew": null, "upgradable": false, "exclude_guests": false},
+			"usage": {"seats_in_subscription": 10, "seats_in_use": 1, "max_seats_used": 3, "seats_owed": 0},
+			"billing": {"subscription_start_date": "2022-04-22", "subscription_end_date": "2023-04-22", "trial_ends_on": null}
+		}`)
+	})
+
+	subscription, _, err := client.Namespaces.GetNamespaceSubscription(1)
+	if err != nil {
+		t.Errorf("Namespaces.GetNamespaceSubscription returned error: %v", err)
+	}
+
+	start := ISOTime(time.Date(2022, time.April, 22, 0, 0, 0, 0, time.UTC))
+	end := ISOTime(time.Date(2023, time.April, 22, 0, 0, 0, 0, time.UTC))
+	want := &NamespaceSubscription{
+		Plan:  NamespaceSubscriptionPlan{Code: "ultimate", Name: "Ultimate"},
+		Usage: NamespaceSubscriptionUsage{SeatsInSubscription: 10, SeatsInUse: 1, MaxSeatsUsed: 3},
+		Billing: NamespaceSubscriptionBilling{
+			SubscriptionStartDate: &start,
+			SubscriptionEndDate:   &end,
+		},
+	}
+	if !reflect.DeepEqual(subscription, want) {
+		t.Errorf("Namespaces.GetNamespaceSubscription returned \ngot:\n%v\nwant:\n%v", Stringify(subscription), Stringify(want))
+	}
+}
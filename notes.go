@@ -106,6 +106,13 @@ func (n Note) String() string {
 	return Stringify(n)
 }
 
+// IsInternal reports whether the note is only visible to project members
+// with at least the Reporter role. Older GitLab versions only set the
+// deprecated confidential flag.
+func (n *Note) IsInternal() bool {
+	return n.Internal || n.Confidential
+}
+
 // ListIssueNotesOptions represents the available ListIssueNotes() options.
 //
 // GitLab API docs:
@@ -121,7 +128,7 @@ type ListIssueNotesOptions struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/notes.html#list-project-issue-notes
 func (s *NotesService) ListIssueNotes(pid interface{}, issue int, opt *ListIssueNotesOptions, options ...RequestOptionFunc) ([]*Note, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -146,7 +153,7 @@ func (s *NotesService) ListIssueNotes(pid interface{}, issue int, opt *ListIssue
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/notes.html#get-single-issue-note
 func (s *NotesService) GetIssueNote(pid interface{}, issue, note int, options ...RequestOptionFunc) (*Note, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -174,6 +181,7 @@ func (s *NotesService) GetIssueNote(pid interface{}, issue, note int, options ..
 type CreateIssueNoteOptions struct {
 	Body      *string    `url:"body,omitempty" json:"body,omitempty"`
 	CreatedAt *time.Time `url:"created_at,omitempty" json:"created_at,omitempty"`
+	Internal  *bool      `url:"internal,omitempty" json:"internal,omitempty"`
 }
 
 // CreateIssueNote creates a new note to a single project issue.
@@ -181,7 +189,7 @@ type CreateIssueNoteOptions struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/notes.html#create-new-issue-note
 func (s *NotesService) CreateIssueNote(pid interface{}, issue int, opt *CreateIssueNoteOptions, options ...RequestOptionFunc) (*Note, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -215,7 +223,7 @@ type UpdateIssueNoteOptions struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/notes.html#modify-existing-issue-note
 func (s *NotesService) UpdateIssueNote(pid interface{}, issue, note int, opt *UpdateIssueNoteOptions, options ...RequestOptionFunc) (*Note, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -240,7 +248,7 @@ func (s *NotesService) UpdateIssueNote(pid interface{}, issue, note int, opt *Up
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/notes.html#delete-an-issue-note
 func (s *NotesService) DeleteIssueNote(pid interface{}, issue, note int, options ...RequestOptionFunc) (*Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, err
 	}
@@ -270,7 +278,7 @@ type ListSnippetNotesOptions struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/notes.html#list-all-snippet-notes
 func (s *NotesService) ListSnippetNotes(pid interface{}, snippet int, opt *ListSnippetNotesOptions, options ...RequestOptionFunc) ([]*Note, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -295,7 +303,7 @@ func (s *NotesService) ListSnippetNotes(pid interface{}, snippet int, opt *ListS
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/notes.html#get-single-snippet-note
 func (s *NotesService) GetSnippetNote(pid interface{}, snippet, note int, options ...RequestOptionFunc) (*Note, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -330,7 +338,7 @@ type CreateSnippetNoteOptions struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/notes.html#create-new-snippet-note
 func (s *NotesService) CreateSnippetNote(pid interface{}, snippet int, opt *CreateSnippetNoteOptions, options ...RequestOptionFunc) (*Note, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -364,7 +372,7 @@ type UpdateSnippetNoteOptions struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/notes.html#modify-existing-snippet-note
 func (s *NotesService) UpdateSnippetNote(pid interface{}, snippet, note int, opt *UpdateSnippetNoteOptions, options ...RequestOptionFunc) (*Note, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -389,7 +397,7 @@ func (s *NotesService) UpdateSnippetNote(pid interface{}, snippet, note int, opt
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/notes.html#delete-a-snippet-note
 func (s *NotesService) DeleteSnippetNote(pid interface{}, snippet, note int, options ...RequestOptionFunc) (*Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, err
 	}
@@ -419,7 +427,7 @@ type ListMergeRequestNotesOptions struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/notes.html#list-all-merge-request-notes
 func (s *NotesService) ListMergeRequestNotes(pid interface{}, mergeRequest int, opt *ListMergeRequestNotesOptions, options ...RequestOptionFunc) ([]*Note, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -444,7 +452,7 @@ func (s *NotesService) ListMergeRequestNotes(pid interface{}, mergeRequest int,
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/notes.html#get-single-merge-request-note
 func (s *NotesService) GetMergeRequestNote(pid interface{}, mergeRequest, note int, options ...RequestOptionFunc) (*Note, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -470,7 +478,8 @@ func (s *NotesService) GetMergeRequestNote(pid interface{}, mergeRequest, note i
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/notes.html#create-new-merge-request-note
 type CreateMergeRequestNoteOptions struct {
-	Body *string `url:"body,omitempty" json:"body,omitempty"`
+	Body     *string `url:"body,omitempty" json:"body,omitempty"`
+	Internal *bool   `url:"internal,omitempty" json:"internal,omitempty"`
 }
 
 // CreateMergeRequestNote creates a new note for a single merge request.
@@ -478,7 +487,7 @@ type CreateMergeRequestNoteOptions struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/notes.html#create-new-merge-request-note
 func (s *NotesService) CreateMergeRequestNote(pid interface{}, mergeRequest int, opt *CreateMergeRequestNoteOptions, options ...RequestOptionFunc) (*Note, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -512,7 +521,7 @@ type UpdateMergeRequestNoteOptions struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/notes.html#modify-existing-merge-request-note
 func (s *NotesService) UpdateMergeRequestNote(pid interface{}, mergeRequest, note int, opt *UpdateMergeRequestNoteOptions, options ...RequestOptionFunc) (*Note, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -537,7 +546,7 @@ func (s *NotesService) UpdateMergeRequestNote(pid interface{}, mergeRequest, not
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/notes.html#delete-a-merge-request-note
 func (s *NotesService) DeleteMergeRequestNote(pid interface{}, mergeRequest, note int, options ...RequestOptionFunc) (*Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, err
 	}
@@ -567,7 +576,7 @@ type ListEpicNotesOptions struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/notes.html#list-all-epic-notes
 func (s *NotesService) ListEpicNotes(gid interface{}, epic int, opt *ListEpicNotesOptions, options ...RequestOptionFunc) ([]*Note, *Response, error) {
-	group, err := parseID(gid)
+	group, err := parseGroupID(gid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -592,7 +601,7 @@ func (s *NotesService) ListEpicNotes(gid interface{}, epic int, opt *ListEpicNot
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/notes.html#get-single-epic-note
 func (s *NotesService) GetEpicNote(gid interface{}, epic, note int, options ...RequestOptionFunc) (*Note, *Response, error) {
-	group, err := parseID(gid)
+	group, err := parseGroupID(gid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -617,7 +626,8 @@ func (s *NotesService) GetEpicNote(gid interface{}, epic, note int, options ...R
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/notes.html#create-new-epic-note
 type CreateEpicNoteOptions struct {
-	Body *string `url:"body,omitempty" json:"body,omitempty"`
+	Body     *string `url:"body,omitempty" json:"body,omitempty"`
+	Internal *bool   `url:"internal,omitempty" json:"internal,omitempty"`
 }
 
 // CreateEpicNote creates a new note for a single merge request.
@@ -625,7 +635,7 @@ type CreateEpicNoteOptions struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/notes.html#create-new-epic-note
 func (s *NotesService) CreateEpicNote(gid interface{}, epic int, opt *CreateEpicNoteOptions, options ...RequestOptionFunc) (*Note, *Response, error) {
-	group, err := parseID(gid)
+	group, err := parseGroupID(gid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -657,7 +667,7 @@ type UpdateEpicNoteOptions struct {
 //
 // https://docs.gitlab.com/ee/api/notes.html#modify-existing-epic-note
 func (s *NotesService) UpdateEpicNote(gid interface{}, epic, note int, opt *UpdateEpicNoteOptions, options ...RequestOptionFunc) (*Note, *Response, error) {
-	group, err := parseID(gid)
+	group, err := parseGroupID(gid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -681,7 +691,7 @@ func (s *NotesService) UpdateEpicNote(gid interface{}, epic, note int, opt *Upda
 //
 // https://docs.gitlab.com/ee/api/notes.html#delete-an-epic-note
 func (s *NotesService) DeleteEpicNote(gid interface{}, epic, note int, options ...RequestOptionFunc) (*Response, error) {
-	group, err := parseID(gid)
+	group, err := parseGroupID(gid)
 	if err != nil {
 		return nil, err
 	}
@@ -78,3 +78,40 @@ func TestGetMergeRequestNote(t *testing.T) {
 		t.Errorf("Notes.GetEpicNote want %#v, got %#v", note, want)
 	}
 }
+
+func TestCreateInternalIssueNote(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/issues/5/notes", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testBody(t, r, `{"body":"Customer ticket #123","internal":true}`)
+		fmt.Fprint(w, `{"id":3,"body":"Customer ticket #123","noteable_type":"Issue","internal":true,"confidential":true}`)
+	})
+
+	note, _, err := client.Notes.CreateIssueNote(1, 5, &CreateIssueNoteOptions{
+		Body:     Ptr("Customer ticket #123"),
+		Internal: Ptr(true),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !note.IsInternal() {
+		t.Errorf("Notes.CreateIssueNote returned a note which is not internal: %#v", note)
+	}
+}
+
+func TestNoteIsInternal(t *testing.T) {
+	for _, tc := range []struct {
+		note *Note
+		want bool
+	}{
+		{&Note{}, false},
+		{&Note{Internal: true}, true},
+		{&Note{Confidential: true}, true},
+	} {
+		if got := tc.note.IsInternal(); got != tc.want {
+			t.Errorf("IsInternal() for %#v returned %t, want %t", tc.note, got, tc.want)
+		}
+	}
+}
@@ -146,7 +146,7 @@ func (s *NotificationSettingsService) UpdateGlobalSettings(opt *NotificationSett
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/notification_settings.html#group--project-level-notification-settings
 func (s *NotificationSettingsService) GetSettingsForGroup(gid interface{}, options ...RequestOptionFunc) (*NotificationSettings, *Response, error) {
-	group, err := parseID(gid)
+	group, err := parseGroupID(gid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -171,7 +171,7 @@ func (s *NotificationSettingsService) GetSettingsForGroup(gid interface{}, optio
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/notification_settings.html#group--project-level-notification-settings
 func (s *NotificationSettingsService) GetSettingsForProject(pid interface{}, options ...RequestOptionFunc) (*NotificationSettings, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -196,7 +196,7 @@ func (s *NotificationSettingsService) GetSettingsForProject(pid interface{}, opt
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/notification_settings.html#update-groupproject-level-notification-settings
 func (s *NotificationSettingsService) UpdateSettingsForGroup(gid interface{}, opt *NotificationSettingsOptions, options ...RequestOptionFunc) (*NotificationSettings, *Response, error) {
-	group, err := parseID(gid)
+	group, err := parseGroupID(gid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -221,7 +221,7 @@ func (s *NotificationSettingsService) UpdateSettingsForGroup(gid interface{}, op
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/notification_settings.html#update-groupproject-level-notification-settings
 func (s *NotificationSettingsService) UpdateSettingsForProject(pid interface{}, opt *NotificationSettingsOptions, options ...RequestOptionFunc) (*NotificationSettings, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -0,0 +1,174 @@
+package gitlab
+
+import (
+	"crypto/sha1"
+	"crypto/sha512"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+// NPMPackagesService handles communication with the npm package registry
+// related methods of the GitLab API.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/packages/npm.html
+type NPMPackagesService struct {
+	client *Client
+}
+
+// NPMPackageMetadata represents the metadata of an npm package.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/packages/npm.html#metadata
+type NPMPackageMetadata struct {
+	Name     string                        `json:"name"`
+	Versions map[string]*NPMPackageVersion `json:"versions"`
+	DistTags map[string]string             `json:"dist-tags"`
+}
+
+// NPMPackageVersion represents a single version of an npm package.
+type NPMPackageVersion struct {
+	Name                 string            `json:"name"`
+	Version              string            `json:"version"`
+	Dist                 NPMPackageDist    `json:"dist"`
+	Dependencies         map[string]string `json:"dependencies,omitempty"`
+	DevDependencies      map[string]string `json:"devDependencies,omitempty"`
+	PeerDependencies     map[string]string `json:"peerDependencies,omitempty"`
+	BundleDependencies   map[string]string `json:"bundleDependencies,omitempty"`
+	OptionalDependencies map[string]string `json:"optionalDependencies,omitempty"`
+}
+
+// NPMPackageDist describes the tarball of an npm package version.
+type NPMPackageDist struct {
+	Shasum    string `json:"shasum"`
+	Integrity string `json:"integrity,omitempty"`
+	Tarball   string `json:"tarball"`
+}
+
+func (m NPMPackageMetadata) String() string {
+	return Stringify(m)
+}
+
+// GetInstancePackageMetadata gets the metadata of an npm package from the
+// instance-level registry. The package name includes its scope, for example
+// "@my-group/my-package".
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/packages/npm.html#metadata
+func (s *NPMPackagesService) GetInstancePackageMetadata(packageName string, options ...RequestOptionFunc) (*NPMPackageMetadata, *Response, error) {
+	u := fmt.Sprintf("packages/npm/%s", PathEscape(packageName))
+	return s.getPackageMetadata(u, options)
+}
+
+// GetProjectPackageMetadata gets the metadata of an npm package from the
+// registry of a project.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/packages/npm.html#metadata
+func (s *NPMPackagesService) GetProjectPackageMetadata(pid interface{}, packageName string, options ...RequestOptionFunc) (*NPMPackageMetadata, *Response, error) {
+	project, err := parseProjectID(pid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("projects/%s/packages/npm/%s", PathEscape(project), PathEscape(packageName))
+
+	return s.getPackageMetadata(u, options)
+}
+
+func (s *NPMPackagesService) getPackageMetadata(u string, options []RequestOptionFunc) (*NPMPackageMetadata, *Response, error) {
+	req, err := s.client.NewRequest(http.MethodGet, u, nil, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	m := new(NPMPackageMetadata)
+	resp, err := s.client.Do(req, m)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return m, resp, nil
+}
+
+// PublishNPMPackageOptions represents the available PublishPackage()
+// options. Unlike most options, these are not sent as they are, but used to
+// build the npm package document which is the body of the request, so they
+// are plain values without url or json tags.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/packages/npm.html#upload-a-package-file
+type PublishNPMPackageOptions struct {
+	// Name is the name of the package, including its scope.
+	Name string
+
+	// Version is the version of the package.
+	Version string
+
+	// Tarball is the content of the package tarball, as created by
+	// "npm pack".
+	Tarball []byte
+
+	// Tag is the dist-tag of the published version. Defaults to "latest".
+	Tag string
+
+	// Dependencies are the dependencies of the published version.
+	Dependencies map[string]string
+}
+
+// PublishPackage publishes a version of an npm package to the registry of a
+// project, like "npm publish" does.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/packages/npm.html#upload-a-package-file
+func (s *NPMPackagesService) PublishPackage(pid interface{}, opt *PublishNPMPackageOptions, options ...RequestOptionFunc) (*Response, error) {
+	if opt == nil || opt.Name == "" || opt.Version == "" {
+		return nil, errors.New("name and version are required")
+	}
+	project, err := parseProjectID(pid)
+	if err != nil {
+		return nil, err
+	}
+	u := fmt.Sprintf("projects/%s/packages/npm/%s", PathEscape(project), PathEscape(opt.Name))
+
+	tag := opt.Tag
+	if tag == "" {
+		tag = "latest"
+	}
+
+	sha1sum := sha1.Sum(opt.Tarball)
+	sha512sum := sha512.Sum512(opt.Tarball)
+	filename := fmt.Sprintf("%s-%s.tgz", opt.Name, opt.Version)
+
+	body := map[string]interface{}{
+		"name": opt.Name,
+		"versions": map[string]*NPMPackageVersion{
+			opt.Version: {
+				Name:    opt.Name,
+				Version: opt.Version,
+				Dist: NPMPackageDist{
+					Shasum:    hex.EncodeToString(sha1sum[:]),
+					Integrity: "sha512-" + base64.StdEncoding.EncodeToString(sha512sum[:]),
+					Tarball:   s.client.BaseURL().String() + u + "/-/" + filename,
+				},
+				Dependencies: opt.Dependencies,
+			},
+		},
+		"dist-tags": map[string]string{tag: opt.Version},
+		"_attachments": map[string]interface{}{
+			filename: map[string]interface{}{
+				"content_type": "application/octet-stream",
+				"data":         base64.StdEncoding.EncodeToString(opt.Tarball),
+				"length":       len(opt.Tarball),
+			},
+		},
+	}
+
+	req, err := s.client.NewRequest(http.MethodPut, u, body, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, nil)
+}
@@ -0,0 +1,82 @@
+package gitlab
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNPMPackagesService_GetProjectPackageMetadata(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/packages/npm/@my-group/my-package", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{
+			"name": "@my-group/my-package",
+			"versions": {"1.0.0": {"name": "@my-group/my-package", "version": "1.0.0", "dist": {"shasum": "abc", "tarball": "https://example.com/my-package-1.0.0.tgz"}}},
+			"dist-tags": {"latest": "1.0.0"}
+		}`)
+	})
+
+	m, _, err := client.NPMPackages.GetProjectPackageMetadata(1, "@my-group/my-package")
+	require.NoError(t, err)
+	require.Equal(t, "1.0.0", m.DistTags["latest"])
+	require.Equal(t, "abc", m.Versions["1.0.0"].Dist.Shasum)
+}
+
+func TestNPMPackagesService_GetInstancePackageMetadata(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/packages/npm/@my-group/my-package", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"name": "@my-group/my-package", "versions": {}, "dist-tags": {}}`)
+	})
+
+	m, _, err := client.NPMPackages.GetInstancePackageMetadata("@my-group/my-package")
+	require.NoError(t, err)
+	require.Equal(t, "@my-group/my-package", m.Name)
+}
+
+func TestNPMPackagesService_PublishPackage(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/packages/npm/@my-group/my-package", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+
+		var body struct {
+			Versions    map[string]*NPMPackageVersion `json:"versions"`
+			DistTags    map[string]string             `json:"dist-tags"`
+			Attachments map[string]struct {
+				Data   string `json:"data"`
+				Length int    `json:"length"`
+			} `json:"_attachments"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		require.Equal(t, map[string]string{"next": "1.0.0"}, body.DistTags)
+		require.Equal(t, "a9993e364706816aba3e25717850c26c9cd0d89d", body.Versions["1.0.0"].Dist.Shasum)
+		require.Equal(t, "YWJj", body.Attachments["@my-group/my-package-1.0.0.tgz"].Data)
+
+		w.WriteHeader(http.StatusOK)
+	})
+
+	_, err := client.NPMPackages.PublishPackage(1, &PublishNPMPackageOptions{
+		Name:    "@my-group/my-package",
+		Version: "1.0.0",
+		Tarball: []byte("abc"),
+		Tag:     "next",
+	})
+	require.NoError(t, err)
+}
+
+func TestNPMPackagesService_PublishPackageWithoutOptions(t *testing.T) {
+	_, client := setup(t)
+
+	_, err := client.NPMPackages.PublishPackage(1, nil)
+	require.EqualError(t, err, "name and version are required")
+
+	_, err = client.NPMPackages.PublishPackage(1, &PublishNPMPackageOptions{Name: "@my-group/my-package"})
+	require.EqualError(t, err, "name and version are required")
+}
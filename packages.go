@@ -125,7 +125,7 @@ type ListProjectPackagesOptions struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/packages.html#within-a-project
 func (s *PackagesService) ListProjectPackages(pid interface{}, opt *ListProjectPackagesOptions, options ...RequestOptionFunc) ([]*Package, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -166,7 +166,7 @@ type ListGroupPackagesOptions struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/packages.html#within-a-group
 func (s *PackagesService) ListGroupPackages(gid interface{}, opt *ListGroupPackagesOptions, options ...RequestOptionFunc) ([]*GroupPackage, *Response, error) {
-	group, err := parseID(gid)
+	group, err := parseGroupID(gid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -198,7 +198,7 @@ type ListPackageFilesOptions ListOptions
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/packages.html#list-package-files
 func (s *PackagesService) ListPackageFiles(pid interface{}, pkg int, opt *ListPackageFilesOptions, options ...RequestOptionFunc) ([]*PackageFile, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -227,7 +227,7 @@ func (s *PackagesService) ListPackageFiles(pid interface{}, pkg int, opt *ListPa
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/packages.html#delete-a-project-package
 func (s *PackagesService) DeleteProjectPackage(pid interface{}, pkg int, options ...RequestOptionFunc) (*Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, err
 	}
@@ -246,7 +246,7 @@ func (s *PackagesService) DeleteProjectPackage(pid interface{}, pkg int, options
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/packages.html#delete-a-package-file
 func (s *PackagesService) DeletePackageFile(pid interface{}, pkg, file int, options ...RequestOptionFunc) (*Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, err
 	}
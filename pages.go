@@ -51,7 +51,7 @@ type PagesDeployment struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/pages.html#unpublish-pages
 func (s *PagesService) UnpublishPages(gid interface{}, options ...RequestOptionFunc) (*Response, error) {
-	page, err := parseID(gid)
+	page, err := parseGroupID(gid)
 	if err != nil {
 		return nil, err
 	}
@@ -71,7 +71,7 @@ func (s *PagesService) UnpublishPages(gid interface{}, options ...RequestOptionF
 // GitLab API Docs:
 // https://docs.gitlab.com/ee/api/pages.html#get-pages-settings-for-a-project
 func (s *PagesService) GetPages(gid interface{}, options ...RequestOptionFunc) (*Pages, *Response, error) {
-	project, err := parseID(gid)
+	project, err := parseGroupID(gid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -106,7 +106,7 @@ type UpdatePagesOptions struct {
 // GitLab API Docs:
 // https://docs.gitlab.com/ee/api/pages.html#update-pages-settings-for-a-project
 func (s *PagesService) UpdatePages(pid interface{}, opt UpdatePagesOptions, options ...RequestOptionFunc) (*Pages, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -19,6 +19,7 @@ package gitlab
 import (
 	"fmt"
 	"net/http"
+	"net/url"
 	"time"
 )
 
@@ -61,7 +62,7 @@ type ListPagesDomainsOptions ListOptions
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/pages_domains.html#list-pages-domains
 func (s *PagesDomainsService) ListPagesDomains(pid interface{}, opt *ListPagesDomainsOptions, options ...RequestOptionFunc) ([]*PagesDomain, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -105,11 +106,11 @@ func (s *PagesDomainsService) ListAllPagesDomains(options ...RequestOptionFunc)
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/pages_domains.html#single-pages-domain
 func (s *PagesDomainsService) GetPagesDomain(pid interface{}, domain string, options ...RequestOptionFunc) (*PagesDomain, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("projects/%s/pages/domains/%s", PathEscape(project), domain)
+	u := fmt.Sprintf("projects/%s/pages/domains/%s", PathEscape(project), url.PathEscape(domain))
 
 	req, err := s.client.NewRequest(http.MethodGet, u, nil, options)
 	if err != nil {
@@ -141,7 +142,7 @@ type CreatePagesDomainOptions struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/pages_domains.html#create-new-pages-domain
 func (s *PagesDomainsService) CreatePagesDomain(pid interface{}, opt *CreatePagesDomainOptions, options ...RequestOptionFunc) (*PagesDomain, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -176,11 +177,11 @@ type UpdatePagesDomainOptions struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/pages_domains.html#update-pages-domain
 func (s *PagesDomainsService) UpdatePagesDomain(pid interface{}, domain string, opt *UpdatePagesDomainOptions, options ...RequestOptionFunc) (*PagesDomain, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("projects/%s/pages/domains/%s", PathEscape(project), domain)
+	u := fmt.Sprintf("projects/%s/pages/domains/%s", PathEscape(project), url.PathEscape(domain))
 
 	req, err := s.client.NewRequest(http.MethodPut, u, opt, options)
 	if err != nil {
@@ -201,11 +202,11 @@ func (s *PagesDomainsService) UpdatePagesDomain(pid interface{}, domain string,
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/pages_domains.html#delete-pages-domain
 func (s *PagesDomainsService) DeletePagesDomain(pid interface{}, domain string, options ...RequestOptionFunc) (*Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, err
 	}
-	u := fmt.Sprintf("projects/%s/pages/domains/%s", PathEscape(project), domain)
+	u := fmt.Sprintf("projects/%s/pages/domains/%s", PathEscape(project), url.PathEscape(domain))
 
 	req, err := s.client.NewRequest(http.MethodDelete, u, nil, options)
 	if err != nil {
@@ -0,0 +1,96 @@
+package gitlab
+
+import (
+	"net/url"
+	"reflect"
+	"strconv"
+	"sync"
+	"sync/atomic"
+
+	"github.com/hashicorp/go-retryablehttp"
+)
+
+// maxPerPage is the maximum number of items per page GitLab returns, unless
+// an administrator configured a lower limit.
+const maxPerPage = 100
+
+// PaginationStats contains the pagination statistics of a client, as
+// collected when per page auto-tuning is enabled using WithPerPageAutoTune.
+type PaginationStats struct {
+	// PagesFetched is the number of paginated responses received.
+	PagesFetched int64
+
+	// ItemsPerPageRaised is the number of requests for which the number of
+	// items per page was raised.
+	ItemsPerPageRaised int64
+
+	// ItemsPerPageClamped is the number of responses which contained fewer
+	// items per page than requested, because the server clamped the value.
+	ItemsPerPageClamped int64
+}
+
+// perPageTuner raises the number of items per page of list requests which
+// do not set it, and lowers it for endpoints which clamped it before.
+type perPageTuner struct {
+	// limits contains the clamped number of items per page, by URL path.
+	limits sync.Map
+
+	pages, raised, clamped atomic.Int64
+}
+
+// tune raises the number of items per page of a list call using the given
+// options. Requests of other calls are left alone.
+func (t *perPageTuner) tune(req *retryablehttp.Request, opt interface{}) {
+	if !isListOptionsType(reflect.TypeOf(opt)) {
+		return
+	}
+
+	q := req.URL.Query()
+	if q.Get("per_page") != "" {
+		return
+	}
+
+	perPage := maxPerPage
+	if limit, ok := t.limits.Load(req.URL.Path); ok {
+		perPage = limit.(int)
+	}
+
+	// Append the value instead of re-encoding the query, to keep the query
+	// exactly as it was encoded by the request options.
+	v := url.Values{"per_page": []string{strconv.Itoa(perPage)}}.Encode()
+	if req.URL.RawQuery == "" {
+		req.URL.RawQuery = v
+	} else {
+		req.URL.RawQuery += "&" + v
+	}
+	t.raised.Add(1)
+}
+
+func (t *perPageTuner) observe(req *retryablehttp.Request, resp *Response) {
+	if resp.Header.Get(xPerPage) == "" {
+		return
+	}
+	t.pages.Add(1)
+
+	requested, err := strconv.Atoi(req.URL.Query().Get("per_page"))
+	if err != nil || resp.ItemsPerPage <= 0 || resp.ItemsPerPage >= requested {
+		return
+	}
+	t.limits.Store(req.URL.Path, resp.ItemsPerPage)
+	t.clamped.Add(1)
+}
+
+// PaginationStats returns the pagination statistics of the client. The
+// statistics are only collected when per page auto-tuning is enabled using
+// WithPerPageAutoTune.
+func (c *Client) PaginationStats() PaginationStats {
+	if c.perPageTuner == nil {
+		return PaginationStats{}
+	}
+
+	return PaginationStats{
+		PagesFetched:        c.perPageTuner.pages.Load(),
+		ItemsPerPageRaised:  c.perPageTuner.raised.Load(),
+		ItemsPerPageClamped: c.perPageTuner.clamped.Load(),
+	}
+}
@@ -0,0 +1,71 @@
+package gitlab
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithPerPageAutoTune(t *testing.T) {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	var requested []string
+	mux.HandleFunc("/api/v4/projects/1", func(w http.ResponseWriter, r *http.Request) {
+		require.Empty(t, r.URL.RawQuery)
+		fmt.Fprint(w, `{"id": 1}`)
+	})
+	mux.HandleFunc("/api/v4/projects/1/pipeline_schedules", func(w http.ResponseWriter, r *http.Request) {
+		requested = append(requested, r.URL.Query().Get("per_page"))
+
+		// Clamp the number of items per page like an instance with a lower
+		// limit does.
+		perPage, _ := strconv.Atoi(r.URL.Query().Get("per_page"))
+		if perPage > 50 {
+			perPage = 50
+		}
+		w.Header().Set("X-Per-Page", strconv.Itoa(perPage))
+		w.Header().Set("X-Page", "1")
+		fmt.Fprint(w, `[]`)
+	})
+
+	client, err := NewClient("", WithBaseURL(server.URL), WithPerPageAutoTune())
+	require.NoError(t, err)
+
+	_, _, err = client.PipelineSchedules.ListPipelineSchedules(1, nil)
+	require.NoError(t, err)
+	_, _, err = client.PipelineSchedules.ListPipelineSchedules(1, &ListPipelineSchedulesOptions{Page: 2})
+	require.NoError(t, err)
+	_, _, err = client.PipelineSchedules.ListPipelineSchedules(1, &ListPipelineSchedulesOptions{PerPage: 20})
+	require.NoError(t, err)
+
+	// Requests of calls which do not list resources are left alone.
+	_, _, err = client.Projects.GetProject(1, nil)
+	require.NoError(t, err)
+
+	require.Equal(t, []string{"100", "50", "20"}, requested)
+	require.Equal(t, PaginationStats{
+		PagesFetched:        3,
+		ItemsPerPageRaised:  2,
+		ItemsPerPageClamped: 1,
+	}, client.PaginationStats())
+}
+
+func TestPaginationStatsDisabled(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/pipeline_schedules", func(w http.ResponseWriter, r *http.Request) {
+		require.Empty(t, r.URL.Query().Get("per_page"))
+		w.Header().Set("X-Per-Page", "20")
+		fmt.Fprint(w, `[]`)
+	})
+
+	_, _, err := client.PipelineSchedules.ListPipelineSchedules(1, nil)
+	require.NoError(t, err)
+	require.Equal(t, PaginationStats{}, client.PaginationStats())
+}
@@ -0,0 +1,294 @@
+package gitlab
+
+import (
+	"fmt"
+	"math/bits"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// PipelineScheduleProblem represents a problem found by
+// AuditGroupPipelineSchedules.
+type PipelineScheduleProblem string
+
+// The problems AuditGroupPipelineSchedules reports.
+const (
+	// ScheduleInvalidCron means the cron expression cannot be parsed.
+	ScheduleInvalidCron PipelineScheduleProblem = "invalid_cron"
+
+	// ScheduleNeverRuns means the cron expression only matches dates which do
+	// not exist, like February 30.
+	ScheduleNeverRuns PipelineScheduleProblem = "never_runs"
+
+	// ScheduleTooFrequent means the cron expression matches more often than
+	// GitLab runs pipeline schedules, which is every 10 minutes by default.
+	ScheduleTooFrequent PipelineScheduleProblem = "too_frequent"
+
+	// ScheduleUnknownTimezone means the time zone is not an IANA time zone
+	// name known to the local system.
+	ScheduleUnknownTimezone PipelineScheduleProblem = "unknown_timezone"
+
+	// ScheduleInactive means the schedule is deactivated.
+	ScheduleInactive PipelineScheduleProblem = "inactive"
+
+	// ScheduleOverdue means the schedule is active, but its next run lies in
+	// the past.
+	ScheduleOverdue PipelineScheduleProblem = "overdue"
+
+	// ScheduleNoOwner means the schedule has no owner, so it cannot run.
+	ScheduleNoOwner PipelineScheduleProblem = "no_owner"
+
+	// ScheduleOwnerInactive means the owner of the schedule is blocked or
+	// deactivated, so the pipelines of the schedule fail to be created.
+	ScheduleOwnerInactive PipelineScheduleProblem = "owner_inactive"
+)
+
+// PipelineScheduleReport represents the audit result of a single pipeline
+// schedule.
+type PipelineScheduleReport struct {
+	Project  *Project
+	Schedule *PipelineSchedule
+
+	// Problems is empty when no problems were found.
+	Problems []PipelineScheduleProblem
+}
+
+// AuditGroupPipelineSchedules lists the pipeline schedules of all
+// non-archived projects of a group and its subgroups, and checks them for
+// invalid or suspicious cron expressions and owners which can no longer run
+// them. A report is returned for every schedule.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/pipeline_schedules.html#get-all-pipeline-schedules
+func (s *PipelineSchedulesService) AuditGroupPipelineSchedules(gid interface{}, options ...RequestOptionFunc) ([]*PipelineScheduleReport, error) {
+	var reports []*PipelineScheduleReport
+	now := time.Now()
+
+	projectOpt := &ListGroupProjectsOptions{
+		ListOptions:      ListOptions{PerPage: 100, Page: 1},
+		Archived:         Ptr(false),
+		IncludeSubGroups: Ptr(true),
+		Simple:           Ptr(true),
+	}
+	for {
+		projects, resp, err := s.client.Groups.ListGroupProjects(gid, projectOpt, options...)
+		if err != nil {
+			return reports, err
+		}
+
+		for _, p := range projects {
+			scheduleOpt := &ListPipelineSchedulesOptions{PerPage: 100, Page: 1}
+			for {
+				schedules, resp, err := s.ListPipelineSchedules(p.ID, scheduleOpt, options...)
+				if err != nil {
+					return reports, err
+				}
+
+				for _, ps := range schedules {
+					reports = append(reports, &PipelineScheduleReport{
+						Project:  p,
+						Schedule: ps,
+						Problems: checkPipelineSchedule(ps, now),
+					})
+				}
+
+				if resp.NextPage == 0 {
+					break
+				}
+				scheduleOpt.Page = resp.NextPage
+			}
+		}
+
+		if resp.NextPage == 0 {
+			break
+		}
+		projectOpt.Page = resp.NextPage
+	}
+
+	return reports, nil
+}
+
+func checkPipelineSchedule(ps *PipelineSchedule, now time.Time) []PipelineScheduleProblem {
+	var problems []PipelineScheduleProblem
+
+	c, err := parseCron(ps.Cron)
+	switch {
+	case err != nil:
+		problems = append(problems, ScheduleInvalidCron)
+	case !c.canRun():
+		problems = append(problems, ScheduleNeverRuns)
+	case bits.OnesCount64(c.minutes) > 6:
+		problems = append(problems, ScheduleTooFrequent)
+	}
+
+	if ps.CronTimezone != "" {
+		if _, err := time.LoadLocation(ps.CronTimezone); err != nil {
+			problems = append(problems, ScheduleUnknownTimezone)
+		}
+	}
+
+	if !ps.Active {
+		problems = append(problems, ScheduleInactive)
+	} else if ps.NextRunAt != nil && ps.NextRunAt.Before(now.Add(-time.Hour)) {
+		problems = append(problems, ScheduleOverdue)
+	}
+
+	switch {
+	case ps.Owner == nil:
+		problems = append(problems, ScheduleNoOwner)
+	case ps.Owner.State != "" && ps.Owner.State != "active":
+		problems = append(problems, ScheduleOwnerInactive)
+	}
+
+	return problems
+}
+
+// cronSchedule is a parsed cron expression, with a bit set for every value a
+// field matches.
+type cronSchedule struct {
+	minutes, hours, days, months, weekdays uint64
+
+	// daysRestricted and weekdaysRestricted are set when the field is not
+	// "*", as a day then matches if either of both fields matches.
+	daysRestricted, weekdaysRestricted bool
+}
+
+var cronMacros = map[string]string{
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+	"@monthly":  "0 0 1 * *",
+	"@weekly":   "0 0 * * 0",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@hourly":   "0 * * * *",
+}
+
+var (
+	cronMonthNames   = []string{"jan", "feb", "mar", "apr", "may", "jun", "jul", "aug", "sep", "oct", "nov", "dec"}
+	cronWeekdayNames = []string{"sun", "mon", "tue", "wed", "thu", "fri", "sat"}
+)
+
+// parseCron parses a standard cron expression with five fields. A trailing
+// time zone, which GitLab accepts as well, is ignored.
+func parseCron(expr string) (*cronSchedule, error) {
+	expr = strings.TrimSpace(expr)
+	if m, ok := cronMacros[strings.ToLower(expr)]; ok {
+		expr = m
+	}
+
+	fields := strings.Fields(expr)
+	if len(fields) == 6 {
+		fields = fields[:5]
+	}
+	if len(fields) != 5 {
+		return nil, fmt.Errorf("expected 5 fields, got %d", len(fields))
+	}
+
+	c := new(cronSchedule)
+	var err error
+	if c.minutes, _, err = parseCronField(fields[0], 0, 59, nil); err != nil {
+		return nil, fmt.Errorf("invalid minute field: %w", err)
+	}
+	if c.hours, _, err = parseCronField(fields[1], 0, 23, nil); err != nil {
+		return nil, fmt.Errorf("invalid hour field: %w", err)
+	}
+	if c.days, c.daysRestricted, err = parseCronField(fields[2], 1, 31, nil); err != nil {
+		return nil, fmt.Errorf("invalid day of month field: %w", err)
+	}
+	if c.months, _, err = parseCronField(fields[3], 1, 12, cronMonthNames); err != nil {
+		return nil, fmt.Errorf("invalid month field: %w", err)
+	}
+	if c.weekdays, c.weekdaysRestricted, err = parseCronField(fields[4], 0, 7, cronWeekdayNames); err != nil {
+		return nil, fmt.Errorf("invalid day of week field: %w", err)
+	}
+
+	// Both 0 and 7 are Sunday.
+	if c.weekdays&(1<<7) != 0 {
+		c.weekdays |= 1
+	}
+
+	return c, nil
+}
+
+// canRun reports whether the schedule matches at least one existing date.
+func (c *cronSchedule) canRun() bool {
+	if !c.daysRestricted || c.weekdaysRestricted {
+		return true
+	}
+
+	daysInMonth := []int{31, 29, 31, 30, 31, 30, 31, 31, 30, 31, 30, 31}
+	for m := 1; m <= 12; m++ {
+		if c.months&(1<<m) == 0 {
+			continue
+		}
+		for d := 1; d <= daysInMonth[m-1]; d++ {
+			if c.days&(1<<d) != 0 {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// parseCronField parses a single field of a cron expression. Names are
+// matched case-insensitively and map to min plus their index.
+func parseCronField(field string, min, max int, names []string) (uint64, bool, error) {
+	var set uint64
+	restricted := field != "*"
+
+	for _, item := range strings.Split(field, ",") {
+		rng, stepStr, hasStep := strings.Cut(item, "/")
+
+		step := 1
+		if hasStep {
+			var err error
+			if step, err = strconv.Atoi(stepStr); err != nil || step <= 0 {
+				return 0, false, fmt.Errorf("invalid step %q", stepStr)
+			}
+		}
+
+		lo, hi := min, max
+		if rng != "*" {
+			from, to, isRange := strings.Cut(rng, "-")
+
+			var err error
+			if lo, err = parseCronValue(from, min, max, names); err != nil {
+				return 0, false, err
+			}
+			hi = lo
+			if isRange {
+				if hi, err = parseCronValue(to, min, max, names); err != nil {
+					return 0, false, err
+				}
+			} else if hasStep {
+				hi = max
+			}
+			if lo > hi {
+				return 0, false, fmt.Errorf("invalid range %q", rng)
+			}
+		}
+
+		for v := lo; v <= hi; v += step {
+			set |= 1 << v
+		}
+	}
+
+	return set, restricted, nil
+}
+
+func parseCronValue(s string, min, max int, names []string) (int, error) {
+	for i, name := range names {
+		if strings.EqualFold(s, name) {
+			return min + i, nil
+		}
+	}
+
+	v, err := strconv.Atoi(s)
+	if err != nil || v < min || v > max {
+		return 0, fmt.Errorf("invalid value %q", s)
+	}
+
+	return v, nil
+}
@@ -0,0 +1,73 @@
+package gitlab
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuditGroupPipelineSchedules(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/groups/1/projects", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		require.Equal(t, "true", r.URL.Query().Get("include_subgroups"))
+		require.Equal(t, "false", r.URL.Query().Get("archived"))
+		fmt.Fprint(w, `[{"id": 2, "path_with_namespace": "group/app"}]`)
+	})
+	mux.HandleFunc("/api/v4/projects/2/pipeline_schedules", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprintf(w, `[
+			{"id": 1, "cron": "0 4 * * 1-5", "cron_timezone": "UTC", "active": true, "next_run_at": %q, "owner": {"id": 1, "state": "active"}},
+			{"id": 2, "cron": "0 4 31 2 *", "cron_timezone": "UTC", "active": true, "owner": {"id": 1, "state": "active"}},
+			{"id": 3, "cron": "* * * * *", "cron_timezone": "UTC", "active": false, "owner": {"id": 2, "state": "blocked"}},
+			{"id": 4, "cron": "0 25 * * *", "cron_timezone": "Mars/Olympus", "active": true, "next_run_at": "2020-01-01T04:00:00Z"}
+		]`, time.Now().Add(time.Hour).Format(time.RFC3339))
+	})
+
+	reports, err := client.PipelineSchedules.AuditGroupPipelineSchedules(1)
+	require.NoError(t, err)
+	require.Len(t, reports, 4)
+
+	require.Equal(t, 2, reports[0].Project.ID)
+	require.Empty(t, reports[0].Problems)
+	require.Equal(t, []PipelineScheduleProblem{ScheduleNeverRuns}, reports[1].Problems)
+	require.Equal(t, []PipelineScheduleProblem{ScheduleTooFrequent, ScheduleInactive, ScheduleOwnerInactive}, reports[2].Problems)
+	require.Equal(t, []PipelineScheduleProblem{ScheduleInvalidCron, ScheduleUnknownTimezone, ScheduleOverdue, ScheduleNoOwner}, reports[3].Problems)
+}
+
+func TestParseCron(t *testing.T) {
+	valid := []string{
+		"*/10 * * * *",
+		"0 0 1,15 * *",
+		"30 2 * jan-mar MON-fri",
+		"0 0 * * 7",
+		"@weekly",
+		"0 4 * * * Europe/Berlin",
+	}
+	for _, expr := range valid {
+		_, err := parseCron(expr)
+		require.NoError(t, err, expr)
+	}
+
+	invalid := []string{"", "* * * *", "60 * * * *", "*/0 * * * *", "5-1 * * * *", "0 0 * foo *"}
+	for _, expr := range invalid {
+		_, err := parseCron(expr)
+		require.Error(t, err, expr)
+	}
+
+	c, err := parseCron("*/10 * * * *")
+	require.NoError(t, err)
+	require.Equal(t, uint64(1|1<<10|1<<20|1<<30|1<<40|1<<50), c.minutes)
+
+	c, err = parseCron("0 0 30 2 *")
+	require.NoError(t, err)
+	require.False(t, c.canRun())
+
+	c, err = parseCron("0 0 30 2 1")
+	require.NoError(t, err)
+	require.True(t, c.canRun())
+}
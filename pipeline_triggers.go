@@ -56,7 +56,7 @@ type ListPipelineTriggersOptions ListOptions
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/pipeline_triggers.html#list-project-trigger-tokens
 func (s *PipelineTriggersService) ListPipelineTriggers(pid interface{}, opt *ListPipelineTriggersOptions, options ...RequestOptionFunc) ([]*PipelineTrigger, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -81,7 +81,7 @@ func (s *PipelineTriggersService) ListPipelineTriggers(pid interface{}, opt *Lis
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/pipeline_triggers.html#get-trigger-token-details
 func (s *PipelineTriggersService) GetPipelineTrigger(pid interface{}, trigger int, options ...RequestOptionFunc) (*PipelineTrigger, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -114,7 +114,7 @@ type AddPipelineTriggerOptions struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/pipeline_triggers.html#create-a-trigger-token
 func (s *PipelineTriggersService) AddPipelineTrigger(pid interface{}, opt *AddPipelineTriggerOptions, options ...RequestOptionFunc) (*PipelineTrigger, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -147,7 +147,7 @@ type EditPipelineTriggerOptions struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/pipeline_triggers.html#update-a-project-trigger-token
 func (s *PipelineTriggersService) EditPipelineTrigger(pid interface{}, trigger int, opt *EditPipelineTriggerOptions, options ...RequestOptionFunc) (*PipelineTrigger, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -173,7 +173,7 @@ func (s *PipelineTriggersService) EditPipelineTrigger(pid interface{}, trigger i
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/pipeline_triggers.html#take-ownership-of-a-project-trigger
 func (s *PipelineTriggersService) TakeOwnershipOfPipelineTrigger(pid interface{}, trigger int, options ...RequestOptionFunc) (*PipelineTrigger, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -198,7 +198,7 @@ func (s *PipelineTriggersService) TakeOwnershipOfPipelineTrigger(pid interface{}
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/pipeline_triggers.html#remove-a-project-trigger-token
 func (s *PipelineTriggersService) DeletePipelineTrigger(pid interface{}, trigger int, options ...RequestOptionFunc) (*Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, err
 	}
@@ -217,9 +217,17 @@ func (s *PipelineTriggersService) DeletePipelineTrigger(pid interface{}, trigger
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/pipeline_triggers.html#trigger-a-pipeline-with-a-token
 type RunPipelineTriggerOptions struct {
-	Ref       *string           `url:"ref" json:"ref"`
-	Token     *string           `url:"token" json:"token"`
+	Ref   *string `url:"ref" json:"ref"`
+	Token *string `url:"token" json:"token"`
+
+	// Variables are always passed to jobs as environment variables, as the
+	// trigger API has no variable types. Use PipelinesService.CreatePipeline
+	// with FilePipelineVariable to pass file variables.
 	Variables map[string]string `url:"variables,omitempty" json:"variables,omitempty"`
+
+	// Inputs are the typed inputs declared in the spec:inputs section of
+	// the CI/CD configuration.
+	Inputs PipelineInputs `url:"inputs,omitempty" json:"inputs,omitempty"`
 }
 
 // RunPipelineTrigger starts a trigger from a project.
@@ -227,7 +235,7 @@ type RunPipelineTriggerOptions struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/pipeline_triggers.html#trigger-a-pipeline-with-a-token
 func (s *PipelineTriggersService) RunPipelineTrigger(pid interface{}, opt *RunPipelineTriggerOptions, options ...RequestOptionFunc) (*Pipeline, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -42,3 +42,26 @@ func TestRunPipeline(t *testing.T) {
 		t.Errorf("PipelineTriggers.RunPipelineTrigger returned %+v, want %+v", pipeline, want)
 	}
 }
+
+func TestRunPipelineWithInputs(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/trigger/pipeline", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testBody(t, r, `{"ref":"master","token":"abc","inputs":{"dry_run":false,"stage":"test"}}`)
+		fmt.Fprint(w, `{"id":1, "status":"pending"}`)
+	})
+
+	opt := &RunPipelineTriggerOptions{
+		Ref:   Ptr("master"),
+		Token: Ptr("abc"),
+		Inputs: PipelineInputs{
+			"stage":   StringPipelineInput("test"),
+			"dry_run": BooleanPipelineInput(false),
+		},
+	}
+	_, _, err := client.PipelineTriggers.RunPipelineTrigger(1, opt)
+	if err != nil {
+		t.Errorf("PipelineTriggers.RunPipelineTrigger returned error: %v", err)
+	}
+}
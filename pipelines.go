@@ -17,6 +17,7 @@
 package gitlab
 
 import (
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"time"
@@ -177,7 +178,7 @@ type ListProjectPipelinesOptions struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/pipelines.html#list-project-pipelines
 func (s *PipelinesService) ListProjectPipelines(pid interface{}, opt *ListProjectPipelinesOptions, options ...RequestOptionFunc) ([]*PipelineInfo, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -202,7 +203,7 @@ func (s *PipelinesService) ListProjectPipelines(pid interface{}, opt *ListProjec
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/pipelines.html#get-a-single-pipeline
 func (s *PipelinesService) GetPipeline(pid interface{}, pipeline int, options ...RequestOptionFunc) (*Pipeline, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -227,7 +228,7 @@ func (s *PipelinesService) GetPipeline(pid interface{}, pipeline int, options ..
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/pipelines.html#get-variables-of-a-pipeline
 func (s *PipelinesService) GetPipelineVariables(pid interface{}, pipeline int, options ...RequestOptionFunc) ([]*PipelineVariable, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -252,7 +253,7 @@ func (s *PipelinesService) GetPipelineVariables(pid interface{}, pipeline int, o
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/pipelines.html#get-a-pipelines-test-report
 func (s *PipelinesService) GetPipelineTestReport(pid interface{}, pipeline int, options ...RequestOptionFunc) (*PipelineTestReport, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -285,7 +286,7 @@ type GetLatestPipelineOptions struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/pipelines.html#get-the-latest-pipeline
 func (s *PipelinesService) GetLatestPipeline(pid interface{}, opt *GetLatestPipelineOptions, options ...RequestOptionFunc) (*Pipeline, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -312,6 +313,59 @@ func (s *PipelinesService) GetLatestPipeline(pid interface{}, opt *GetLatestPipe
 type CreatePipelineOptions struct {
 	Ref       *string                     `url:"ref" json:"ref"`
 	Variables *[]*PipelineVariableOptions `url:"variables,omitempty" json:"variables,omitempty"`
+	Inputs    PipelineInputs              `url:"inputs,omitempty" json:"inputs,omitempty"`
+}
+
+// PipelineInputs represents the inputs of a pipeline, by name. The inputs
+// must be declared in the spec:inputs section of the CI/CD configuration.
+//
+// GitLab docs: https://docs.gitlab.com/ee/ci/yaml/inputs.html
+type PipelineInputs map[string]*PipelineInputValue
+
+// PipelineInputValue represents the typed value of a pipeline input. Use
+// StringPipelineInput, NumberPipelineInput, BooleanPipelineInput or
+// ArrayPipelineInput to create one matching the type of the input.
+type PipelineInputValue struct {
+	value interface{}
+}
+
+// StringPipelineInput returns the value of a string input.
+func StringPipelineInput(v string) *PipelineInputValue {
+	return &PipelineInputValue{value: v}
+}
+
+// NumberPipelineInput returns the value of a number input.
+func NumberPipelineInput(v float64) *PipelineInputValue {
+	return &PipelineInputValue{value: v}
+}
+
+// BooleanPipelineInput returns the value of a boolean input.
+func BooleanPipelineInput(v bool) *PipelineInputValue {
+	return &PipelineInputValue{value: v}
+}
+
+// ArrayPipelineInput returns the value of an array input. The elements must
+// be encodable as JSON.
+func ArrayPipelineInput(v ...interface{}) *PipelineInputValue {
+	if v == nil {
+		v = []interface{}{}
+	}
+	return &PipelineInputValue{value: v}
+}
+
+// Value returns the value of the input.
+func (v *PipelineInputValue) Value() interface{} {
+	return v.value
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (v *PipelineInputValue) MarshalJSON() ([]byte, error) {
+	return json.Marshal(v.value)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (v *PipelineInputValue) UnmarshalJSON(data []byte) error {
+	return json.Unmarshal(data, &v.value)
 }
 
 // PipelineVariable represents a pipeline variable.
@@ -323,12 +377,33 @@ type PipelineVariableOptions struct {
 	VariableType *VariableTypeValue `url:"variable_type,omitempty" json:"variable_type,omitempty"`
 }
 
+// EnvPipelineVariable returns the options of a pipeline variable which is
+// exposed to jobs as an environment variable.
+func EnvPipelineVariable(key, value string) *PipelineVariableOptions {
+	return &PipelineVariableOptions{
+		Key:          Ptr(key),
+		Value:        Ptr(value),
+		VariableType: Ptr(EnvVariableType),
+	}
+}
+
+// FilePipelineVariable returns the options of a pipeline variable which is
+// exposed to jobs as a file. The environment variable contains the path of
+// the file, which contains the given value.
+func FilePipelineVariable(key, value string) *PipelineVariableOptions {
+	return &PipelineVariableOptions{
+		Key:          Ptr(key),
+		Value:        Ptr(value),
+		VariableType: Ptr(FileVariableType),
+	}
+}
+
 // CreatePipeline creates a new project pipeline.
 //
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/pipelines.html#create-a-new-pipeline
 func (s *PipelinesService) CreatePipeline(pid interface{}, opt *CreatePipelineOptions, options ...RequestOptionFunc) (*Pipeline, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -353,7 +428,7 @@ func (s *PipelinesService) CreatePipeline(pid interface{}, opt *CreatePipelineOp
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/pipelines.html#retry-jobs-in-a-pipeline
 func (s *PipelinesService) RetryPipelineBuild(pid interface{}, pipeline int, options ...RequestOptionFunc) (*Pipeline, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -378,7 +453,7 @@ func (s *PipelinesService) RetryPipelineBuild(pid interface{}, pipeline int, opt
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/pipelines.html#cancel-a-pipelines-jobs
 func (s *PipelinesService) CancelPipelineBuild(pid interface{}, pipeline int, options ...RequestOptionFunc) (*Pipeline, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -403,7 +478,7 @@ func (s *PipelinesService) CancelPipelineBuild(pid interface{}, pipeline int, op
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/pipelines.html#delete-a-pipeline
 func (s *PipelinesService) DeletePipeline(pid interface{}, pipeline int, options ...RequestOptionFunc) (*Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, err
 	}
@@ -432,7 +507,7 @@ type UpdatePipelineMetadataOptions struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/pipelines.html#update-pipeline-metadata
 func (s *PipelinesService) UpdatePipelineMetadata(pid interface{}, pipeline int, opt *UpdatePipelineMetadataOptions, options ...RequestOptionFunc) (*Pipeline, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -291,3 +291,54 @@ func TestUpdateMetadata(t *testing.T) {
 		t.Errorf("Pipelines.UpdatePipelineMetadata returned %+v, want %+v", pipeline, want)
 	}
 }
+
+func TestCreatePipelineWithInputs(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/pipeline", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testBody(t, r, `{"ref":"master","inputs":{"deploy":true,"environment":"staging","regions":["eu","us"],"replicas":3}}`)
+		fmt.Fprint(w, `{"id":1, "status":"pending"}`)
+	})
+
+	opt := &CreatePipelineOptions{
+		Ref: Ptr("master"),
+		Inputs: PipelineInputs{
+			"environment": StringPipelineInput("staging"),
+			"replicas":    NumberPipelineInput(3),
+			"deploy":      BooleanPipelineInput(true),
+			"regions":     ArrayPipelineInput("eu", "us"),
+		},
+	}
+	pipeline, _, err := client.Pipelines.CreatePipeline(1, opt)
+	if err != nil {
+		t.Errorf("Pipelines.CreatePipeline returned error: %v", err)
+	}
+
+	want := &Pipeline{ID: 1, Status: "pending"}
+	if !reflect.DeepEqual(want, pipeline) {
+		t.Errorf("Pipelines.CreatePipeline returned %+v, want %+v", pipeline, want)
+	}
+}
+
+func TestCreatePipelineWithVariables(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/pipeline", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testBody(t, r, `{"ref":"master","variables":[{"key":"DEPLOY_ENV","value":"staging","variable_type":"env_var"},{"key":"KUBECONFIG","value":"apiVersion: v1","variable_type":"file"}]}`)
+		fmt.Fprint(w, `{"id":1, "status":"pending"}`)
+	})
+
+	opt := &CreatePipelineOptions{
+		Ref: Ptr("master"),
+		Variables: &[]*PipelineVariableOptions{
+			EnvPipelineVariable("DEPLOY_ENV", "staging"),
+			FilePipelineVariable("KUBECONFIG", "apiVersion: v1"),
+		},
+	}
+	_, _, err := client.Pipelines.CreatePipeline(1, opt)
+	if err != nil {
+		t.Errorf("Pipelines.CreatePipeline returned error: %v", err)
+	}
+}
@@ -0,0 +1,130 @@
+package gitlab
+
+import (
+	"context"
+	"io"
+	"reflect"
+	"sync"
+
+	"github.com/hashicorp/go-retryablehttp"
+)
+
+// Progress represents the progress of one or more requests tracked by a
+// ProgressTracker.
+type Progress struct {
+	// PagesFetched is the number of paginated responses received.
+	PagesFetched int64
+
+	// ItemsProcessed is the number of items decoded from list responses.
+	ItemsProcessed int64
+
+	// TotalItems is the total number of items of the listed resource, as
+	// reported by the last paginated response. It is 0 when unknown.
+	TotalItems int64
+
+	// BytesDownloaded is the number of bytes of raw responses, like
+	// artifacts archives and exports, written so far.
+	BytesDownloaded int64
+
+	// TotalBytes is the sum of the sizes of the raw responses received so
+	// far, if GitLab reported them. It is 0 when unknown.
+	TotalBytes int64
+}
+
+// ProgressTracker tracks the progress of the requests it is passed to using
+// WithProgress, and reports every change to a callback. A tracker can be
+// shared by requests running in multiple goroutines.
+type ProgressTracker struct {
+	mu       sync.Mutex
+	progress Progress
+	fn       func(Progress)
+}
+
+// NewProgressTracker returns a tracker which calls fn on every change of the
+// progress. Calls of fn are serialized, so fn does not need to be
+// goroutine-safe itself, but it should return quickly as it blocks the
+// request reporting the change. fn may be nil.
+func NewProgressTracker(fn func(Progress)) *ProgressTracker {
+	return &ProgressTracker{fn: fn}
+}
+
+// Progress returns the current progress.
+func (t *ProgressTracker) Progress() Progress {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.progress
+}
+
+func (t *ProgressTracker) update(fn func(*Progress)) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	fn(&t.progress)
+	if t.fn != nil {
+		t.fn(t.progress)
+	}
+}
+
+type progressContextKey struct{}
+
+// WithProgress reports the progress of a request to the given tracker. Pass
+// the same tracker to all requests of a long operation, like the pages of a
+// listing, to track the progress of the whole operation.
+func WithProgress(t *ProgressTracker) RequestOptionFunc {
+	return func(req *retryablehttp.Request) error {
+		*req = *req.WithContext(context.WithValue(req.Context(), progressContextKey{}, t))
+		return nil
+	}
+}
+
+func progressFromContext(ctx context.Context) *ProgressTracker {
+	t, _ := ctx.Value(progressContextKey{}).(*ProgressTracker)
+	return t
+}
+
+// trackResponse records a received response. It returns the writer raw
+// responses should be written to.
+func (t *ProgressTracker) trackResponse(resp *Response, v interface{}) interface{} {
+	if resp.Header.Get(xPerPage) != "" || resp.NextLink != "" {
+		t.update(func(p *Progress) {
+			p.PagesFetched++
+			if resp.TotalItems > 0 {
+				p.TotalItems = int64(resp.TotalItems)
+			}
+		})
+	}
+
+	if w, ok := v.(io.Writer); ok {
+		if resp.ContentLength > 0 {
+			t.update(func(p *Progress) { p.TotalBytes += resp.ContentLength })
+		}
+		return &progressWriter{w: w, t: t}
+	}
+
+	return v
+}
+
+// trackItems records the number of items decoded into v, if v points to a
+// slice.
+func (t *ProgressTracker) trackItems(v interface{}) {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Ptr || rv.Elem().Kind() != reflect.Slice {
+		return
+	}
+	if n := rv.Elem().Len(); n > 0 {
+		t.update(func(p *Progress) { p.ItemsProcessed += int64(n) })
+	}
+}
+
+type progressWriter struct {
+	w io.Writer
+	t *ProgressTracker
+}
+
+func (w *progressWriter) Write(b []byte) (int, error) {
+	n, err := w.w.Write(b)
+	if n > 0 {
+		w.t.update(func(p *Progress) { p.BytesDownloaded += int64(n) })
+	}
+	return n, err
+}
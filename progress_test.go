@@ -0,0 +1,72 @@
+package gitlab
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithProgressPagination(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/pipeline_schedules", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Per-Page", "2")
+		w.Header().Set("X-Total", "3")
+		if r.URL.Query().Get("page") == "2" {
+			fmt.Fprint(w, `[{"id": 3}]`)
+			return
+		}
+		w.Header().Set("X-Next-Page", "2")
+		fmt.Fprint(w, `[{"id": 1}, {"id": 2}]`)
+	})
+
+	var updates []Progress
+	tracker := NewProgressTracker(func(p Progress) {
+		updates = append(updates, p)
+	})
+
+	opt := &ListPipelineSchedulesOptions{PerPage: 2}
+	for {
+		_, resp, err := client.PipelineSchedules.ListPipelineSchedules(1, opt, WithProgress(tracker))
+		require.NoError(t, err)
+		if resp.NextPage == 0 {
+			break
+		}
+		opt.Page = resp.NextPage
+	}
+
+	require.Equal(t, Progress{PagesFetched: 2, ItemsProcessed: 3, TotalItems: 3}, tracker.Progress())
+	require.Len(t, updates, 4)
+	require.Equal(t, Progress{PagesFetched: 1, TotalItems: 3}, updates[0])
+}
+
+func TestWithProgressDownload(t *testing.T) {
+	mux, client := setup(t)
+
+	content := strings.Repeat("a", 64*1024)
+	mux.HandleFunc("/api/v4/projects/1/jobs/2/artifacts", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Length", fmt.Sprint(len(content)))
+		fmt.Fprint(w, content)
+	})
+
+	tracker := NewProgressTracker(nil)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 3; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, _, err := client.Jobs.GetJobArtifacts(1, 2, WithProgress(tracker))
+			require.NoError(t, err)
+		}()
+	}
+	wg.Wait()
+
+	p := tracker.Progress()
+	require.Equal(t, int64(3*len(content)), p.BytesDownloaded)
+	require.Equal(t, int64(3*len(content)), p.TotalBytes)
+}
@@ -64,7 +64,7 @@ type ListProjectAccessTokensOptions ListOptions
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/project_access_tokens.html#list-project-access-tokens
 func (s *ProjectAccessTokensService) ListProjectAccessTokens(pid interface{}, opt *ListProjectAccessTokensOptions, options ...RequestOptionFunc) ([]*ProjectAccessToken, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -89,7 +89,7 @@ func (s *ProjectAccessTokensService) ListProjectAccessTokens(pid interface{}, op
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/project_access_tokens.html#get-a-project-access-token
 func (s *ProjectAccessTokensService) GetProjectAccessToken(pid interface{}, id int, options ...RequestOptionFunc) (*ProjectAccessToken, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -126,7 +126,7 @@ type CreateProjectAccessTokenOptions struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/project_access_tokens.html#create-a-project-access-token
 func (s *ProjectAccessTokensService) CreateProjectAccessToken(pid interface{}, opt *CreateProjectAccessTokenOptions, options ...RequestOptionFunc) (*ProjectAccessToken, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -161,7 +161,7 @@ type RotateProjectAccessTokenOptions struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/project_access_tokens.html#rotate-a-project-access-token
 func (s *ProjectAccessTokensService) RotateProjectAccessToken(pid interface{}, id int, opt *RotateProjectAccessTokenOptions, options ...RequestOptionFunc) (*ProjectAccessToken, *Response, error) {
-	projects, err := parseID(pid)
+	projects, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -185,7 +185,7 @@ func (s *ProjectAccessTokensService) RotateProjectAccessToken(pid interface{}, i
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/project_access_tokens.html#revoke-a-project-access-token
 func (s *ProjectAccessTokensService) RevokeProjectAccessToken(pid interface{}, id int, options ...RequestOptionFunc) (*Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, err
 	}
@@ -59,7 +59,7 @@ type ListProjectBadgesOptions struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/project_badges.html#list-all-badges-of-a-project
 func (s *ProjectBadgesService) ListProjectBadges(pid interface{}, opt *ListProjectBadgesOptions, options ...RequestOptionFunc) ([]*ProjectBadge, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -84,7 +84,7 @@ func (s *ProjectBadgesService) ListProjectBadges(pid interface{}, opt *ListProje
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/project_badges.html#get-a-badge-of-a-project
 func (s *ProjectBadgesService) GetProjectBadge(pid interface{}, badge int, options ...RequestOptionFunc) (*ProjectBadge, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -119,7 +119,7 @@ type AddProjectBadgeOptions struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/project_badges.html#add-a-badge-to-a-project
 func (s *ProjectBadgesService) AddProjectBadge(pid interface{}, opt *AddProjectBadgeOptions, options ...RequestOptionFunc) (*ProjectBadge, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -154,7 +154,7 @@ type EditProjectBadgeOptions struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/project_badges.html#edit-a-badge-of-a-project
 func (s *ProjectBadgesService) EditProjectBadge(pid interface{}, badge int, opt *EditProjectBadgeOptions, options ...RequestOptionFunc) (*ProjectBadge, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -180,7 +180,7 @@ func (s *ProjectBadgesService) EditProjectBadge(pid interface{}, badge int, opt
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/project_badges.html#remove-a-badge-from-a-project
 func (s *ProjectBadgesService) DeleteProjectBadge(pid interface{}, badge int, options ...RequestOptionFunc) (*Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, err
 	}
@@ -209,7 +209,7 @@ type ProjectBadgePreviewOptions struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/project_badges.html#preview-a-badge-from-a-project
 func (s *ProjectBadgesService) PreviewProjectBadge(pid interface{}, opt *ProjectBadgePreviewOptions, options ...RequestOptionFunc) (*ProjectBadge, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
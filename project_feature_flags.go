@@ -3,6 +3,7 @@ package gitlab
 import (
 	"fmt"
 	"net/http"
+	"net/url"
 	"time"
 )
 
@@ -79,7 +80,7 @@ type ListProjectFeatureFlagOptions struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/feature_flags.html#list-feature-flags-for-a-project
 func (s *ProjectFeatureFlagService) ListProjectFeatureFlags(pid interface{}, opt *ListProjectFeatureFlagOptions, options ...RequestOptionFunc) ([]*ProjectFeatureFlag, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -104,11 +105,11 @@ func (s *ProjectFeatureFlagService) ListProjectFeatureFlags(pid interface{}, opt
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/feature_flags.html#get-a-single-feature-flag
 func (s *ProjectFeatureFlagService) GetProjectFeatureFlag(pid interface{}, name string, options ...RequestOptionFunc) (*ProjectFeatureFlag, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("projects/%s/feature_flags/%s", PathEscape(project), name)
+	u := fmt.Sprintf("projects/%s/feature_flags/%s", PathEscape(project), url.PathEscape(name))
 
 	req, err := s.client.NewRequest(http.MethodGet, u, nil, options)
 	if err != nil {
@@ -164,7 +165,7 @@ type ProjectFeatureFlagScopeOptions struct {
 // Gitlab API docs:
 // https://docs.gitlab.com/ee/api/feature_flags.html#create-a-feature-flag
 func (s *ProjectFeatureFlagService) CreateProjectFeatureFlag(pid interface{}, opt *CreateProjectFeatureFlagOptions, options ...RequestOptionFunc) (*ProjectFeatureFlag, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -203,7 +204,7 @@ type UpdateProjectFeatureFlagOptions struct {
 // Gitlab API docs:
 // https://docs.gitlab.com/ee/api/feature_flags.html#update-a-feature-flag
 func (s *ProjectFeatureFlagService) UpdateProjectFeatureFlag(pid interface{}, name string, opt *UpdateProjectFeatureFlagOptions, options ...RequestOptionFunc) (*ProjectFeatureFlag, *Response, error) {
-	group, err := parseID(pid)
+	group, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -231,11 +232,11 @@ func (s *ProjectFeatureFlagService) UpdateProjectFeatureFlag(pid interface{}, na
 // Gitlab API docs:
 // https://docs.gitlab.com/ee/api/feature_flags.html#delete-a-feature-flag
 func (s *ProjectFeatureFlagService) DeleteProjectFeatureFlag(pid interface{}, name string, options ...RequestOptionFunc) (*Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, err
 	}
-	u := fmt.Sprintf("projects/%s/feature_flags/%s", PathEscape(project), name)
+	u := fmt.Sprintf("projects/%s/feature_flags/%s", PathEscape(project), url.PathEscape(name))
 
 	req, err := s.client.NewRequest(http.MethodDelete, u, nil, options)
 	if err != nil {
@@ -96,7 +96,7 @@ type ScheduleExportOptions struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/project_import_export.html#schedule-an-export
 func (s *ProjectImportExportService) ScheduleExport(pid interface{}, opt *ScheduleExportOptions, options ...RequestOptionFunc) (*Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, err
 	}
@@ -115,7 +115,7 @@ func (s *ProjectImportExportService) ScheduleExport(pid interface{}, opt *Schedu
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/project_import_export.html#export-status
 func (s *ProjectImportExportService) ExportStatus(pid interface{}, options ...RequestOptionFunc) (*ExportStatus, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -140,7 +140,7 @@ func (s *ProjectImportExportService) ExportStatus(pid interface{}, options ...Re
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/project_import_export.html#export-download
 func (s *ProjectImportExportService) ExportDownload(pid interface{}, options ...RequestOptionFunc) ([]byte, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -160,6 +160,26 @@ func (s *ProjectImportExportService) ExportDownload(pid interface{}, options ...
 	return b.Bytes(), resp, err
 }
 
+// ExportDownloadTo streams the finished export into the given writer, which
+// avoids keeping large exports in memory.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/project_import_export.html#export-download
+func (s *ProjectImportExportService) ExportDownloadTo(pid interface{}, w io.Writer, options ...RequestOptionFunc) (*Response, error) {
+	project, err := parseProjectID(pid)
+	if err != nil {
+		return nil, err
+	}
+	u := fmt.Sprintf("projects/%s/export/download", PathEscape(project))
+
+	req, err := s.client.NewRequest(http.MethodGet, u, nil, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, w)
+}
+
 // ImportFileOptions represents the available ImportFile() options.
 //
 // GitLab API docs:
@@ -204,7 +224,7 @@ func (s *ProjectImportExportService) ImportFromFile(archive io.Reader, opt *Impo
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/project_import_export.html#import-status
 func (s *ProjectImportExportService) ImportStatus(pid interface{}, options ...RequestOptionFunc) (*ImportStatus, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
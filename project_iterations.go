@@ -69,7 +69,7 @@ type ListProjectIterationsOptions struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/iterations.html#list-project-iterations
 func (i *ProjectIterationsService) ListProjectIterations(pid interface{}, opt *ListProjectIterationsOptions, options ...RequestOptionFunc) ([]*ProjectIteration, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -43,7 +43,7 @@ type ManagedLicense struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/managed_licenses.html#list-managed-licenses
 func (s *ManagedLicensesService) ListManagedLicenses(pid interface{}, options ...RequestOptionFunc) ([]*ManagedLicense, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -68,7 +68,7 @@ func (s *ManagedLicensesService) ListManagedLicenses(pid interface{}, options ..
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/managed_licenses.html#show-an-existing-managed-license
 func (s *ManagedLicensesService) GetManagedLicense(pid, mlid interface{}, options ...RequestOptionFunc) (*ManagedLicense, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -106,7 +106,7 @@ type AddManagedLicenseOptions struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/managed_licenses.html#create-a-new-managed-license
 func (s *ManagedLicensesService) AddManagedLicense(pid interface{}, opt *AddManagedLicenseOptions, options ...RequestOptionFunc) (*ManagedLicense, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -131,7 +131,7 @@ func (s *ManagedLicensesService) AddManagedLicense(pid interface{}, opt *AddMana
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/managed_licenses.html#delete-a-managed-license
 func (s *ManagedLicensesService) DeleteManagedLicense(pid, mlid interface{}, options ...RequestOptionFunc) (*Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, err
 	}
@@ -163,7 +163,7 @@ type EditManagedLicenceOptions struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/managed_licenses.html#edit-an-existing-managed-license
 func (s *ManagedLicensesService) EditManagedLicense(pid, mlid interface{}, opt *EditManagedLicenceOptions, options ...RequestOptionFunc) (*ManagedLicense, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -55,7 +55,7 @@ type ListProjectMirrorOptions ListOptions
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/remote_mirrors.html#list-a-projects-remote-mirrors
 func (s *ProjectMirrorService) ListProjectMirror(pid interface{}, opt *ListProjectMirrorOptions, options ...RequestOptionFunc) ([]*ProjectMirror, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -80,7 +80,7 @@ func (s *ProjectMirrorService) ListProjectMirror(pid interface{}, opt *ListProje
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/remote_mirrors.html#get-a-single-projects-remote-mirror
 func (s *ProjectMirrorService) GetProjectMirror(pid interface{}, mirror int, options ...RequestOptionFunc) (*ProjectMirror, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -118,7 +118,7 @@ type AddProjectMirrorOptions struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/remote_mirrors.html#create-a-push-mirror
 func (s *ProjectMirrorService) AddProjectMirror(pid interface{}, opt *AddProjectMirrorOptions, options ...RequestOptionFunc) (*ProjectMirror, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -155,7 +155,7 @@ type EditProjectMirrorOptions struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/remote_mirrors.html#update-a-remote-mirrors-attributes
 func (s *ProjectMirrorService) EditProjectMirror(pid interface{}, mirror int, opt *EditProjectMirrorOptions, options ...RequestOptionFunc) (*ProjectMirror, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -180,7 +180,7 @@ func (s *ProjectMirrorService) EditProjectMirror(pid interface{}, mirror int, op
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/remote_mirrors.html#delete-a-remote-mirror
 func (s *ProjectMirrorService) DeleteProjectMirror(pid interface{}, mirror int, options ...RequestOptionFunc) (*Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, err
 	}
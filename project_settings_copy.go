@@ -0,0 +1,470 @@
+package gitlab
+
+import (
+	"fmt"
+)
+
+// ProjectSettingsKind represents a kind of project settings copied by
+// CopyProjectSettings.
+type ProjectSettingsKind string
+
+// The kinds of project settings CopyProjectSettings can copy.
+const (
+	// GeneralProjectSettings are the description, topics, visibility,
+	// feature access levels, and the merge request and CI/CD settings.
+	GeneralProjectSettings ProjectSettingsKind = "general"
+
+	// ProtectedBranchSettings are the protected branches. Only access
+	// levels are copied, as users, groups and deploy keys differ between
+	// instances.
+	ProtectedBranchSettings ProjectSettingsKind = "protected_branches"
+
+	// VariableSettings are the CI/CD variables which are not masked.
+	VariableSettings ProjectSettingsKind = "variables"
+
+	// HookSettings are the webhooks, without their secret tokens and
+	// custom headers.
+	HookSettings ProjectSettingsKind = "hooks"
+
+	// BadgeSettings are the badges of the project, not of its groups.
+	BadgeSettings ProjectSettingsKind = "badges"
+
+	// LabelSettings are the labels of the project, not of its groups.
+	LabelSettings ProjectSettingsKind = "labels"
+)
+
+// CopyProjectSettingsOptions represents the available CopyProjectSettings()
+// options.
+type CopyProjectSettingsOptions struct {
+	// Kinds are the kinds of settings to copy. Defaults to all kinds.
+	Kinds []ProjectSettingsKind
+}
+
+// CopyProjectSettings reads the settings of a project using the source
+// client, which can be connected to another GitLab instance, and applies
+// them to a project of this client. Secrets are never copied: masked
+// variables are skipped, and hooks are created without their tokens.
+// Existing protected branches, variables and labels of the project are
+// updated, and hooks and badges which already exist are left alone.
+//
+// The result lists the copied items, like "label bug", and the items which
+// failed to copy. An error is only returned if the settings of the source
+// project cannot be read. The request options are used for both clients.
+func (s *ProjectsService) CopyProjectSettings(pid interface{}, source *Client, sourcePID interface{}, opt *CopyProjectSettingsOptions, options ...RequestOptionFunc) (*BulkResult[string], error) {
+	kinds := []ProjectSettingsKind{
+		GeneralProjectSettings,
+		ProtectedBranchSettings,
+		VariableSettings,
+		HookSettings,
+		BadgeSettings,
+		LabelSettings,
+	}
+	if opt != nil && len(opt.Kinds) > 0 {
+		kinds = opt.Kinds
+	}
+
+	c := &settingsCopier{
+		source:    source,
+		sourcePID: sourcePID,
+		target:    s.client,
+		pid:       pid,
+		options:   options,
+		result:    new(BulkResult[string]),
+	}
+
+	for _, kind := range kinds {
+		var err error
+		switch kind {
+		case GeneralProjectSettings:
+			err = c.copyGeneral()
+		case ProtectedBranchSettings:
+			err = c.copyProtectedBranches()
+		case VariableSettings:
+			err = c.copyVariables()
+		case HookSettings:
+			err = c.copyHooks()
+		case BadgeSettings:
+			err = c.copyBadges()
+		case LabelSettings:
+			err = c.copyLabels()
+		default:
+			err = fmt.Errorf("unknown project settings kind %q", kind)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return c.result, nil
+}
+
+// settingsCopier copies the settings of a project to a project of another
+// client, recording the outcome per item.
+type settingsCopier struct {
+	source    *Client
+	sourcePID interface{}
+	target    *Client
+	pid       interface{}
+	options   []RequestOptionFunc
+	result    *BulkResult[string]
+}
+
+func (c *settingsCopier) record(item string, err error) {
+	if err != nil {
+		c.result.Failed = append(c.result.Failed, &BulkItemError[string]{Item: item, Err: err})
+		return
+	}
+	c.result.Succeeded = append(c.result.Succeeded, item)
+}
+
+// listAllPages calls list for all pages of a listing, and returns all items.
+func listAllPages[T any](list func(ListOptions) ([]T, *Response, error)) ([]T, error) {
+	opt := ListOptions{PerPage: 100}
+
+	var all []T
+	for {
+		items, resp, err := list(opt)
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, items...)
+
+		if resp.NextPage == 0 {
+			break
+		}
+		opt.Page = resp.NextPage
+	}
+
+	return all, nil
+}
+
+// nonZero returns a pointer to v, or nil if v is the zero value. It is used
+// for settings which are omitted by GitLab versions not supporting them.
+func nonZero[T comparable](v T) *T {
+	var zero T
+	if v == zero {
+		return nil
+	}
+	return &v
+}
+
+func (c *settingsCopier) copyGeneral() error {
+	p, _, err := c.source.Projects.GetProject(c.sourcePID, nil, c.options...)
+	if err != nil {
+		return err
+	}
+
+	opt := &EditProjectOptions{
+		Description:              Ptr(p.Description),
+		Topics:                   Ptr(p.Topics),
+		Visibility:               nonZero(p.Visibility),
+		IssuesAccessLevel:        nonZero(p.IssuesAccessLevel),
+		RepositoryAccessLevel:    nonZero(p.RepositoryAccessLevel),
+		MergeRequestsAccessLevel: nonZero(p.MergeRequestsAccessLevel),
+		BuildsAccessLevel:        nonZero(p.BuildsAccessLevel),
+		WikiAccessLevel:          nonZero(p.WikiAccessLevel),
+		SnippetsAccessLevel:      nonZero(p.SnippetsAccessLevel),
+		MergeMethod:              nonZero(p.MergeMethod),
+		SquashOption:             nonZero(p.SquashOption),
+
+		OnlyAllowMergeIfPipelineSucceeds:          Ptr(p.OnlyAllowMergeIfPipelineSucceeds),
+		OnlyAllowMergeIfAllDiscussionsAreResolved: Ptr(p.OnlyAllowMergeIfAllDiscussionsAreResolved),
+		AllowMergeOnSkippedPipeline:               Ptr(p.AllowMergeOnSkippedPipeline),
+		RemoveSourceBranchAfterMerge:              Ptr(p.RemoveSourceBranchAfterMerge),
+		ResolveOutdatedDiffDiscussions:            Ptr(p.ResolveOutdatedDiffDiscussions),
+		PrintingMergeRequestLinkEnabled:           Ptr(p.PrintingMergeRequestLinkEnabled),
+		AutocloseReferencedIssues:                 Ptr(p.AutocloseReferencedIssues),
+		MergeRequestsTemplate:                     Ptr(p.MergeRequestsTemplate),
+		IssuesTemplate:                            Ptr(p.IssuesTemplate),
+		LFSEnabled:                                Ptr(p.LFSEnabled),
+		RequestAccessEnabled:                      Ptr(p.RequestAccessEnabled),
+		SharedRunnersEnabled:                      Ptr(p.SharedRunnersEnabled),
+		CIConfigPath:                              Ptr(p.CIConfigPath),
+		BuildTimeout:                              nonZero(p.BuildTimeout),
+	}
+
+	_, _, err = c.target.Projects.EditProject(c.pid, opt, c.options...)
+	c.record("general settings", err)
+
+	return nil
+}
+
+// branchPermissions returns the access level permissions of the given
+// access descriptions, skipping user, group and deploy key permissions.
+func branchPermissions(levels []*BranchAccessDescription) *[]*BranchPermissionOptions {
+	var perms []*BranchPermissionOptions
+	for _, l := range levels {
+		if l.UserID != 0 || l.GroupID != 0 || l.DeployKeyID != 0 {
+			continue
+		}
+		perms = append(perms, &BranchPermissionOptions{AccessLevel: Ptr(l.AccessLevel)})
+	}
+	return &perms
+}
+
+// branchPermissionChanges returns the changes which turn the access level
+// permissions of an existing protection into the given ones. Permissions
+// which are not yet granted are added, and access levels which are no longer
+// granted are removed. User, group and deploy key permissions are kept. It
+// returns nil if the permissions are already the same.
+func branchPermissionChanges(existing, levels []*BranchAccessDescription) *[]*BranchPermissionOptions {
+	wanted := make(map[AccessLevelValue]bool)
+	for _, p := range *branchPermissions(levels) {
+		wanted[*p.AccessLevel] = true
+	}
+
+	var perms []*BranchPermissionOptions
+	for _, l := range existing {
+		if l.UserID != 0 || l.GroupID != 0 || l.DeployKeyID != 0 {
+			continue
+		}
+		if wanted[l.AccessLevel] {
+			delete(wanted, l.AccessLevel)
+			continue
+		}
+		perms = append(perms, &BranchPermissionOptions{ID: Ptr(l.ID), Destroy: Ptr(true)})
+	}
+	for _, p := range *branchPermissions(levels) {
+		if wanted[*p.AccessLevel] {
+			perms = append(perms, p)
+		}
+	}
+	if len(perms) == 0 {
+		return nil
+	}
+	return &perms
+}
+
+func (c *settingsCopier) copyProtectedBranches() error {
+	branches, err := listAllPages(func(lo ListOptions) ([]*ProtectedBranch, *Response, error) {
+		return c.source.ProtectedBranches.ListProtectedBranches(c.sourcePID, &ListProtectedBranchesOptions{ListOptions: lo}, c.options...)
+	})
+	if err != nil {
+		return err
+	}
+
+	targetBranches, err := listAllPages(func(lo ListOptions) ([]*ProtectedBranch, *Response, error) {
+		return c.target.ProtectedBranches.ListProtectedBranches(c.pid, &ListProtectedBranchesOptions{ListOptions: lo}, c.options...)
+	})
+	if err != nil {
+		return err
+	}
+	existing := make(map[string]*ProtectedBranch)
+	for _, b := range targetBranches {
+		existing[b.Name] = b
+	}
+
+	for _, b := range branches {
+		item := "protected branch " + b.Name
+
+		// Existing protections are updated in place, so a failure never
+		// leaves the branch unprotected.
+		if e, ok := existing[b.Name]; ok {
+			_, _, err = c.target.ProtectedBranches.UpdateProtectedBranch(c.pid, b.Name, &UpdateProtectedBranchOptions{
+				AllowForcePush:            Ptr(b.AllowForcePush),
+				CodeOwnerApprovalRequired: Ptr(b.CodeOwnerApprovalRequired),
+				AllowedToPush:             branchPermissionChanges(e.PushAccessLevels, b.PushAccessLevels),
+				AllowedToMerge:            branchPermissionChanges(e.MergeAccessLevels, b.MergeAccessLevels),
+				AllowedToUnprotect:        branchPermissionChanges(e.UnprotectAccessLevels, b.UnprotectAccessLevels),
+			}, c.options...)
+		} else {
+			_, _, err = c.target.ProtectedBranches.ProtectRepositoryBranches(c.pid, &ProtectRepositoryBranchesOptions{
+				Name:                      Ptr(b.Name),
+				AllowForcePush:            Ptr(b.AllowForcePush),
+				CodeOwnerApprovalRequired: Ptr(b.CodeOwnerApprovalRequired),
+				AllowedToPush:             branchPermissions(b.PushAccessLevels),
+				AllowedToMerge:            branchPermissions(b.MergeAccessLevels),
+				AllowedToUnprotect:        branchPermissions(b.UnprotectAccessLevels),
+			}, c.options...)
+		}
+		c.record(item, err)
+	}
+
+	return nil
+}
+
+func (c *settingsCopier) copyVariables() error {
+	variables, err := listAllPages(func(lo ListOptions) ([]*ProjectVariable, *Response, error) {
+		return c.source.ProjectVariables.ListVariables(c.sourcePID, (*ListProjectVariablesOptions)(&lo), c.options...)
+	})
+	if err != nil {
+		return err
+	}
+
+	existing, err := listAllPages(func(lo ListOptions) ([]*ProjectVariable, *Response, error) {
+		return c.target.ProjectVariables.ListVariables(c.pid, (*ListProjectVariablesOptions)(&lo), c.options...)
+	})
+	if err != nil {
+		return err
+	}
+	exists := make(map[[2]string]bool)
+	for _, v := range existing {
+		exists[[2]string{v.Key, v.EnvironmentScope}] = true
+	}
+
+	for _, v := range variables {
+		if v.Masked || v.Hidden {
+			continue
+		}
+		item := "variable " + v.Key
+		if v.EnvironmentScope != "" && v.EnvironmentScope != "*" {
+			item += " (" + v.EnvironmentScope + ")"
+		}
+
+		if exists[[2]string{v.Key, v.EnvironmentScope}] {
+			_, _, err = c.target.ProjectVariables.UpdateVariable(c.pid, v.Key, &UpdateProjectVariableOptions{
+				Value:        Ptr(v.Value),
+				Description:  Ptr(v.Description),
+				Filter:       &VariableFilter{EnvironmentScope: v.EnvironmentScope},
+				Protected:    Ptr(v.Protected),
+				Raw:          Ptr(v.Raw),
+				VariableType: Ptr(v.VariableType),
+			}, c.options...)
+		} else {
+			_, _, err = c.target.ProjectVariables.CreateVariable(c.pid, &CreateProjectVariableOptions{
+				Key:              Ptr(v.Key),
+				Value:            Ptr(v.Value),
+				Description:      Ptr(v.Description),
+				EnvironmentScope: Ptr(v.EnvironmentScope),
+				Protected:        Ptr(v.Protected),
+				Raw:              Ptr(v.Raw),
+				VariableType:     Ptr(v.VariableType),
+			}, c.options...)
+		}
+		c.record(item, err)
+	}
+
+	return nil
+}
+
+func (c *settingsCopier) copyHooks() error {
+	hooks, err := listAllPages(func(lo ListOptions) ([]*ProjectHook, *Response, error) {
+		return c.source.Projects.ListProjectHooks(c.sourcePID, (*ListProjectHooksOptions)(&lo), c.options...)
+	})
+	if err != nil {
+		return err
+	}
+
+	existing, err := listAllPages(func(lo ListOptions) ([]*ProjectHook, *Response, error) {
+		return c.target.Projects.ListProjectHooks(c.pid, (*ListProjectHooksOptions)(&lo), c.options...)
+	})
+	if err != nil {
+		return err
+	}
+	exists := make(map[string]bool)
+	for _, h := range existing {
+		exists[h.URL] = true
+	}
+
+	for _, h := range hooks {
+		if exists[h.URL] {
+			continue
+		}
+		_, _, err := c.target.Projects.AddProjectHook(c.pid, &AddProjectHookOptions{
+			URL:                       Ptr(h.URL),
+			Name:                      Ptr(h.Name),
+			Description:               Ptr(h.Description),
+			ConfidentialIssuesEvents:  Ptr(h.ConfidentialIssuesEvents),
+			ConfidentialNoteEvents:    Ptr(h.ConfidentialNoteEvents),
+			DeploymentEvents:          Ptr(h.DeploymentEvents),
+			EnableSSLVerification:     Ptr(h.EnableSSLVerification),
+			IssuesEvents:              Ptr(h.IssuesEvents),
+			JobEvents:                 Ptr(h.JobEvents),
+			MergeRequestsEvents:       Ptr(h.MergeRequestsEvents),
+			NoteEvents:                Ptr(h.NoteEvents),
+			PipelineEvents:            Ptr(h.PipelineEvents),
+			PushEvents:                Ptr(h.PushEvents),
+			PushEventsBranchFilter:    Ptr(h.PushEventsBranchFilter),
+			ReleasesEvents:            Ptr(h.ReleasesEvents),
+			TagPushEvents:             Ptr(h.TagPushEvents),
+			WikiPageEvents:            Ptr(h.WikiPageEvents),
+			ResourceAccessTokenEvents: Ptr(h.ResourceAccessTokenEvents),
+			CustomWebhookTemplate:     Ptr(h.CustomWebhookTemplate),
+		}, c.options...)
+		c.record("hook "+h.URL, err)
+	}
+
+	return nil
+}
+
+func (c *settingsCopier) copyBadges() error {
+	badges, err := listAllPages(func(lo ListOptions) ([]*ProjectBadge, *Response, error) {
+		return c.source.ProjectBadges.ListProjectBadges(c.sourcePID, &ListProjectBadgesOptions{ListOptions: lo}, c.options...)
+	})
+	if err != nil {
+		return err
+	}
+
+	existing, err := listAllPages(func(lo ListOptions) ([]*ProjectBadge, *Response, error) {
+		return c.target.ProjectBadges.ListProjectBadges(c.pid, &ListProjectBadgesOptions{ListOptions: lo}, c.options...)
+	})
+	if err != nil {
+		return err
+	}
+	exists := make(map[[2]string]bool)
+	for _, b := range existing {
+		exists[[2]string{b.LinkURL, b.ImageURL}] = true
+	}
+
+	for _, b := range badges {
+		if b.Kind != "project" || exists[[2]string{b.LinkURL, b.ImageURL}] {
+			continue
+		}
+		_, _, err := c.target.ProjectBadges.AddProjectBadge(c.pid, &AddProjectBadgeOptions{
+			Name:     Ptr(b.Name),
+			LinkURL:  Ptr(b.LinkURL),
+			ImageURL: Ptr(b.ImageURL),
+		}, c.options...)
+		c.record("badge "+b.Name, err)
+	}
+
+	return nil
+}
+
+func (c *settingsCopier) copyLabels() error {
+	labels, err := listAllPages(func(lo ListOptions) ([]*Label, *Response, error) {
+		return c.source.Labels.ListLabels(c.sourcePID, &ListLabelsOptions{ListOptions: lo, IncludeAncestorGroups: Ptr(false)}, c.options...)
+	})
+	if err != nil {
+		return err
+	}
+
+	existing, err := listAllPages(func(lo ListOptions) ([]*Label, *Response, error) {
+		return c.target.Labels.ListLabels(c.pid, &ListLabelsOptions{ListOptions: lo, IncludeAncestorGroups: Ptr(false)}, c.options...)
+	})
+	if err != nil {
+		return err
+	}
+	exists := make(map[string]bool)
+	for _, l := range existing {
+		exists[l.Name] = true
+	}
+
+	for _, l := range labels {
+		if !l.IsProjectLabel {
+			continue
+		}
+		var priority *int
+		if l.Priority != 0 {
+			priority = Ptr(l.Priority)
+		}
+
+		if exists[l.Name] {
+			_, _, err = c.target.Labels.UpdateLabel(c.pid, l.Name, &UpdateLabelOptions{
+				Color:       Ptr(l.Color),
+				Description: Ptr(l.Description),
+				Priority:    priority,
+			}, c.options...)
+		} else {
+			_, _, err = c.target.Labels.CreateLabel(c.pid, &CreateLabelOptions{
+				Name:        Ptr(l.Name),
+				Color:       Ptr(l.Color),
+				Description: Ptr(l.Description),
+				Priority:    priority,
+			}, c.options...)
+		}
+		c.record("label "+l.Name, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,169 @@
+package gitlab
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestProjectsService_CopyProjectSettings(t *testing.T) {
+	srcMux, source := setup(t)
+	dstMux, target := setup(t)
+
+	srcMux.HandleFunc("/api/v4/projects/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"id": 1, "description": "Template", "topics": ["go"], "visibility": "internal", "merge_method": "ff", "build_timeout": 3600}`)
+	})
+	srcMux.HandleFunc("/api/v4/projects/1/protected_branches", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `[{
+			"name": "main",
+			"push_access_levels": [{"access_level": 40}, {"access_level": 30, "user_id": 5}],
+			"merge_access_levels": [{"access_level": 30}],
+			"unprotect_access_levels": [],
+			"allow_force_push": false
+		}, {
+			"name": "release",
+			"push_access_levels": [{"access_level": 40}],
+			"merge_access_levels": [{"access_level": 30}],
+			"allow_force_push": false
+		}]`)
+	})
+	srcMux.HandleFunc("/api/v4/projects/1/variables", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `[
+			{"key": "GOFLAGS", "value": "-mod=mod", "variable_type": "env_var", "environment_scope": "*"},
+			{"key": "REGION", "value": "eu", "variable_type": "env_var", "environment_scope": "production"},
+			{"key": "DEPLOY_TOKEN", "value": "secret", "variable_type": "env_var", "masked": true, "environment_scope": "*"}
+		]`)
+	})
+	srcMux.HandleFunc("/api/v4/projects/1/hooks", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `[{"id": 1, "url": "https://ci.example.com/hook", "push_events": true}, {"id": 2, "url": "https://chat.example.com/hook"}]`)
+	})
+	srcMux.HandleFunc("/api/v4/projects/1/badges", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `[
+			{"name": "coverage", "link_url": "https://example.com/%{project_path}", "image_url": "https://example.com/badge.svg", "kind": "project"},
+			{"name": "group", "link_url": "https://example.com/group", "image_url": "https://example.com/group.svg", "kind": "group"}
+		]`)
+	})
+	srcMux.HandleFunc("/api/v4/projects/1/labels", func(w http.ResponseWriter, r *http.Request) {
+		testParams(t, r, "include_ancestor_groups=false&per_page=100")
+		fmt.Fprint(w, `[
+			{"name": "bug", "color": "#ff0000", "is_project_label": true},
+			{"name": "feature", "color": "#00ff00", "priority": 2, "is_project_label": true}
+		]`)
+	})
+
+	dstMux.HandleFunc("/api/v4/projects/2", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		var opt map[string]interface{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&opt))
+		require.Equal(t, "Template", opt["description"])
+		require.Equal(t, "internal", opt["visibility"])
+		require.Equal(t, "ff", opt["merge_method"])
+		require.Equal(t, float64(3600), opt["build_timeout"])
+		require.Equal(t, false, opt["only_allow_merge_if_pipeline_succeeds"])
+		require.NotContains(t, opt, "squash_option")
+		require.NotContains(t, opt, "issues_access_level")
+		fmt.Fprint(w, `{"id": 2}`)
+	})
+	dstMux.HandleFunc("/api/v4/projects/2/protected_branches/main", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPatch)
+		testBody(t, r, `{"allow_force_push":false,"code_owner_approval_required":false,"allowed_to_push":[{"id":12,"_destroy":true}],"allowed_to_merge":[{"id":13,"_destroy":true},{"access_level":30}]}`)
+		fmt.Fprint(w, `{"name": "main"}`)
+	})
+	dstMux.HandleFunc("/api/v4/projects/2/protected_branches", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			fmt.Fprint(w, `[{
+				"name": "main",
+				"push_access_levels": [{"id": 11, "access_level": 40}, {"id": 12, "access_level": 30}],
+				"merge_access_levels": [{"id": 13, "access_level": 40}, {"id": 14, "access_level": 30, "user_id": 5}]
+			}]`)
+		case http.MethodPost:
+			testBody(t, r, `{"name":"release","allow_force_push":false,"allowed_to_push":[{"access_level":40}],"allowed_to_merge":[{"access_level":30}],"allowed_to_unprotect":null,"code_owner_approval_required":false}`)
+			fmt.Fprint(w, `{"name": "release"}`)
+		}
+	})
+	dstMux.HandleFunc("/api/v4/projects/2/variables", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			fmt.Fprint(w, `[{"key": "GOFLAGS", "value": "", "environment_scope": "*"}]`)
+		case http.MethodPost:
+			testBody(t, r, `{"key":"REGION","value":"eu","description":"","environment_scope":"production","protected":false,"raw":false,"variable_type":"env_var"}`)
+			fmt.Fprint(w, `{"key": "REGION"}`)
+		}
+	})
+	dstMux.HandleFunc("/api/v4/projects/2/variables/GOFLAGS", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		fmt.Fprint(w, `{"key": "GOFLAGS"}`)
+	})
+	dstMux.HandleFunc("/api/v4/projects/2/hooks", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			fmt.Fprint(w, `[{"id": 9, "url": "https://chat.example.com/hook"}]`)
+		case http.MethodPost:
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			fmt.Fprint(w, `{"message": "Invalid url given"}`)
+		}
+	})
+	dstMux.HandleFunc("/api/v4/projects/2/badges", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			fmt.Fprint(w, `[]`)
+		case http.MethodPost:
+			testBody(t, r, `{"link_url":"https://example.com/%{project_path}","image_url":"https://example.com/badge.svg","name":"coverage"}`)
+			fmt.Fprint(w, `{"id": 1}`)
+		}
+	})
+	dstMux.HandleFunc("/api/v4/projects/2/labels", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			fmt.Fprint(w, `[{"name": "bug", "is_project_label": true}]`)
+		case http.MethodPost:
+			testBody(t, r, `{"name":"feature","color":"#00ff00","description":"","priority":2}`)
+			fmt.Fprint(w, `{"id": 2}`)
+		}
+	})
+	dstMux.HandleFunc("/api/v4/projects/2/labels/bug", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		testBody(t, r, `{"color":"#ff0000","description":""}`)
+		fmt.Fprint(w, `{"id": 1}`)
+	})
+
+	result, err := target.Projects.CopyProjectSettings(2, source, 1, nil)
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		"general settings",
+		"protected branch main",
+		"protected branch release",
+		"variable GOFLAGS",
+		"variable REGION (production)",
+		"badge coverage",
+		"label bug",
+		"label feature",
+	}, result.Succeeded)
+	require.Len(t, result.Failed, 1)
+	require.Equal(t, "hook https://ci.example.com/hook", result.Failed[0].Item)
+}
+
+func TestProjectsService_CopyProjectSettingsKinds(t *testing.T) {
+	srcMux, source := setup(t)
+	_, target := setup(t)
+
+	srcMux.HandleFunc("/api/v4/projects/1/labels", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+		fmt.Fprint(w, `{"message": "403 Forbidden"}`)
+	})
+
+	_, err := target.Projects.CopyProjectSettings(2, source, 1, &CopyProjectSettingsOptions{
+		Kinds: []ProjectSettingsKind{LabelSettings},
+	})
+	require.Error(t, err)
+
+	_, err = target.Projects.CopyProjectSettings(2, source, 1, &CopyProjectSettingsOptions{
+		Kinds: []ProjectSettingsKind{"members"},
+	})
+	require.EqualError(t, err, `unknown project settings kind "members"`)
+}
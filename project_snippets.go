@@ -20,6 +20,7 @@ import (
 	"bytes"
 	"fmt"
 	"net/http"
+	"net/url"
 )
 
 // ProjectSnippetsService handles communication with the project snippets
@@ -39,7 +40,7 @@ type ListProjectSnippetsOptions ListOptions
 //
 // GitLab API docs: https://docs.gitlab.com/ee/api/project_snippets.html#list-snippets
 func (s *ProjectSnippetsService) ListSnippets(pid interface{}, opt *ListProjectSnippetsOptions, options ...RequestOptionFunc) ([]*Snippet, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -64,7 +65,7 @@ func (s *ProjectSnippetsService) ListSnippets(pid interface{}, opt *ListProjectS
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/project_snippets.html#single-snippet
 func (s *ProjectSnippetsService) GetSnippet(pid interface{}, snippet int, options ...RequestOptionFunc) (*Snippet, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -103,7 +104,7 @@ type CreateProjectSnippetOptions struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/project_snippets.html#create-new-snippet
 func (s *ProjectSnippetsService) CreateSnippet(pid interface{}, opt *CreateProjectSnippetOptions, options ...RequestOptionFunc) (*Snippet, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -142,7 +143,7 @@ type UpdateProjectSnippetOptions struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/project_snippets.html#update-snippet
 func (s *ProjectSnippetsService) UpdateSnippet(pid interface{}, snippet int, opt *UpdateProjectSnippetOptions, options ...RequestOptionFunc) (*Snippet, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -169,7 +170,7 @@ func (s *ProjectSnippetsService) UpdateSnippet(pid interface{}, snippet int, opt
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/project_snippets.html#delete-snippet
 func (s *ProjectSnippetsService) DeleteSnippet(pid interface{}, snippet int, options ...RequestOptionFunc) (*Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, err
 	}
@@ -188,7 +189,7 @@ func (s *ProjectSnippetsService) DeleteSnippet(pid interface{}, snippet int, opt
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/project_snippets.html#snippet-content
 func (s *ProjectSnippetsService) SnippetContent(pid interface{}, snippet int, options ...RequestOptionFunc) ([]byte, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -207,3 +208,69 @@ func (s *ProjectSnippetsService) SnippetContent(pid interface{}, snippet int, op
 
 	return b.Bytes(), resp, err
 }
+
+// SnippetFileContent returns the raw content of a file of a project snippet
+// at the given ref, like a branch name or commit SHA.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/project_snippets.html#snippet-repository-file-content
+func (s *ProjectSnippetsService) SnippetFileContent(pid interface{}, snippet int, ref, filename string, options ...RequestOptionFunc) ([]byte, *Response, error) {
+	project, err := parseProjectID(pid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("projects/%s/snippets/%d/files/%s/%s/raw", PathEscape(project), snippet, url.PathEscape(ref), PathEscape(filename))
+
+	req, err := s.client.NewRequest(http.MethodGet, u, nil, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var b bytes.Buffer
+	resp, err := s.client.Do(req, &b)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return b.Bytes(), resp, err
+}
+
+// ListSnippetFiles gets the files in the repository of a project snippet,
+// including their sizes.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/graphql/reference/#snippetblobs
+func (s *ProjectSnippetsService) ListSnippetFiles(pid interface{}, snippet int, options ...RequestOptionFunc) ([]*SnippetBlob, *Response, error) {
+	fullPath, err := s.client.projectFullPath(pid, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	q := GraphQLQuery{
+		Query: `query($fullPath: ID!, $ids: [SnippetID!]) {
+			project(fullPath: $fullPath) {
+				snippets(ids: $ids) { nodes { ` + snippetBlobsFields + ` } }
+			}
+		}`,
+		Variables: map[string]interface{}{
+			"fullPath": fullPath,
+			"ids":      []string{globalID("ProjectSnippet", snippet)},
+		},
+	}
+
+	var data struct {
+		Project *struct {
+			Snippets snippetBlobsConnection `json:"snippets"`
+		} `json:"project"`
+	}
+	resp, err := s.client.GraphQL(q, &data, options...)
+	if err != nil {
+		return nil, resp, err
+	}
+	if data.Project == nil {
+		return nil, resp, ErrNotFound
+	}
+
+	blobs, err := data.Project.Snippets.blobs()
+	return blobs, resp, err
+}
@@ -1,6 +1,7 @@
 package gitlab
 
 import (
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"testing"
@@ -392,3 +393,46 @@ func TestProjectSnippetsService_SnippetContent(t *testing.T) {
 	require.Nil(t, s)
 	require.Equal(t, http.StatusNotFound, resp.StatusCode)
 }
+
+func TestProjectSnippetsService_SnippetFileContent(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/snippets/2/files/main/config/app.yml/raw", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, "key: value")
+	})
+
+	b, _, err := client.ProjectSnippets.SnippetFileContent(1, 2, "main", "config/app.yml")
+	require.NoError(t, err)
+	require.Equal(t, []byte("key: value"), b)
+}
+
+func TestProjectSnippetsService_ListSnippetFiles(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/graphql", func(w http.ResponseWriter, r *http.Request) {
+		var q GraphQLQuery
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&q))
+		require.Equal(t, "group/project", q.Variables["fullPath"])
+		require.Equal(t, []interface{}{"gid://gitlab/ProjectSnippet/2"}, q.Variables["ids"])
+
+		fmt.Fprint(w, `{"data": {"project": {"snippets": {"nodes": [
+			{"blobs": {"nodes": [{"name": "app.yml", "path": "config/app.yml", "size": 10}]}}
+		]}}}}`)
+	})
+
+	files, _, err := client.ProjectSnippets.ListSnippetFiles("group/project", 2)
+	require.NoError(t, err)
+	require.Equal(t, []*SnippetBlob{{Name: "app.yml", Path: "config/app.yml", Size: 10}}, files)
+}
+
+func TestProjectSnippetsService_ListSnippetFilesNotFound(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/graphql", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"data": {"project": {"snippets": {"nodes": []}}}}`)
+	})
+
+	_, _, err := client.ProjectSnippets.ListSnippetFiles("group/project", 2)
+	require.ErrorIs(t, err, ErrNotFound)
+}
@@ -68,7 +68,7 @@ type ListProjectVariablesOptions ListOptions
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/project_level_variables.html#list-project-variables
 func (s *ProjectVariablesService) ListVariables(pid interface{}, opt *ListProjectVariablesOptions, options ...RequestOptionFunc) ([]*ProjectVariable, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -102,7 +102,7 @@ type GetProjectVariableOptions struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/project_level_variables.html#get-a-single-variable
 func (s *ProjectVariablesService) GetVariable(pid interface{}, key string, opt *GetProjectVariableOptions, options ...RequestOptionFunc) (*ProjectVariable, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -144,7 +144,7 @@ type CreateProjectVariableOptions struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/project_level_variables.html#create-a-variable
 func (s *ProjectVariablesService) CreateVariable(pid interface{}, opt *CreateProjectVariableOptions, options ...RequestOptionFunc) (*ProjectVariable, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -185,7 +185,7 @@ type UpdateProjectVariableOptions struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/project_level_variables.html#update-a-variable
 func (s *ProjectVariablesService) UpdateVariable(pid interface{}, key string, opt *UpdateProjectVariableOptions, options ...RequestOptionFunc) (*ProjectVariable, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -219,7 +219,7 @@ type RemoveProjectVariableOptions struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/project_level_variables.html#delete-a-variable
 func (s *ProjectVariablesService) RemoveVariable(pid interface{}, key string, opt *RemoveProjectVariableOptions, options ...RequestOptionFunc) (*Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, err
 	}
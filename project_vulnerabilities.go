@@ -95,7 +95,7 @@ type ListProjectVulnerabilitiesOptions struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/project_vulnerabilities.html#list-project-vulnerabilities
 func (s *ProjectVulnerabilitiesService) ListProjectVulnerabilities(pid interface{}, opt *ListProjectVulnerabilitiesOptions, options ...RequestOptionFunc) ([]*ProjectVulnerability, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -129,7 +129,7 @@ type CreateVulnerabilityOptions struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/project_vulnerabilities.html#new-vulnerability
 func (s *ProjectVulnerabilitiesService) CreateVulnerability(pid interface{}, opt *CreateVulnerabilityOptions, options ...RequestOptionFunc) (*ProjectVulnerability, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -21,6 +21,8 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/go-retryablehttp"
@@ -255,6 +257,63 @@ type ProjectLicense struct {
 	SourceURL string `json:"source_url"`
 }
 
+// spdxLicenseIDs maps the license keys detected by GitLab to their SPDX
+// license identifiers. GitLab does not tell the "only" and "or later" variants
+// of the GNU licenses apart, so their keys map to the "-only" identifiers,
+// which replace the deprecated identifiers without a suffix.
+var spdxLicenseIDs = map[string]string{
+	"0bsd":               "0BSD",
+	"afl-3.0":            "AFL-3.0",
+	"agpl-3.0":           "AGPL-3.0-only",
+	"apache-2.0":         "Apache-2.0",
+	"artistic-2.0":       "Artistic-2.0",
+	"bsd-2-clause":       "BSD-2-Clause",
+	"bsd-3-clause":       "BSD-3-Clause",
+	"bsd-3-clause-clear": "BSD-3-Clause-Clear",
+	"bsd-4-clause":       "BSD-4-Clause",
+	"bsl-1.0":            "BSL-1.0",
+	"cc-by-4.0":          "CC-BY-4.0",
+	"cc-by-sa-4.0":       "CC-BY-SA-4.0",
+	"cc0-1.0":            "CC0-1.0",
+	"cecill-2.1":         "CECILL-2.1",
+	"ecl-2.0":            "ECL-2.0",
+	"epl-1.0":            "EPL-1.0",
+	"epl-2.0":            "EPL-2.0",
+	"eupl-1.1":           "EUPL-1.1",
+	"eupl-1.2":           "EUPL-1.2",
+	"gpl-2.0":            "GPL-2.0-only",
+	"gpl-3.0":            "GPL-3.0-only",
+	"isc":                "ISC",
+	"lgpl-2.1":           "LGPL-2.1-only",
+	"lgpl-3.0":           "LGPL-3.0-only",
+	"lppl-1.3c":          "LPPL-1.3c",
+	"mit":                "MIT",
+	"mpl-2.0":            "MPL-2.0",
+	"ms-pl":              "MS-PL",
+	"ms-rl":              "MS-RL",
+	"mulanpsl-2.0":       "MulanPSL-2.0",
+	"ncsa":               "NCSA",
+	"odbl-1.0":           "ODbL-1.0",
+	"ofl-1.1":            "OFL-1.1",
+	"osl-3.0":            "OSL-3.0",
+	"postgresql":         "PostgreSQL",
+	"unlicense":          "Unlicense",
+	"upl-1.0":            "UPL-1.0",
+	"vim":                "Vim",
+	"wtfpl":              "WTFPL",
+	"zlib":               "Zlib",
+}
+
+// SPDXID returns the SPDX license identifier of the license, like
+// "Apache-2.0". It returns an empty string when the license is not a known
+// SPDX license, for example when GitLab detected it as "other".
+func (l *ProjectLicense) SPDXID() string {
+	if l == nil {
+		return ""
+	}
+	return spdxLicenseIDs[strings.ToLower(l.Key)]
+}
+
 // ProjectNamespace represents a project namespace.
 type ProjectNamespace struct {
 	ID        int    `json:"id"`
@@ -387,7 +446,7 @@ func (s *ProjectsService) ListUserProjects(uid interface{}, opt *ListProjectsOpt
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("users/%s/projects", user)
+	u := fmt.Sprintf("users/%s/projects", PathEscape(user))
 
 	req, err := s.client.NewRequest(http.MethodGet, u, opt, options)
 	if err != nil {
@@ -412,7 +471,7 @@ func (s *ProjectsService) ListUserContributedProjects(uid interface{}, opt *List
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("users/%s/contributed_projects", user)
+	u := fmt.Sprintf("users/%s/contributed_projects", PathEscape(user))
 
 	req, err := s.client.NewRequest(http.MethodGet, u, opt, options)
 	if err != nil {
@@ -437,7 +496,7 @@ func (s *ProjectsService) ListUserStarredProjects(uid interface{}, opt *ListProj
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("users/%s/starred_projects", user)
+	u := fmt.Sprintf("users/%s/starred_projects", PathEscape(user))
 
 	req, err := s.client.NewRequest(http.MethodGet, u, opt, options)
 	if err != nil {
@@ -476,7 +535,7 @@ type ListProjectUserOptions struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/projects.html#get-project-users
 func (s *ProjectsService) ListProjectsUsers(pid interface{}, opt *ListProjectUserOptions, options ...RequestOptionFunc) ([]*ProjectUser, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -523,7 +582,7 @@ type ListProjectGroupOptions struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/projects.html#list-a-projects-groups
 func (s *ProjectsService) ListProjectsGroups(pid interface{}, opt *ListProjectGroupOptions, options ...RequestOptionFunc) ([]*ProjectGroup, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -552,7 +611,7 @@ type ProjectLanguages map[string]float32
 //
 // GitLab API docs:  https://docs.gitlab.com/ee/api/projects.html#languages
 func (s *ProjectsService) GetProjectLanguages(pid interface{}, options ...RequestOptionFunc) (*ProjectLanguages, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -587,7 +646,7 @@ type GetProjectOptions struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/projects.html#get-single-project
 func (s *ProjectsService) GetProject(pid interface{}, opt *GetProjectOptions, options ...RequestOptionFunc) (*Project, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -607,6 +666,35 @@ func (s *ProjectsService) GetProject(pid interface{}, opt *GetProjectOptions, op
 	return p, resp, nil
 }
 
+// ProjectExists reports whether a project exists and is visible to the
+// authenticated user, without retrieving the project.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/projects.html#get-single-project
+func (s *ProjectsService) ProjectExists(pid interface{}, options ...RequestOptionFunc) (bool, *Response, error) {
+	project, err := parseProjectID(pid)
+	if err != nil {
+		return false, nil, err
+	}
+	u := fmt.Sprintf("projects/%s", PathEscape(project))
+
+	return s.client.exists(u, nil, options)
+}
+
+// GetProjectLicense gets the license GitLab detected in the default branch
+// of a project. It returns a nil license when no license was detected.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/projects.html#get-single-project
+func (s *ProjectsService) GetProjectLicense(pid interface{}, options ...RequestOptionFunc) (*ProjectLicense, *Response, error) {
+	p, resp, err := s.GetProject(pid, &GetProjectOptions{License: Ptr(true)}, options...)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return p.License, resp, nil
+}
+
 // CreateProjectOptions represents the available CreateProject() options.
 //
 // GitLab API docs: https://docs.gitlab.com/ee/api/projects.html#create-project
@@ -948,7 +1036,7 @@ func (s *ProjectsService) EditProject(pid interface{}, opt *EditProjectOptions,
 		opt.ContainerExpirationPolicyAttributes.NameRegex = opt.ContainerExpirationPolicyAttributes.NameRegexDelete
 	}
 
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -1003,7 +1091,7 @@ type ForkProjectOptions struct {
 //
 // GitLab API docs: https://docs.gitlab.com/ee/api/projects.html#fork-project
 func (s *ProjectsService) ForkProject(pid interface{}, opt *ForkProjectOptions, options ...RequestOptionFunc) (*Project, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -1028,7 +1116,7 @@ func (s *ProjectsService) ForkProject(pid interface{}, opt *ForkProjectOptions,
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/projects.html#star-a-project
 func (s *ProjectsService) StarProject(pid interface{}, options ...RequestOptionFunc) (*Project, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -1066,7 +1154,7 @@ type ListProjectInvidedGroupOptions struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/projects.html#list-a-projects-invited-groups
 func (s *ProjectsService) ListProjectsInvitedGroups(pid interface{}, opt *ListProjectInvidedGroupOptions, options ...RequestOptionFunc) ([]*ProjectGroup, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -1091,7 +1179,7 @@ func (s *ProjectsService) ListProjectsInvitedGroups(pid interface{}, opt *ListPr
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/projects.html#unstar-a-project
 func (s *ProjectsService) UnstarProject(pid interface{}, options ...RequestOptionFunc) (*Project, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -1117,7 +1205,7 @@ func (s *ProjectsService) UnstarProject(pid interface{}, options ...RequestOptio
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/projects.html#archive-a-project
 func (s *ProjectsService) ArchiveProject(pid interface{}, options ...RequestOptionFunc) (*Project, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -1143,7 +1231,7 @@ func (s *ProjectsService) ArchiveProject(pid interface{}, options ...RequestOpti
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/projects.html#unarchive-a-project
 func (s *ProjectsService) UnarchiveProject(pid interface{}, options ...RequestOptionFunc) (*Project, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -1178,7 +1266,7 @@ type DeleteProjectOptions struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/projects.html#delete-project
 func (s *ProjectsService) DeleteProject(pid interface{}, opt *DeleteProjectOptions, options ...RequestOptionFunc) (*Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, err
 	}
@@ -1205,7 +1293,7 @@ type ShareWithGroupOptions struct {
 //
 // GitLab API docs: https://docs.gitlab.com/ee/api/projects.html#share-project-with-group
 func (s *ProjectsService) ShareProjectWithGroup(pid interface{}, opt *ShareWithGroupOptions, options ...RequestOptionFunc) (*Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, err
 	}
@@ -1223,7 +1311,7 @@ func (s *ProjectsService) ShareProjectWithGroup(pid interface{}, opt *ShareWithG
 //
 // GitLab API docs: https://docs.gitlab.com/ee/api/projects.html#delete-a-shared-project-link-within-a-group
 func (s *ProjectsService) DeleteSharedProjectFromGroup(pid interface{}, groupID int, options ...RequestOptionFunc) (*Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, err
 	}
@@ -1304,7 +1392,7 @@ type ListProjectHooksOptions ListOptions
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/projects.html#list-project-hooks
 func (s *ProjectsService) ListProjectHooks(pid interface{}, opt *ListProjectHooksOptions, options ...RequestOptionFunc) ([]*ProjectHook, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -1329,7 +1417,7 @@ func (s *ProjectsService) ListProjectHooks(pid interface{}, opt *ListProjectHook
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/projects.html#get-project-hook
 func (s *ProjectsService) GetProjectHook(pid interface{}, hook int, options ...RequestOptionFunc) (*ProjectHook, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -1382,7 +1470,7 @@ type AddProjectHookOptions struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/projects.html#add-project-hook
 func (s *ProjectsService) AddProjectHook(pid interface{}, opt *AddProjectHookOptions, options ...RequestOptionFunc) (*ProjectHook, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -1435,7 +1523,7 @@ type EditProjectHookOptions struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/projects.html#edit-project-hook
 func (s *ProjectsService) EditProjectHook(pid interface{}, hook int, opt *EditProjectHookOptions, options ...RequestOptionFunc) (*ProjectHook, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -1461,7 +1549,7 @@ func (s *ProjectsService) EditProjectHook(pid interface{}, hook int, opt *EditPr
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/projects.html#delete-project-hook
 func (s *ProjectsService) DeleteProjectHook(pid interface{}, hook int, options ...RequestOptionFunc) (*Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, err
 	}
@@ -1488,7 +1576,7 @@ func (s *ProjectsService) DeleteProjectHook(pid interface{}, hook int, options .
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/projects.html#trigger-a-test-project-hook
 func (s *ProjectsService) TriggerTestProjectHook(pid interface{}, hook int, event ProjectHookEvent, options ...RequestOptionFunc) (*Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, err
 	}
@@ -1516,11 +1604,11 @@ type SetHookCustomHeaderOptions struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/projects.html#set-a-custom-header
 func (s *ProjectsService) SetProjectCustomHeader(pid interface{}, hook int, key string, opt *SetHookCustomHeaderOptions, options ...RequestOptionFunc) (*Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, err
 	}
-	u := fmt.Sprintf("projects/%s/hooks/%d/custom_headers/%s", PathEscape(project), hook, key)
+	u := fmt.Sprintf("projects/%s/hooks/%d/custom_headers/%s", PathEscape(project), hook, url.PathEscape(key))
 
 	req, err := s.client.NewRequest(http.MethodPut, u, opt, options)
 	if err != nil {
@@ -1535,11 +1623,11 @@ func (s *ProjectsService) SetProjectCustomHeader(pid interface{}, hook int, key
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/projects.html#delete-a-custom-header
 func (s *ProjectsService) DeleteProjectCustomHeader(pid interface{}, hook int, key string, options ...RequestOptionFunc) (*Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, err
 	}
-	u := fmt.Sprintf("projects/%s/hooks/%d/custom_headers/%s", PathEscape(project), hook, key)
+	u := fmt.Sprintf("projects/%s/hooks/%d/custom_headers/%s", PathEscape(project), hook, url.PathEscape(key))
 
 	req, err := s.client.NewRequest(http.MethodDelete, u, nil, options)
 	if err != nil {
@@ -1567,7 +1655,7 @@ type ProjectForkRelation struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/projects.html#create-a-forked-fromto-relation-between-existing-projects.
 func (s *ProjectsService) CreateProjectForkRelation(pid interface{}, fork int, options ...RequestOptionFunc) (*ProjectForkRelation, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -1592,7 +1680,7 @@ func (s *ProjectsService) CreateProjectForkRelation(pid interface{}, fork int, o
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/projects.html#delete-an-existing-forked-from-relationship
 func (s *ProjectsService) DeleteProjectForkRelation(pid interface{}, options ...RequestOptionFunc) (*Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, err
 	}
@@ -1620,7 +1708,7 @@ type ProjectFile struct {
 //
 // GitLab API docs: https://docs.gitlab.com/ee/api/projects.html#upload-a-file
 func (s *ProjectsService) UploadFile(pid interface{}, content io.Reader, filename string, options ...RequestOptionFunc) (*ProjectFile, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -1653,7 +1741,7 @@ func (s *ProjectsService) UploadFile(pid interface{}, content io.Reader, filenam
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/projects.html#upload-a-project-avatar
 func (s *ProjectsService) UploadAvatar(pid interface{}, avatar io.Reader, filename string, options ...RequestOptionFunc) (*Project, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -1686,7 +1774,7 @@ func (s *ProjectsService) UploadAvatar(pid interface{}, avatar io.Reader, filena
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/projects.html#list-forks-of-a-project
 func (s *ProjectsService) ListProjectForks(pid interface{}, opt *ListProjectsOptions, options ...RequestOptionFunc) ([]*Project, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -1734,7 +1822,7 @@ type ProjectPushRules struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/projects.html#get-project-push-rules
 func (s *ProjectsService) GetProjectPushRules(pid interface{}, options ...RequestOptionFunc) (*ProjectPushRules, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -1780,7 +1868,7 @@ type AddProjectPushRuleOptions struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/projects.html#add-project-push-rule
 func (s *ProjectsService) AddProjectPushRule(pid interface{}, opt *AddProjectPushRuleOptions, options ...RequestOptionFunc) (*ProjectPushRules, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -1826,7 +1914,7 @@ type EditProjectPushRuleOptions struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/projects.html#edit-project-push-rule
 func (s *ProjectsService) EditProjectPushRule(pid interface{}, opt *EditProjectPushRuleOptions, options ...RequestOptionFunc) (*ProjectPushRules, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -1853,7 +1941,7 @@ func (s *ProjectsService) EditProjectPushRule(pid interface{}, opt *EditProjectP
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/projects.html#delete-project-push-rule
 func (s *ProjectsService) DeleteProjectPushRule(pid interface{}, options ...RequestOptionFunc) (*Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, err
 	}
@@ -1888,7 +1976,7 @@ type ProjectApprovals struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/merge_request_approvals.html#get-configuration
 func (s *ProjectsService) GetApprovalConfiguration(pid interface{}, options ...RequestOptionFunc) (*ProjectApprovals, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -1928,7 +2016,7 @@ type ChangeApprovalConfigurationOptions struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/merge_request_approvals.html#change-configuration
 func (s *ProjectsService) ChangeApprovalConfiguration(pid interface{}, opt *ChangeApprovalConfigurationOptions, options ...RequestOptionFunc) (*ProjectApprovals, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -1960,7 +2048,7 @@ type GetProjectApprovalRulesListsOptions ListOptions
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/merge_request_approvals.html#get-project-level-rules
 func (s *ProjectsService) GetProjectApprovalRules(pid interface{}, opt *GetProjectApprovalRulesListsOptions, options ...RequestOptionFunc) ([]*ProjectApprovalRule, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -1985,7 +2073,7 @@ func (s *ProjectsService) GetProjectApprovalRules(pid interface{}, opt *GetProje
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/merge_request_approvals.html#get-a-single-project-level-rule
 func (s *ProjectsService) GetProjectApprovalRule(pid interface{}, ruleID int, options ...RequestOptionFunc) (*ProjectApprovalRule, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -2011,14 +2099,15 @@ func (s *ProjectsService) GetProjectApprovalRule(pid interface{}, ruleID int, op
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/merge_request_approvals.html#create-project-level-rule
 type CreateProjectLevelRuleOptions struct {
-	Name                          *string `url:"name,omitempty" json:"name,omitempty"`
-	ApprovalsRequired             *int    `url:"approvals_required,omitempty" json:"approvals_required,omitempty"`
-	ReportType                    *string `url:"report_type,omitempty" json:"report_type,omitempty"`
-	RuleType                      *string `url:"rule_type,omitempty" json:"rule_type,omitempty"`
-	UserIDs                       *[]int  `url:"user_ids,omitempty" json:"user_ids,omitempty"`
-	GroupIDs                      *[]int  `url:"group_ids,omitempty" json:"group_ids,omitempty"`
-	ProtectedBranchIDs            *[]int  `url:"protected_branch_ids,omitempty" json:"protected_branch_ids,omitempty"`
-	AppliesToAllProtectedBranches *bool   `url:"applies_to_all_protected_branches,omitempty" json:"applies_to_all_protected_branches,omitempty"`
+	Name                          *string   `url:"name,omitempty" json:"name,omitempty"`
+	ApprovalsRequired             *int      `url:"approvals_required,omitempty" json:"approvals_required,omitempty"`
+	ReportType                    *string   `url:"report_type,omitempty" json:"report_type,omitempty"`
+	RuleType                      *string   `url:"rule_type,omitempty" json:"rule_type,omitempty"`
+	UserIDs                       *[]int    `url:"user_ids,omitempty" json:"user_ids,omitempty"`
+	GroupIDs                      *[]int    `url:"group_ids,omitempty" json:"group_ids,omitempty"`
+	ProtectedBranchIDs            *[]int    `url:"protected_branch_ids,omitempty" json:"protected_branch_ids,omitempty"`
+	AppliesToAllProtectedBranches *bool     `url:"applies_to_all_protected_branches,omitempty" json:"applies_to_all_protected_branches,omitempty"`
+	Usernames                     *[]string `url:"usernames,omitempty" json:"usernames,omitempty"`
 }
 
 // CreateProjectApprovalRule creates a new project-level approval rule.
@@ -2026,7 +2115,7 @@ type CreateProjectLevelRuleOptions struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/merge_request_approvals.html#create-project-level-rule
 func (s *ProjectsService) CreateProjectApprovalRule(pid interface{}, opt *CreateProjectLevelRuleOptions, options ...RequestOptionFunc) (*ProjectApprovalRule, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -2052,12 +2141,13 @@ func (s *ProjectsService) CreateProjectApprovalRule(pid interface{}, opt *Create
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/merge_request_approvals.html#update-project-level-rule
 type UpdateProjectLevelRuleOptions struct {
-	Name                          *string `url:"name,omitempty" json:"name,omitempty"`
-	ApprovalsRequired             *int    `url:"approvals_required,omitempty" json:"approvals_required,omitempty"`
-	UserIDs                       *[]int  `url:"user_ids,omitempty" json:"user_ids,omitempty"`
-	GroupIDs                      *[]int  `url:"group_ids,omitempty" json:"group_ids,omitempty"`
-	ProtectedBranchIDs            *[]int  `url:"protected_branch_ids,omitempty" json:"protected_branch_ids,omitempty"`
-	AppliesToAllProtectedBranches *bool   `url:"applies_to_all_protected_branches,omitempty" json:"applies_to_all_protected_branches,omitempty"`
+	Name                          *string   `url:"name,omitempty" json:"name,omitempty"`
+	ApprovalsRequired             *int      `url:"approvals_required,omitempty" json:"approvals_required,omitempty"`
+	UserIDs                       *[]int    `url:"user_ids,omitempty" json:"user_ids,omitempty"`
+	GroupIDs                      *[]int    `url:"group_ids,omitempty" json:"group_ids,omitempty"`
+	ProtectedBranchIDs            *[]int    `url:"protected_branch_ids,omitempty" json:"protected_branch_ids,omitempty"`
+	AppliesToAllProtectedBranches *bool     `url:"applies_to_all_protected_branches,omitempty" json:"applies_to_all_protected_branches,omitempty"`
+	Usernames                     *[]string `url:"usernames,omitempty" json:"usernames,omitempty"`
 }
 
 // UpdateProjectApprovalRule updates an existing approval rule with new options.
@@ -2065,7 +2155,7 @@ type UpdateProjectLevelRuleOptions struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/merge_request_approvals.html#update-project-level-rule
 func (s *ProjectsService) UpdateProjectApprovalRule(pid interface{}, approvalRule int, opt *UpdateProjectLevelRuleOptions, options ...RequestOptionFunc) (*ProjectApprovalRule, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -2090,7 +2180,7 @@ func (s *ProjectsService) UpdateProjectApprovalRule(pid interface{}, approvalRul
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/merge_request_approvals.html#delete-project-level-rule
 func (s *ProjectsService) DeleteProjectApprovalRule(pid interface{}, approvalRule int, options ...RequestOptionFunc) (*Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, err
 	}
@@ -2119,7 +2209,7 @@ type ChangeAllowedApproversOptions struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/merge_request_approvals.html#change-allowed-approvers
 func (s *ProjectsService) ChangeAllowedApprovers(pid interface{}, opt *ChangeAllowedApproversOptions, options ...RequestOptionFunc) (*ProjectApprovals, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -2159,7 +2249,7 @@ type ProjectPullMirrorDetails struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/projects.html#get-a-projects-pull-mirror-details
 func (s *ProjectsService) GetProjectPullMirrorDetails(pid interface{}, options ...RequestOptionFunc) (*ProjectPullMirrorDetails, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -2184,7 +2274,7 @@ func (s *ProjectsService) GetProjectPullMirrorDetails(pid interface{}, options .
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/projects.html#start-the-pull-mirroring-process-for-a-project
 func (s *ProjectsService) StartMirroringProject(pid interface{}, options ...RequestOptionFunc) (*Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, err
 	}
@@ -2215,7 +2305,7 @@ type TransferProjectOptions struct {
 //
 // GitLab API docs: https://docs.gitlab.com/ee/api/projects.html#transfer-a-project-to-a-new-namespace
 func (s *ProjectsService) TransferProject(pid interface{}, opt *TransferProjectOptions, options ...RequestOptionFunc) (*Project, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -2240,7 +2330,7 @@ func (s *ProjectsService) TransferProject(pid interface{}, opt *TransferProjectO
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/projects.html#start-the-housekeeping-task-for-a-project
 func (s *ProjectsService) StartHousekeepingProject(pid interface{}, options ...RequestOptionFunc) (*Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, err
 	}
@@ -2266,7 +2356,7 @@ type ProjectReposityStorage struct {
 }
 
 func (s *ProjectsService) GetRepositoryStorage(pid interface{}, options ...RequestOptionFunc) (*ProjectReposityStorage, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
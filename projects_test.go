@@ -1958,3 +1958,88 @@ func TestDeleteProjectWebhookHeader(t *testing.T) {
 
 	assert.Equal(t, http.StatusNoContent, req.StatusCode)
 }
+
+func TestGetProjectByProjectID(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/", func(w http.ResponseWriter, r *http.Request) {
+		testURL(t, r, "/api/v4/projects/namespace%2Fname")
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"id":1}`)
+	})
+
+	project, _, err := client.Projects.GetProject(NewProjectID("namespace/name"), nil)
+	if err != nil {
+		t.Fatalf("Projects.GetProject returned error: %v", err)
+	}
+
+	want := &Project{ID: 1}
+	if !reflect.DeepEqual(want, project) {
+		t.Errorf("Projects.GetProject returned %+v, want %+v", project, want)
+	}
+}
+
+func TestGetProjectLicense(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		if r.URL.Query().Get("license") != "true" {
+			t.Errorf("Projects.GetProjectLicense did not request the license")
+		}
+		fmt.Fprint(w, `{"id": 1, "license": {"key": "apache-2.0", "name": "Apache License 2.0", "nickname": ""}}`)
+	})
+
+	license, _, err := client.Projects.GetProjectLicense(1)
+	if err != nil {
+		t.Fatalf("Projects.GetProjectLicense returned error: %v", err)
+	}
+
+	want := &ProjectLicense{Key: "apache-2.0", Name: "Apache License 2.0"}
+	if !reflect.DeepEqual(want, license) {
+		t.Errorf("Projects.GetProjectLicense returned %+v, want %+v", license, want)
+	}
+	if id := license.SPDXID(); id != "Apache-2.0" {
+		t.Errorf("ProjectLicense.SPDXID returned %q, want %q", id, "Apache-2.0")
+	}
+	if id := (&ProjectLicense{Key: "gpl-3.0"}).SPDXID(); id != "GPL-3.0-only" {
+		t.Errorf("ProjectLicense.SPDXID returned %q, want %q", id, "GPL-3.0-only")
+	}
+	if id := (&ProjectLicense{Key: "other"}).SPDXID(); id != "" {
+		t.Errorf("ProjectLicense.SPDXID returned %q, want empty", id)
+	}
+	if id := (*ProjectLicense)(nil).SPDXID(); id != "" {
+		t.Errorf("ProjectLicense.SPDXID returned %q, want empty", id)
+	}
+}
+
+func TestProjectExists(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodHead)
+	})
+
+	exists, _, err := client.Projects.ProjectExists(1)
+	if err != nil {
+		t.Errorf("Projects.ProjectExists returned error: %v", err)
+	}
+	if !exists {
+		t.Errorf("Projects.ProjectExists returned false, want true")
+	}
+
+	exists, _, err = client.Projects.ProjectExists(2)
+	if err != nil {
+		t.Errorf("Projects.ProjectExists returned error: %v", err)
+	}
+	if exists {
+		t.Errorf("Projects.ProjectExists returned true, want false")
+	}
+}
+
+func TestGetProjectByGroupID(t *testing.T) {
+	_, client := setup(t)
+
+	_, _, err := client.Projects.GetProject(NewGroupID("namespace"), nil)
+	assert.EqualError(t, err, "invalid ID, expected a project ID but got a GroupID")
+}
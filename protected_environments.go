@@ -70,6 +70,41 @@ type EnvironmentApprovalRule struct {
 	GroupInheritanceType   int              `json:"group_inheritance_type"`
 }
 
+// Group inheritance types of protected environment deploy access levels and
+// approval rules with a group.
+const (
+	// GroupInheritanceDirectMembers only includes direct members of the
+	// group.
+	GroupInheritanceDirectMembers = 0
+
+	// GroupInheritanceAllMembers includes inherited members of the group.
+	GroupInheritanceAllMembers = 1
+)
+
+// EnvironmentApproverType describes who can approve deployments for an
+// approval rule of a protected environment.
+type EnvironmentApproverType string
+
+// The types of approvers of protected environment approval rules.
+const (
+	UserEnvironmentApprover        EnvironmentApproverType = "user"
+	GroupEnvironmentApprover       EnvironmentApproverType = "group"
+	AccessLevelEnvironmentApprover EnvironmentApproverType = "access_level"
+)
+
+// ApproverType returns the type of the approvers of the rule. A rule has
+// either a user, a group or an access level.
+func (r *EnvironmentApprovalRule) ApproverType() EnvironmentApproverType {
+	switch {
+	case r.UserID != 0:
+		return UserEnvironmentApprover
+	case r.GroupID != 0:
+		return GroupEnvironmentApprover
+	default:
+		return AccessLevelEnvironmentApprover
+	}
+}
+
 // ListProtectedEnvironmentsOptions represents the available
 // ListProtectedEnvironments() options.
 //
@@ -83,7 +118,7 @@ type ListProtectedEnvironmentsOptions ListOptions
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/protected_environments.html#list-protected-environments
 func (s *ProtectedEnvironmentsService) ListProtectedEnvironments(pid interface{}, opt *ListProtectedEnvironmentsOptions, options ...RequestOptionFunc) ([]*ProtectedEnvironment, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -109,7 +144,7 @@ func (s *ProtectedEnvironmentsService) ListProtectedEnvironments(pid interface{}
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/protected_environments.html#get-a-single-protected-environment
 func (s *ProtectedEnvironmentsService) GetProtectedEnvironment(pid interface{}, environment string, options ...RequestOptionFunc) (*ProtectedEnvironment, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -167,13 +202,43 @@ type EnvironmentApprovalRuleOptions struct {
 	GroupInheritanceType   *int              `url:"group_inheritance_type,omitempty" json:"group_inheritance_type,omitempty"`
 }
 
+// EnvironmentApprovalRuleForUser returns an approval rule requiring the given
+// number of approvals from a user.
+func EnvironmentApprovalRuleForUser(user, requiredApprovals int) *EnvironmentApprovalRuleOptions {
+	return &EnvironmentApprovalRuleOptions{
+		UserID:                Ptr(user),
+		RequiredApprovalCount: Ptr(requiredApprovals),
+	}
+}
+
+// EnvironmentApprovalRuleForGroup returns an approval rule requiring the given
+// number of approvals from members of a group. The inheritance type is
+// GroupInheritanceDirectMembers or GroupInheritanceAllMembers.
+func EnvironmentApprovalRuleForGroup(group, requiredApprovals, inheritanceType int) *EnvironmentApprovalRuleOptions {
+	return &EnvironmentApprovalRuleOptions{
+		GroupID:               Ptr(group),
+		RequiredApprovalCount: Ptr(requiredApprovals),
+		GroupInheritanceType:  Ptr(inheritanceType),
+	}
+}
+
+// EnvironmentApprovalRuleForAccessLevel returns an approval rule requiring the
+// given number of approvals from project members with at least the given
+// access level.
+func EnvironmentApprovalRuleForAccessLevel(level AccessLevelValue, requiredApprovals int) *EnvironmentApprovalRuleOptions {
+	return &EnvironmentApprovalRuleOptions{
+		AccessLevel:           Ptr(level),
+		RequiredApprovalCount: Ptr(requiredApprovals),
+	}
+}
+
 // ProtectRepositoryEnvironments protects a single repository environment or
 // several project repository environments using wildcard protected environment.
 //
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/protected_environments.html#protect-a-single-environment
 func (s *ProtectedEnvironmentsService) ProtectRepositoryEnvironments(pid interface{}, opt *ProtectRepositoryEnvironmentsOptions, options ...RequestOptionFunc) (*ProtectedEnvironment, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -235,13 +300,76 @@ type UpdateEnvironmentApprovalRuleOptions struct {
 	Destroy                *bool             `url:"_destroy,omitempty" json:"_destroy,omitempty"`
 }
 
+// UpdateEnvironmentApprovalRuleForUser returns a new approval rule requiring
+// the given number of approvals from a user.
+func UpdateEnvironmentApprovalRuleForUser(user, requiredApprovals int) *UpdateEnvironmentApprovalRuleOptions {
+	return &UpdateEnvironmentApprovalRuleOptions{
+		UserID:                Ptr(user),
+		RequiredApprovalCount: Ptr(requiredApprovals),
+	}
+}
+
+// UpdateEnvironmentApprovalRuleForGroup returns a new approval rule requiring
+// the given number of approvals from members of a group. The inheritance type
+// is GroupInheritanceDirectMembers or GroupInheritanceAllMembers.
+func UpdateEnvironmentApprovalRuleForGroup(group, requiredApprovals, inheritanceType int) *UpdateEnvironmentApprovalRuleOptions {
+	return &UpdateEnvironmentApprovalRuleOptions{
+		GroupID:               Ptr(group),
+		RequiredApprovalCount: Ptr(requiredApprovals),
+		GroupInheritanceType:  Ptr(inheritanceType),
+	}
+}
+
+// UpdateEnvironmentApprovalRuleForAccessLevel returns a new approval rule
+// requiring the given number of approvals from project members with at least
+// the given access level.
+func UpdateEnvironmentApprovalRuleForAccessLevel(level AccessLevelValue, requiredApprovals int) *UpdateEnvironmentApprovalRuleOptions {
+	return &UpdateEnvironmentApprovalRuleOptions{
+		AccessLevel:           Ptr(level),
+		RequiredApprovalCount: Ptr(requiredApprovals),
+	}
+}
+
+// UpdateEnvironmentApprovalRule returns an update of the number of approvals
+// required by the existing approval rule with the given ID.
+func UpdateEnvironmentApprovalRule(rule, requiredApprovals int) *UpdateEnvironmentApprovalRuleOptions {
+	return &UpdateEnvironmentApprovalRuleOptions{
+		ID:                    Ptr(rule),
+		RequiredApprovalCount: Ptr(requiredApprovals),
+	}
+}
+
+// DestroyEnvironmentApprovalRule returns an update removing the existing
+// approval rule with the given ID.
+func DestroyEnvironmentApprovalRule(rule int) *UpdateEnvironmentApprovalRuleOptions {
+	return &UpdateEnvironmentApprovalRuleOptions{
+		ID:      Ptr(rule),
+		Destroy: Ptr(true),
+	}
+}
+
+// ApproverType returns the type of the approvers set by the update, or an
+// empty type if the update only refers to an existing rule by its ID.
+func (r *UpdateEnvironmentApprovalRuleOptions) ApproverType() EnvironmentApproverType {
+	switch {
+	case r.UserID != nil:
+		return UserEnvironmentApprover
+	case r.GroupID != nil:
+		return GroupEnvironmentApprover
+	case r.AccessLevel != nil:
+		return AccessLevelEnvironmentApprover
+	default:
+		return ""
+	}
+}
+
 // UpdateProtectedEnvironments updates a single repository environment or
 // several project repository environments using wildcard protected environment.
 //
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/protected_environments.html#update-a-protected-environment
 func (s *ProtectedEnvironmentsService) UpdateProtectedEnvironments(pid interface{}, environment string, opt *UpdateProtectedEnvironmentsOptions, options ...RequestOptionFunc) (*ProtectedEnvironment, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -267,7 +395,7 @@ func (s *ProtectedEnvironmentsService) UpdateProtectedEnvironments(pid interface
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/protected_environments.html#unprotect-a-single-environment
 func (s *ProtectedEnvironmentsService) UnprotectEnvironment(pid interface{}, environment string, options ...RequestOptionFunc) (*Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, err
 	}
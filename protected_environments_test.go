@@ -604,3 +604,62 @@ func TestUnprotectRepositoryEnvironments(t *testing.T) {
 	assert.NoError(t, err, "failed to get response")
 	assert.Equal(t, http.StatusOK, resp.StatusCode)
 }
+
+func TestProtectRepositoryEnvironmentsWithApprovalRules(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/protected_environments", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testBody(t, r, `{"name":"production","approval_rules":[{"user_id":10,"required_approvals":1},{"group_id":20,"required_approvals":2,"group_inheritance_type":1},{"access_level":40,"required_approvals":1}]}`)
+		fmt.Fprint(w, `{
+			"name": "production",
+			"approval_rules": [
+				{"id": 1, "user_id": 10, "required_approvals": 1},
+				{"id": 2, "group_id": 20, "required_approvals": 2, "group_inheritance_type": 1},
+				{"id": 3, "access_level": 40, "required_approvals": 1}
+			]
+		}`)
+	})
+
+	environment, _, err := client.ProtectedEnvironments.ProtectRepositoryEnvironments(1, &ProtectRepositoryEnvironmentsOptions{
+		Name: Ptr("production"),
+		ApprovalRules: &[]*EnvironmentApprovalRuleOptions{
+			EnvironmentApprovalRuleForUser(10, 1),
+			EnvironmentApprovalRuleForGroup(20, 2, GroupInheritanceAllMembers),
+			EnvironmentApprovalRuleForAccessLevel(MaintainerPermissions, 1),
+		},
+	})
+	assert.NoError(t, err)
+	assert.Len(t, environment.ApprovalRules, 3)
+	assert.Equal(t, UserEnvironmentApprover, environment.ApprovalRules[0].ApproverType())
+	assert.Equal(t, GroupEnvironmentApprover, environment.ApprovalRules[1].ApproverType())
+	assert.Equal(t, AccessLevelEnvironmentApprover, environment.ApprovalRules[2].ApproverType())
+}
+
+func TestUpdateProtectedEnvironmentsWithApprovalRules(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/protected_environments/production", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		testBody(t, r, `{"approval_rules":[{"user_id":10,"required_approvals":1},{"group_id":20,"required_approvals":2,"group_inheritance_type":1},{"access_level":40,"required_approvals":1},{"id":4,"required_approvals":3},{"id":5,"_destroy":true}]}`)
+		fmt.Fprint(w, `{"name": "production"}`)
+	})
+
+	rules := []*UpdateEnvironmentApprovalRuleOptions{
+		UpdateEnvironmentApprovalRuleForUser(10, 1),
+		UpdateEnvironmentApprovalRuleForGroup(20, 2, GroupInheritanceAllMembers),
+		UpdateEnvironmentApprovalRuleForAccessLevel(MaintainerPermissions, 1),
+		UpdateEnvironmentApprovalRule(4, 3),
+		DestroyEnvironmentApprovalRule(5),
+	}
+	assert.Equal(t, UserEnvironmentApprover, rules[0].ApproverType())
+	assert.Equal(t, GroupEnvironmentApprover, rules[1].ApproverType())
+	assert.Equal(t, AccessLevelEnvironmentApprover, rules[2].ApproverType())
+	assert.Empty(t, rules[3].ApproverType())
+	assert.Empty(t, rules[4].ApproverType())
+
+	_, _, err := client.ProtectedEnvironments.UpdateProtectedEnvironments(1, "production", &UpdateProtectedEnvironmentsOptions{
+		ApprovalRules: &rules,
+	})
+	assert.NoError(t, err)
+}
@@ -17,8 +17,12 @@
 package gitlab
 
 import (
+	"errors"
 	"fmt"
+	"io"
 	"net/http"
+	"net/url"
+	"strings"
 	"time"
 )
 
@@ -61,7 +65,8 @@ type Release struct {
 		} `json:"sources"`
 		Links []*ReleaseLink `json:"links"`
 	} `json:"assets"`
-	Links struct {
+	Evidences []*ReleaseEvidence `json:"evidences"`
+	Links     struct {
 		ClosedIssueURL     string `json:"closed_issues_url"`
 		ClosedMergeRequest string `json:"closed_merge_requests_url"`
 		EditURL            string `json:"edit_url"`
@@ -72,6 +77,17 @@ type Release struct {
 	} `json:"_links"`
 }
 
+// ReleaseEvidence represents a snapshot of the data related to a release,
+// collected when the release is created or on request.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/user/project/releases/release_evidence.html
+type ReleaseEvidence struct {
+	SHA         string     `json:"sha"`
+	Filepath    string     `json:"filepath"`
+	CollectedAt *time.Time `json:"collected_at"`
+}
+
 // ListReleasesOptions represents ListReleases() options.
 //
 // GitLab API docs:
@@ -88,7 +104,7 @@ type ListReleasesOptions struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/releases/index.html#list-releases
 func (s *ReleasesService) ListReleases(pid interface{}, opt *ListReleasesOptions, options ...RequestOptionFunc) ([]*Release, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -113,7 +129,7 @@ func (s *ReleasesService) ListReleases(pid interface{}, opt *ListReleasesOptions
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/releases/index.html#get-a-release-by-a-tag-name
 func (s *ReleasesService) GetRelease(pid interface{}, tagName string, options ...RequestOptionFunc) (*Release, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -138,7 +154,7 @@ func (s *ReleasesService) GetRelease(pid interface{}, tagName string, options ..
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/releases/#get-the-latest-release
 func (s *ReleasesService) GetLatestRelease(pid interface{}, options ...RequestOptionFunc) (*Release, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -199,7 +215,7 @@ type ReleaseAssetLinkOptions struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/releases/index.html#create-a-release
 func (s *ReleasesService) CreateRelease(pid interface{}, opts *CreateReleaseOptions, options ...RequestOptionFunc) (*Release, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -235,7 +251,7 @@ type UpdateReleaseOptions struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/releases/index.html#update-a-release
 func (s *ReleasesService) UpdateRelease(pid interface{}, tagName string, opts *UpdateReleaseOptions, options ...RequestOptionFunc) (*Release, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -260,7 +276,7 @@ func (s *ReleasesService) UpdateRelease(pid interface{}, tagName string, opts *U
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/releases/index.html#delete-a-release
 func (s *ReleasesService) DeleteRelease(pid interface{}, tagName string, options ...RequestOptionFunc) (*Release, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -279,3 +295,127 @@ func (s *ReleasesService) DeleteRelease(pid interface{}, tagName string, options
 
 	return r, resp, nil
 }
+
+// CollectReleaseEvidence creates a new evidence for an existing release.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/releases/index.html#collect-release-evidence
+func (s *ReleasesService) CollectReleaseEvidence(pid interface{}, tagName string, options ...RequestOptionFunc) (*Response, error) {
+	project, err := parseProjectID(pid)
+	if err != nil {
+		return nil, err
+	}
+	u := fmt.Sprintf("projects/%s/releases/%s/evidence", PathEscape(project), PathEscape(tagName))
+
+	req, err := s.client.NewRequest(http.MethodPost, u, nil, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, nil)
+}
+
+// ListReleaseEvidences gets the evidences collected for a release, oldest
+// first.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/releases/index.html#get-a-release-by-a-tag-name
+func (s *ReleasesService) ListReleaseEvidences(pid interface{}, tagName string, options ...RequestOptionFunc) ([]*ReleaseEvidence, *Response, error) {
+	r, resp, err := s.GetRelease(pid, tagName, options...)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return r.Evidences, resp, nil
+}
+
+// DownloadReleaseEvidence streams the JSON document of a release evidence
+// into the given writer. The document is requested from the GitLab instance
+// of the client, using the path of the evidence's file path.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/user/project/releases/release_evidence.html
+func (s *ReleasesService) DownloadReleaseEvidence(evidence *ReleaseEvidence, w io.Writer, options ...RequestOptionFunc) (*Response, error) {
+	if evidence == nil || evidence.Filepath == "" {
+		return nil, errors.New("release evidence has no file path")
+	}
+	fp, err := url.Parse(evidence.Filepath)
+	if err != nil {
+		return nil, fmt.Errorf("invalid release evidence file path: %w", err)
+	}
+
+	req, err := s.client.NewRequest(http.MethodGet, "", nil, options)
+	if err != nil {
+		return nil, err
+	}
+
+	// Evidences are not part of the versioned REST API. Only the path is
+	// used, so credentials are never sent to another host. The path may
+	// already contain the relative URL root of the instance.
+	root := s.client.hostPath("")
+	req.URL.Path = root + strings.TrimPrefix(strings.TrimPrefix(fp.Path, root), "/")
+	req.URL.RawPath = ""
+
+	return s.client.Do(req, w)
+}
+
+// ReleaseAssetFile represents a file which is uploaded to the project and
+// attached to a release as an asset link by CreateReleaseWithAssets().
+type ReleaseAssetFile struct {
+	// Name is the name of the asset link. Defaults to the filename.
+	Name            string
+	Filename        string
+	Content         io.Reader
+	DirectAssetPath *string
+	LinkType        *LinkTypeValue
+}
+
+// CreateReleaseWithAssets uploads the given files to the project and creates
+// a release which links to the uploaded files, next to any links already
+// present in the options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/releases/index.html#create-a-release
+func (s *ReleasesService) CreateReleaseWithAssets(pid interface{}, opts *CreateReleaseOptions, files []*ReleaseAssetFile, options ...RequestOptionFunc) (*Release, *Response, error) {
+	var o CreateReleaseOptions
+	if opts != nil {
+		// Copy the options, so the links of the uploaded files are not added
+		// to the options of the caller.
+		o = *opts
+	}
+	assets := new(ReleaseAssetsOptions)
+	if o.Assets != nil {
+		assets.Links = append(assets.Links, o.Assets.Links...)
+	}
+	o.Assets = assets
+
+	for _, f := range files {
+		pf, resp, err := s.client.Projects.UploadFile(pid, f.Content, f.Filename, options...)
+		if err != nil {
+			return nil, resp, fmt.Errorf("uploading release asset %q: %w", f.Filename, err)
+		}
+
+		if pf.FullPath == "" {
+			return nil, resp, fmt.Errorf("uploading release asset %q: upload path not returned", f.Filename)
+		}
+
+		name := f.Name
+		if name == "" {
+			name = f.Filename
+		}
+
+		// Uploads are served relative to the host, not the API.
+		u := *s.client.baseURL
+		u.Path = s.client.hostPath(strings.TrimPrefix(pf.FullPath, "/"))
+		u.RawPath = ""
+
+		assets.Links = append(assets.Links, &ReleaseAssetLinkOptions{
+			Name:            Ptr(name),
+			URL:             Ptr(u.String()),
+			DirectAssetPath: f.DirectAssetPath,
+			LinkType:        f.LinkType,
+		})
+	}
+
+	return s.CreateRelease(pid, &o, options...)
+}
@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/http/httptest"
 	"strings"
 	"testing"
 	"time"
@@ -418,3 +419,148 @@ func TestReleasesService_DeleteRelease(t *testing.T) {
 		t.Errorf("expected tag %s, got %s", exampleTagName, release.TagName)
 	}
 }
+
+func TestReleasesService_CollectReleaseEvidence(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/releases/v0.1/evidence",
+		func(w http.ResponseWriter, r *http.Request) {
+			testMethod(t, r, http.MethodPost)
+			w.WriteHeader(http.StatusCreated)
+		})
+
+	_, err := client.Releases.CollectReleaseEvidence(1, exampleTagName)
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestReleasesService_CreateReleaseWithAssets(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/uploads",
+		func(w http.ResponseWriter, r *http.Request) {
+			testMethod(t, r, http.MethodPost)
+			fmt.Fprint(w, `{
+				"alt": "app.tar.gz",
+				"url": "/uploads/66dbcd21ec5d24ed6ea225176098d52b/app.tar.gz",
+				"full_path": "/-/project/1/uploads/66dbcd21ec5d24ed6ea225176098d52b/app.tar.gz",
+				"markdown": "[app.tar.gz](/uploads/66dbcd21ec5d24ed6ea225176098d52b/app.tar.gz)"
+			}`)
+		})
+
+	mux.HandleFunc("/api/v4/projects/1/releases",
+		func(w http.ResponseWriter, r *http.Request) {
+			testMethod(t, r, http.MethodPost)
+			b, err := io.ReadAll(r.Body)
+			if err != nil {
+				t.Fatalf("unable to read request body")
+			}
+			if !strings.Contains(string(b), `"links":[{"name":"existing","url":"https://example.com/existing"},`+
+				`{"name":"Binary","url":"`+client.baseURL.Scheme+`://`+client.baseURL.Host+`/-/project/1/uploads/66dbcd21ec5d24ed6ea225176098d52b/app.tar.gz","link_type":"package"}]`) {
+				t.Errorf("unexpected request body: %s", b)
+			}
+			fmt.Fprint(w, exampleReleaseResponse)
+		})
+
+	opts := &CreateReleaseOptions{
+		Name:    Ptr("name"),
+		TagName: Ptr(exampleTagName),
+		Assets: &ReleaseAssetsOptions{
+			Links: []*ReleaseAssetLinkOptions{
+				{Name: Ptr("existing"), URL: Ptr("https://example.com/existing")},
+			},
+		},
+	}
+	files := []*ReleaseAssetFile{{
+		Name:     "Binary",
+		Filename: "app.tar.gz",
+		Content:  strings.NewReader("content"),
+		LinkType: Ptr(PackageLinkType),
+	}}
+
+	release, _, err := client.Releases.CreateReleaseWithAssets(1, opts, files)
+	if err != nil {
+		t.Error(err)
+	}
+	if release.TagName != exampleTagName {
+		t.Errorf("expected tag %s, got %s", exampleTagName, release.TagName)
+	}
+	if len(opts.Assets.Links) != 1 {
+		t.Errorf("expected options of the caller to be unchanged, got %d links", len(opts.Assets.Links))
+	}
+}
+
+func TestReleasesService_ListReleaseEvidences(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/releases/v0.1",
+		func(w http.ResponseWriter, r *http.Request) {
+			testMethod(t, r, http.MethodGet)
+			fmt.Fprint(w, `{"tag_name": "v0.1", "evidences": [{
+				"sha": "760d6cdfb0879c3ffedec13af470e0f71cf52c6cde4d",
+				"filepath": "https://gitlab.example.com/root/awesome-app/-/releases/v0.1/evidences/1.json",
+				"collected_at": "2019-01-03T01:56:19.539Z"
+			}]}`)
+		})
+
+	evidences, _, err := client.Releases.ListReleaseEvidences(1, exampleTagName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(evidences) != 1 || evidences[0].SHA != "760d6cdfb0879c3ffedec13af470e0f71cf52c6cde4d" {
+		t.Errorf("unexpected evidences %v", evidences)
+	}
+}
+
+func TestReleasesService_DownloadReleaseEvidence(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/root/awesome-app/-/releases/v0.1/evidences/1.json",
+		func(w http.ResponseWriter, r *http.Request) {
+			testMethod(t, r, http.MethodGet)
+			fmt.Fprint(w, `{"release": {"tag_name": "v0.1"}}`)
+		})
+
+	var b strings.Builder
+	_, err := client.Releases.DownloadReleaseEvidence(&ReleaseEvidence{
+		Filepath: "https://gitlab.example.com/root/awesome-app/-/releases/v0.1/evidences/1.json",
+	}, &b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := `{"release": {"tag_name": "v0.1"}}`; b.String() != want {
+		t.Errorf("expected %s, got %s", want, b.String())
+	}
+
+	if _, err := client.Releases.DownloadReleaseEvidence(&ReleaseEvidence{}, &b); err == nil {
+		t.Error("expected an error for an evidence without file path")
+	}
+}
+
+func TestReleasesService_DownloadReleaseEvidenceRelativeURLRoot(t *testing.T) {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	client, err := NewClient("", WithBaseURL(server.URL+"/gitlab"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	mux.HandleFunc("/gitlab/root/awesome-app/-/releases/v0.1/evidences/1.json",
+		func(w http.ResponseWriter, r *http.Request) {
+			testMethod(t, r, http.MethodGet)
+			fmt.Fprint(w, `{}`)
+		})
+
+	for _, fp := range []string{
+		"https://gitlab.example.com/gitlab/root/awesome-app/-/releases/v0.1/evidences/1.json",
+		"https://gitlab.example.com/root/awesome-app/-/releases/v0.1/evidences/1.json",
+	} {
+		var b strings.Builder
+		if _, err := client.Releases.DownloadReleaseEvidence(&ReleaseEvidence{Filepath: fp}, &b); err != nil {
+			t.Errorf("DownloadReleaseEvidence(%q) returned error: %v", fp, err)
+		}
+	}
+}
@@ -18,10 +18,14 @@ package gitlab
 
 import (
 	"bytes"
+	"encoding/base64"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
 	"net/url"
+	"strings"
+	"unicode/utf8"
 )
 
 // RepositoriesService handles communication with the repositories related
@@ -63,7 +67,7 @@ type ListTreeOptions struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/repositories.html#list-repository-tree
 func (s *RepositoriesService) ListTree(pid interface{}, opt *ListTreeOptions, options ...RequestOptionFunc) ([]*TreeNode, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -89,7 +93,7 @@ func (s *RepositoriesService) ListTree(pid interface{}, opt *ListTreeOptions, op
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/repositories.html#get-a-blob-from-repository
 func (s *RepositoriesService) Blob(pid interface{}, sha string, options ...RequestOptionFunc) ([]byte, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -114,7 +118,7 @@ func (s *RepositoriesService) Blob(pid interface{}, sha string, options ...Reque
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/repositories.html#raw-blob-content
 func (s *RepositoriesService) RawBlobContent(pid interface{}, sha string, options ...RequestOptionFunc) ([]byte, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -149,7 +153,7 @@ type ArchiveOptions struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/repositories.html#get-file-archive
 func (s *RepositoriesService) Archive(pid interface{}, opt *ArchiveOptions, options ...RequestOptionFunc) ([]byte, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -180,7 +184,7 @@ func (s *RepositoriesService) Archive(pid interface{}, opt *ArchiveOptions, opti
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/repositories.html#get-file-archive
 func (s *RepositoriesService) StreamArchive(pid interface{}, w io.Writer, opt *ArchiveOptions, options ...RequestOptionFunc) (*Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, err
 	}
@@ -232,7 +236,7 @@ type CompareOptions struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/repositories.html#compare-branches-tags-or-commits
 func (s *RepositoriesService) Compare(pid interface{}, opt *CompareOptions, options ...RequestOptionFunc) (*Compare, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -280,7 +284,7 @@ type ListContributorsOptions struct {
 //
 // GitLab API docs: https://docs.gitlab.com/ee/api/repositories.html#contributors
 func (s *RepositoriesService) Contributors(pid interface{}, opt *ListContributorsOptions, options ...RequestOptionFunc) ([]*Contributor, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -314,7 +318,7 @@ type MergeBaseOptions struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/repositories.html#merge-base
 func (s *RepositoriesService) MergeBase(pid interface{}, opt *MergeBaseOptions, options ...RequestOptionFunc) (*Commit, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -355,7 +359,7 @@ type AddChangelogOptions struct {
 // Gitlab API docs:
 // https://docs.gitlab.com/ee/api/repositories.html#add-changelog-data-to-a-changelog-file
 func (s *RepositoriesService) AddChangelog(pid interface{}, opt *AddChangelogOptions, options ...RequestOptionFunc) (*Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, err
 	}
@@ -401,11 +405,11 @@ type GenerateChangelogDataOptions struct {
 // Gitlab API docs:
 // https://docs.gitlab.com/ee/api/repositories.html#generate-changelog-data
 func (s *RepositoriesService) GenerateChangelogData(pid interface{}, opt GenerateChangelogDataOptions, options ...RequestOptionFunc) (*ChangelogData, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("projects/%s/repository/changelog", project)
+	u := fmt.Sprintf("projects/%s/repository/changelog", PathEscape(project))
 
 	req, err := s.client.NewRequest(http.MethodGet, u, opt, options)
 	if err != nil {
@@ -420,3 +424,97 @@ func (s *RepositoriesService) GenerateChangelogData(pid interface{}, opt Generat
 
 	return cd, resp, nil
 }
+
+// CommitFileAction represents a single file change made by CommitFiles().
+type CommitFileAction struct {
+	Action FileActionValue
+	Path   string
+
+	// PreviousPath is the original path of a moved file.
+	PreviousPath string
+
+	// Content is the new content of a created or updated file. Content that
+	// is not valid UTF-8 text is base64 encoded automatically.
+	Content []byte
+
+	// ExecuteFilemode sets or clears the execute flag of the file.
+	ExecuteFilemode *bool
+
+	// LastCommitID is the last known commit ID of the file. If the file was
+	// changed by another commit since, the commit fails with ErrConflict.
+	LastCommitID string
+}
+
+// CommitFilesOptions represents the available CommitFiles() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/commits.html#create-a-commit-with-multiple-files-and-actions
+type CommitFilesOptions struct {
+	// StartBranch or StartSHA creates the branch from the given ref, if it
+	// does not exist yet.
+	StartBranch *string
+	StartSHA    *string
+	AuthorEmail *string
+	AuthorName  *string
+	Force       *bool
+}
+
+// CommitFiles creates a single commit on a branch which applies all given
+// file actions. When an action has a LastCommitID and the file was changed
+// since, the returned error wraps ErrConflict.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/commits.html#create-a-commit-with-multiple-files-and-actions
+func (s *RepositoriesService) CommitFiles(pid interface{}, branch, message string, actions []*CommitFileAction, opt *CommitFilesOptions, options ...RequestOptionFunc) (*Commit, *Response, error) {
+	o := &CreateCommitOptions{
+		Branch:        Ptr(branch),
+		CommitMessage: Ptr(message),
+		Actions:       make([]*CommitActionOptions, 0, len(actions)),
+	}
+	if opt != nil {
+		o.StartBranch = opt.StartBranch
+		o.StartSHA = opt.StartSHA
+		o.AuthorEmail = opt.AuthorEmail
+		o.AuthorName = opt.AuthorName
+		o.Force = opt.Force
+	}
+
+	for _, a := range actions {
+		ao := &CommitActionOptions{
+			Action:          Ptr(a.Action),
+			FilePath:        Ptr(a.Path),
+			ExecuteFilemode: a.ExecuteFilemode,
+		}
+		if a.PreviousPath != "" {
+			ao.PreviousPath = Ptr(a.PreviousPath)
+		}
+		if a.LastCommitID != "" {
+			ao.LastCommitID = Ptr(a.LastCommitID)
+		}
+		if a.Action == FileCreate || a.Action == FileUpdate || a.Content != nil {
+			if isText(a.Content) {
+				ao.Content = Ptr(string(a.Content))
+			} else {
+				ao.Content = Ptr(base64.StdEncoding.EncodeToString(a.Content))
+				ao.Encoding = Ptr("base64")
+			}
+		}
+		o.Actions = append(o.Actions, ao)
+	}
+
+	c, resp, err := s.client.Commits.CreateCommit(pid, o, options...)
+	if err != nil {
+		var errResp *ErrorResponse
+		if errors.As(err, &errResp) && strings.Contains(errResp.Message, "changed since you started editing") {
+			return nil, resp, fmt.Errorf("%w: %w", ErrConflict, err)
+		}
+		return nil, resp, err
+	}
+
+	return c, resp, nil
+}
+
+// isText reports whether content can be sent as is, without base64 encoding.
+func isText(content []byte) bool {
+	return utf8.Valid(content) && bytes.IndexByte(content, 0) == -1
+}
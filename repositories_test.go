@@ -443,3 +443,50 @@ func TestGenerateChangelogData(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, want, notes)
 }
+
+func TestRepositoriesService_CommitFiles(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/repository/commits", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testBody(t, r, `{"branch":"config","commit_message":"Update config","start_branch":"main","actions":[`+
+			`{"action":"update","file_path":"config.yml","content":"key: value\n","last_commit_id":"abc"},`+
+			`{"action":"create","file_path":"logo.png","content":"iVBORw0K","encoding":"base64"},`+
+			`{"action":"move","file_path":"new.md","previous_path":"old.md"},`+
+			`{"action":"chmod","file_path":"run.sh","execute_filemode":true},`+
+			`{"action":"delete","file_path":"unused.txt"}]}`)
+		fmt.Fprint(w, `{"id": "ed899a2f4b50b4370feeea94676502b42383c746"}`)
+	})
+
+	actions := []*CommitFileAction{
+		{Action: FileUpdate, Path: "config.yml", Content: []byte("key: value\n"), LastCommitID: "abc"},
+		{Action: FileCreate, Path: "logo.png", Content: []byte{0x89, 'P', 'N', 'G', '\r', '\n'}},
+		{Action: FileMove, Path: "new.md", PreviousPath: "old.md"},
+		{Action: FileChmod, Path: "run.sh", ExecuteFilemode: Ptr(true)},
+		{Action: FileDelete, Path: "unused.txt"},
+	}
+
+	c, _, err := client.Repositories.CommitFiles(1, "config", "Update config", actions, &CommitFilesOptions{
+		StartBranch: Ptr("main"),
+	})
+	require.NoError(t, err)
+	require.Equal(t, "ed899a2f4b50b4370feeea94676502b42383c746", c.ID)
+}
+
+func TestRepositoriesService_CommitFilesConflict(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/repository/commits", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, `{"message": "You are attempting to update a file that has changed since you started editing it."}`)
+	})
+
+	_, resp, err := client.Repositories.CommitFiles(1, "main", "Update config", []*CommitFileAction{
+		{Action: FileUpdate, Path: "config.yml", Content: []byte("key: value\n"), LastCommitID: "abc"},
+	}, nil)
+	require.ErrorIs(t, err, ErrConflict)
+
+	var errResp *ErrorResponse
+	require.ErrorAs(t, err, &errResp)
+	require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+}
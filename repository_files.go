@@ -67,7 +67,7 @@ type GetFileOptions struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/repository_files.html#get-file-from-repository
 func (s *RepositoryFilesService) GetFile(pid interface{}, fileName string, opt *GetFileOptions, options ...RequestOptionFunc) (*File, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -105,7 +105,7 @@ type GetFileMetaDataOptions struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/repository_files.html#get-file-from-repository
 func (s *RepositoryFilesService) GetFileMetaData(pid interface{}, fileName string, opt *GetFileMetaDataOptions, options ...RequestOptionFunc) (*File, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -147,6 +147,25 @@ func (s *RepositoryFilesService) GetFileMetaData(pid interface{}, fileName strin
 	return f, resp, nil
 }
 
+// FileExists reports whether a file exists in the repository at the given
+// ref, without retrieving the file or its metadata.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/repository_files.html#get-file-from-repository
+func (s *RepositoryFilesService) FileExists(pid interface{}, fileName string, opt *GetFileMetaDataOptions, options ...RequestOptionFunc) (bool, *Response, error) {
+	project, err := parseProjectID(pid)
+	if err != nil {
+		return false, nil, err
+	}
+	u := fmt.Sprintf(
+		"projects/%s/repository/files/%s",
+		PathEscape(project),
+		PathEscape(fileName),
+	)
+
+	return s.client.exists(u, opt, options)
+}
+
 // FileBlameRange represents one item of blame information.
 //
 // GitLab API docs: https://docs.gitlab.com/ee/api/repository_files.html
@@ -185,7 +204,7 @@ type GetFileBlameOptions struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/repository_files.html#get-file-blame-from-repository
 func (s *RepositoryFilesService) GetFileBlame(pid interface{}, file string, opt *GetFileBlameOptions, options ...RequestOptionFunc) ([]*FileBlameRange, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -223,7 +242,7 @@ type GetRawFileOptions struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/repository_files.html#get-raw-file-from-repository
 func (s *RepositoryFilesService) GetRawFile(pid interface{}, fileName string, opt *GetRawFileOptions, options ...RequestOptionFunc) ([]byte, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -279,7 +298,7 @@ type CreateFileOptions struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/repository_files.html#create-new-file-in-repository
 func (s *RepositoryFilesService) CreateFile(pid interface{}, fileName string, opt *CreateFileOptions, options ...RequestOptionFunc) (*FileInfo, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -324,7 +343,7 @@ type UpdateFileOptions struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/repository_files.html#update-existing-file-in-repository
 func (s *RepositoryFilesService) UpdateFile(pid interface{}, fileName string, opt *UpdateFileOptions, options ...RequestOptionFunc) (*FileInfo, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -366,7 +385,7 @@ type DeleteFileOptions struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/repository_files.html#delete-existing-file-in-repository
 func (s *RepositoryFilesService) DeleteFile(pid interface{}, fileName string, opt *DeleteFileOptions, options ...RequestOptionFunc) (*Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, err
 	}
@@ -356,3 +356,20 @@ func TestRepositoryFilesService_DeleteFile(t *testing.T) {
 	require.Error(t, err)
 	require.Equal(t, http.StatusNotFound, resp.StatusCode)
 }
+
+func TestRepositoryFilesService_FileExists(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/13083/repository/files/app/models/key.rb", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodHead)
+		testParams(t, r, "ref=master")
+	})
+
+	exists, _, err := client.RepositoryFiles.FileExists(13083, "app/models/key.rb", &GetFileMetaDataOptions{Ref: Ptr("master")})
+	require.NoError(t, err)
+	require.True(t, exists)
+
+	exists, _, err = client.RepositoryFiles.FileExists(13083, "missing.rb", &GetFileMetaDataOptions{Ref: Ptr("master")})
+	require.NoError(t, err)
+	require.False(t, exists)
+}
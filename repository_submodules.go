@@ -68,7 +68,7 @@ type UpdateSubmoduleOptions struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/repository_submodules.html#update-existing-submodule-reference-in-repository
 func (s *RepositorySubmodulesService) UpdateSubmodule(pid interface{}, submodule string, opt *UpdateSubmoduleOptions, options ...RequestOptionFunc) (*SubmoduleCommit, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
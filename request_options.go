@@ -29,7 +29,15 @@ type RequestOptionFunc func(*retryablehttp.Request) error
 // WithContext runs the request with the provided context
 func WithContext(ctx context.Context) RequestOptionFunc {
 	return func(req *retryablehttp.Request) error {
-		*req = *req.WithContext(ctx)
+		// Keep the values set by other request options when replacing the
+		// context.
+		reqCtx := ctx
+		for _, key := range []interface{}{retryPolicyContextKey{}, retryBudgetContextKey{}, progressContextKey{}} {
+			if v := req.Context().Value(key); v != nil {
+				reqCtx = context.WithValue(reqCtx, key, v)
+			}
+		}
+		*req = *req.WithContext(reqCtx)
 		return nil
 	}
 }
@@ -74,6 +82,15 @@ func WithKeysetPaginationParameters(nextLink string) RequestOptionFunc {
 	}
 }
 
+// WithRequestRetry overrides the retry policy of the client for a single
+// request.
+func WithRequestRetry(policy RetryPolicy) RequestOptionFunc {
+	return func(req *retryablehttp.Request) error {
+		*req = *req.WithContext(context.WithValue(req.Context(), retryPolicyContextKey{}, &policy))
+		return nil
+	}
+}
+
 // WithSudo takes either a username or user ID and sets the SUDO request header.
 func WithSudo(uid interface{}) RequestOptionFunc {
 	return func(req *retryablehttp.Request) error {
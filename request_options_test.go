@@ -14,6 +14,7 @@
 package gitlab
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"testing"
@@ -182,3 +183,46 @@ func TestWithKeysetPaginationParameters(t *testing.T) {
 	// Ensure cursor gets properly pulled from "next link" header
 	assert.Equal(t, "eyJuYW1lIjoiRmxpZ2h0anMiLCJpZCI6IjI2IiwiX2tkIjoibiJ9", values.Get("cursor"))
 }
+
+func TestWithRequestRetry(t *testing.T) {
+	mux, client := setup(t)
+
+	attempts := 0
+	mux.HandleFunc("/api/v4/retry", func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.WriteHeader(http.StatusBadGateway)
+	})
+
+	// By default a POST request is not retried on a server error.
+	req, err := client.NewRequest(http.MethodPost, "retry", nil, []RequestOptionFunc{
+		WithRequestRetry(RetryPolicy{MaxAttempts: 3}),
+	})
+	assert.NoError(t, err)
+
+	_, err = client.Do(req, nil)
+	assert.Error(t, err)
+	assert.Equal(t, 1, attempts)
+
+	// Unless the policy allows retrying non-idempotent requests.
+	attempts = 0
+	req, err = client.NewRequest(http.MethodPost, "retry", nil, []RequestOptionFunc{
+		WithRequestRetry(RetryPolicy{MaxAttempts: 3, RetryNonIdempotent: true}),
+		WithContext(context.Background()),
+	})
+	assert.NoError(t, err)
+
+	_, err = client.Do(req, nil)
+	assert.Error(t, err)
+	assert.Equal(t, 3, attempts)
+
+	// Only the configured status codes are retried.
+	attempts = 0
+	req, err = client.NewRequest(http.MethodGet, "retry", nil, []RequestOptionFunc{
+		WithRequestRetry(RetryPolicy{MaxAttempts: 3, RetryOn: []int{http.StatusServiceUnavailable}}),
+	})
+	assert.NoError(t, err)
+
+	_, err = client.Do(req, nil)
+	assert.Error(t, err)
+	assert.Equal(t, 1, attempts)
+}
@@ -19,6 +19,7 @@ package gitlab
 import (
 	"fmt"
 	"net/http"
+	"net/url"
 	"time"
 )
 
@@ -57,7 +58,7 @@ func (rg ResourceGroup) String() string {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/resource_groups.html#get-all-resource-groups-for-a-project
 func (s *ResourceGroupService) GetAllResourceGroupsForAProject(pid interface{}, options ...RequestOptionFunc) ([]*ResourceGroup, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -83,11 +84,11 @@ func (s *ResourceGroupService) GetAllResourceGroupsForAProject(pid interface{},
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/resource_groups.html#get-a-specific-resource-group
 func (s *ResourceGroupService) GetASpecificResourceGroup(pid interface{}, key string, options ...RequestOptionFunc) (*ResourceGroup, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("projects/%s/resource_groups/%s", PathEscape(project), key)
+	u := fmt.Sprintf("projects/%s/resource_groups/%s", PathEscape(project), url.PathEscape(key))
 
 	req, err := s.client.NewRequest(http.MethodGet, u, nil, options)
 	if err != nil {
@@ -109,11 +110,11 @@ func (s *ResourceGroupService) GetASpecificResourceGroup(pid interface{}, key st
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/resource_groups.html#list-upcoming-jobs-for-a-specific-resource-group
 func (s *ResourceGroupService) ListUpcomingJobsForASpecificResourceGroup(pid interface{}, key string, options ...RequestOptionFunc) ([]*Job, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("projects/%s/resource_groups/%s/upcoming_jobs", PathEscape(project), key)
+	u := fmt.Sprintf("projects/%s/resource_groups/%s/upcoming_jobs", PathEscape(project), url.PathEscape(key))
 
 	req, err := s.client.NewRequest(http.MethodGet, u, nil, options)
 	if err != nil {
@@ -144,11 +145,11 @@ type EditAnExistingResourceGroupOptions struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/resource_groups.html#edit-an-existing-resource-group
 func (s *ResourceGroupService) EditAnExistingResourceGroup(pid interface{}, key string, opts *EditAnExistingResourceGroupOptions, options ...RequestOptionFunc) (*ResourceGroup, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("projects/%s/resource_groups/%s", PathEscape(project), key)
+	u := fmt.Sprintf("projects/%s/resource_groups/%s", PathEscape(project), url.PathEscape(key))
 
 	req, err := s.client.NewRequest(http.MethodPut, u, opts, options)
 	if err != nil {
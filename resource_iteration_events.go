@@ -76,7 +76,7 @@ type ListIterationEventsOptions struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/resource_iteration_events.html#list-project-issue-iteration-events
 func (s *ResourceIterationEventsService) ListIssueIterationEvents(pid interface{}, issue int, opt *ListIterationEventsOptions, options ...RequestOptionFunc) ([]*IterationEvent, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -101,7 +101,7 @@ func (s *ResourceIterationEventsService) ListIssueIterationEvents(pid interface{
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/resource_iteration_events.html#get-single-issue-iteration-event
 func (s *ResourceIterationEventsService) GetIssueIterationEvent(pid interface{}, issue int, event int, options ...RequestOptionFunc) (*IterationEvent, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -58,7 +58,7 @@ type ListMilestoneEventsOptions struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/resource_milestone_events.html#list-project-issue-milestone-events
 func (s *ResourceMilestoneEventsService) ListIssueMilestoneEvents(pid interface{}, issue int, opt *ListMilestoneEventsOptions, options ...RequestOptionFunc) ([]*MilestoneEvent, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -83,7 +83,7 @@ func (s *ResourceMilestoneEventsService) ListIssueMilestoneEvents(pid interface{
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/resource_milestone_events.html#get-single-issue-milestone-event
 func (s *ResourceMilestoneEventsService) GetIssueMilestoneEvent(pid interface{}, issue int, event int, options ...RequestOptionFunc) (*MilestoneEvent, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -109,7 +109,7 @@ func (s *ResourceMilestoneEventsService) GetIssueMilestoneEvent(pid interface{},
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/resource_milestone_events.html#list-project-merge-request-milestone-events
 func (s *ResourceMilestoneEventsService) ListMergeMilestoneEvents(pid interface{}, request int, opt *ListMilestoneEventsOptions, options ...RequestOptionFunc) ([]*MilestoneEvent, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -134,7 +134,7 @@ func (s *ResourceMilestoneEventsService) ListMergeMilestoneEvents(pid interface{
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/resource_milestone_events.html#get-single-merge-request-milestone-event
 func (s *ResourceMilestoneEventsService) GetMergeRequestMilestoneEvent(pid interface{}, request int, event int, options ...RequestOptionFunc) (*MilestoneEvent, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -57,7 +57,7 @@ type ListStateEventsOptions struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/resource_state_events.html#list-project-issue-state-events
 func (s *ResourceStateEventsService) ListIssueStateEvents(pid interface{}, issue int, opt *ListStateEventsOptions, options ...RequestOptionFunc) ([]*StateEvent, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -82,7 +82,7 @@ func (s *ResourceStateEventsService) ListIssueStateEvents(pid interface{}, issue
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/resource_state_events.html#get-single-issue-state-event
 func (s *ResourceStateEventsService) GetIssueStateEvent(pid interface{}, issue int, event int, options ...RequestOptionFunc) (*StateEvent, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -108,7 +108,7 @@ func (s *ResourceStateEventsService) GetIssueStateEvent(pid interface{}, issue i
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/resource_state_events.html#list-project-merge-request-state-events
 func (s *ResourceStateEventsService) ListMergeStateEvents(pid interface{}, request int, opt *ListStateEventsOptions, options ...RequestOptionFunc) ([]*StateEvent, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -133,7 +133,7 @@ func (s *ResourceStateEventsService) ListMergeStateEvents(pid interface{}, reque
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/resource_state_events.html#get-single-merge-request-state-event
 func (s *ResourceStateEventsService) GetMergeRequestStateEvent(pid interface{}, request int, event int, options ...RequestOptionFunc) (*StateEvent, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -59,7 +59,7 @@ type ListWeightEventsOptions struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/resource_weight_events.html#list-project-issue-weight-events
 func (s *ResourceWeightEventsService) ListIssueWeightEvents(pid interface{}, issue int, opt *ListWeightEventsOptions, options ...RequestOptionFunc) ([]*WeightEvent, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -0,0 +1,96 @@
+package gitlab
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/go-retryablehttp"
+)
+
+// RetryBudget caps the retries of a logical operation which can consist of
+// multiple requests, like listing all pages of a resource. Once the budget
+// is used up, failed requests are no longer retried. A budget can be shared
+// by requests running in multiple goroutines.
+type RetryBudget struct {
+	// MaxRetries is the maximum number of retries of all requests together.
+	// Zero means no limit.
+	MaxRetries int
+
+	// MaxWait is the maximum total time spent waiting before retries. Waits
+	// are shortened to fit in the remaining budget. Zero means no limit.
+	MaxWait time.Duration
+
+	mu      sync.Mutex
+	retries int
+	waited  time.Duration
+}
+
+// Retries returns the number of retries made using the budget.
+func (b *RetryBudget) Retries() int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.retries
+}
+
+// Waited returns the total time waited before retries made using the
+// budget.
+func (b *RetryBudget) Waited() time.Duration {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.waited
+}
+
+// exhausted reports whether no retries are left in the budget.
+func (b *RetryBudget) exhausted() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return (b.MaxRetries > 0 && b.retries >= b.MaxRetries) ||
+		(b.MaxWait > 0 && b.waited >= b.MaxWait)
+}
+
+// spend records a retry after the given wait, and returns the wait shortened
+// to the remaining budget.
+func (b *RetryBudget) spend(wait time.Duration) time.Duration {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.MaxWait > 0 && b.waited+wait > b.MaxWait {
+		wait = b.MaxWait - b.waited
+	}
+	b.retries++
+	b.waited += wait
+
+	return wait
+}
+
+type retryBudgetContextKey struct{}
+
+// WithRetryBudget makes the retries of a request count against the given
+// budget. Pass the same budget to all requests of a logical operation.
+func WithRetryBudget(b *RetryBudget) RequestOptionFunc {
+	return func(req *retryablehttp.Request) error {
+		*req = *req.WithContext(context.WithValue(req.Context(), retryBudgetContextKey{}, b))
+		return nil
+	}
+}
+
+func retryBudgetFromContext(ctx context.Context) *RetryBudget {
+	b, _ := ctx.Value(retryBudgetContextKey{}).(*RetryBudget)
+	return b
+}
+
+// withRetryBudgetBackoff returns a backoff which shortens the waits of the
+// given backoff to fit in the retry budget of a request, and records them.
+func withRetryBudgetBackoff(backoff retryablehttp.Backoff) retryablehttp.Backoff {
+	return func(min, max time.Duration, attemptNum int, resp *http.Response) time.Duration {
+		wait := backoff(min, max, attemptNum, resp)
+		if resp != nil && resp.Request != nil {
+			if budget := retryBudgetFromContext(resp.Request.Context()); budget != nil {
+				wait = budget.spend(wait)
+			}
+		}
+		return wait
+	}
+}
@@ -0,0 +1,55 @@
+package gitlab
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithRetryBudget(t *testing.T) {
+	unavailable := Fault{StatusCode: http.StatusServiceUnavailable}
+	plan := NewFaultPlan(unavailable, unavailable, unavailable, Fault{}, unavailable)
+	mux, client := newFaultTestClient(t, plan)
+
+	mux.HandleFunc("/api/v4/projects/1", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"id": 1}`)
+	})
+
+	budget := &RetryBudget{MaxRetries: 3}
+
+	// The first request uses the whole budget and succeeds after 3 retries.
+	_, _, err := client.Projects.GetProject(1, nil, WithRetryBudget(budget))
+	require.NoError(t, err)
+	require.Equal(t, 3, budget.Retries())
+	require.Equal(t, 30*time.Millisecond, budget.Waited())
+
+	// The next request of the same operation is no longer retried.
+	_, resp, err := client.Projects.GetProject(1, nil, WithRetryBudget(budget))
+	require.Error(t, err)
+	require.Equal(t, http.StatusServiceUnavailable, resp.StatusCode)
+	require.Equal(t, 3, budget.Retries())
+	require.Equal(t, 0, plan.Remaining())
+}
+
+func TestWithRetryBudgetMaxWait(t *testing.T) {
+	plan := NewFaultPlan(
+		Fault{StatusCode: http.StatusServiceUnavailable},
+		Fault{StatusCode: http.StatusServiceUnavailable},
+		Fault{StatusCode: http.StatusServiceUnavailable},
+	)
+	_, client := newFaultTestClient(t, plan)
+
+	budget := &RetryBudget{MaxWait: 15 * time.Millisecond}
+	_, _, err := client.Projects.GetProject(1, nil, WithContext(context.Background()), WithRetryBudget(budget))
+	require.Error(t, err)
+
+	// The second wait is shortened to the remaining budget, after which
+	// the budget is exhausted.
+	require.Equal(t, 2, budget.Retries())
+	require.Equal(t, 15*time.Millisecond, budget.Waited())
+	require.Equal(t, 0, plan.Remaining())
+}
@@ -0,0 +1,90 @@
+package gitlab
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// RubyGemsPackagesService handles communication with the RubyGems package
+// registry related methods of the GitLab API.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/packages/rubygems.html
+type RubyGemsPackagesService struct {
+	client *Client
+}
+
+// RubyGemDependency represents a version of a gem and its runtime
+// dependencies.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/packages/rubygems.html#fetch-a-list-of-dependencies
+type RubyGemDependency struct {
+	Name         string     `json:"name"`
+	Number       string     `json:"number"`
+	Platform     string     `json:"platform"`
+	Dependencies [][]string `json:"dependencies"`
+}
+
+func (d RubyGemDependency) String() string {
+	return Stringify(d)
+}
+
+// ListRubyGemDependenciesOptions represents the available ListDependencies()
+// options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/packages/rubygems.html#fetch-a-list-of-dependencies
+type ListRubyGemDependenciesOptions struct {
+	Gems *[]string `url:"gems,comma,omitempty" json:"gems,omitempty"`
+}
+
+// ListDependencies gets the versions of the given gems in the registry of a
+// project, including their runtime dependencies.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/packages/rubygems.html#fetch-a-list-of-dependencies
+func (s *RubyGemsPackagesService) ListDependencies(pid interface{}, opt *ListRubyGemDependenciesOptions, options ...RequestOptionFunc) ([]*RubyGemDependency, *Response, error) {
+	project, err := parseProjectID(pid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("projects/%s/packages/rubygems/api/v1/dependencies", PathEscape(project))
+
+	req, err := s.client.NewRequest(http.MethodGet, u, opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var d []*RubyGemDependency
+	resp, err := s.client.Do(req, &d)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return d, resp, nil
+}
+
+// PublishPackage uploads a gem, as built by "gem build", to the registry of
+// a project.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/packages/rubygems.html#upload-a-gem
+func (s *RubyGemsPackagesService) PublishPackage(pid interface{}, content io.Reader, options ...RequestOptionFunc) (*Response, error) {
+	project, err := parseProjectID(pid)
+	if err != nil {
+		return nil, err
+	}
+	u := fmt.Sprintf("projects/%s/packages/rubygems/api/v1/gems", PathEscape(project))
+
+	req, err := s.client.NewRequest(http.MethodPost, u, nil, options)
+	if err != nil {
+		return nil, err
+	}
+	if err := req.SetBody(content); err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/octet-stream")
+
+	return s.client.Do(req, nil)
+}
@@ -0,0 +1,50 @@
+package gitlab
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRubyGemsPackagesService_ListDependencies(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/packages/rubygems/api/v1/dependencies", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		require.Equal(t, "my_gem,other_gem", r.URL.Query().Get("gems"))
+		fmt.Fprint(w, `[{"name": "my_gem", "number": "1.0.0", "platform": "ruby", "dependencies": [["rake", ">= 0"]]}]`)
+	})
+
+	deps, _, err := client.RubyGemsPackages.ListDependencies(1, &ListRubyGemDependenciesOptions{
+		Gems: Ptr([]string{"my_gem", "other_gem"}),
+	})
+	require.NoError(t, err)
+	require.Equal(t, []*RubyGemDependency{{
+		Name:         "my_gem",
+		Number:       "1.0.0",
+		Platform:     "ruby",
+		Dependencies: [][]string{{"rake", ">= 0"}},
+	}}, deps)
+}
+
+func TestRubyGemsPackagesService_PublishPackage(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/packages/rubygems/api/v1/gems", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		require.Equal(t, "application/octet-stream", r.Header.Get("Content-Type"))
+
+		b, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		require.Equal(t, "gem content", string(b))
+
+		w.WriteHeader(http.StatusCreated)
+	})
+
+	_, err := client.RubyGemsPackages.PublishPackage(1, strings.NewReader("gem content"))
+	require.NoError(t, err)
+}
@@ -0,0 +1,363 @@
+// Package runner implements a minimal client for the API used by GitLab
+// Runner to request jobs and report their progress. It can be used to build
+// custom runners and schedulers.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/runners.html
+package runner
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	gitlab "github.com/xanzy/go-gitlab"
+)
+
+// Client requests jobs and reports their progress on behalf of a single
+// runner.
+type Client struct {
+	client *gitlab.Client
+	token  string
+}
+
+// NewClient returns a client for the runner with the given authentication
+// token, using the base URL and settings of the given GitLab client.
+func NewClient(client *gitlab.Client, token string) *Client {
+	return &Client{client: client, token: token}
+}
+
+// Info describes the runner when requesting a job.
+type Info struct {
+	Name         string    `json:"name,omitempty"`
+	Version      string    `json:"version,omitempty"`
+	Revision     string    `json:"revision,omitempty"`
+	Platform     string    `json:"platform,omitempty"`
+	Architecture string    `json:"architecture,omitempty"`
+	Executor     string    `json:"executor,omitempty"`
+	Shell        string    `json:"shell,omitempty"`
+	Features     *Features `json:"features,omitempty"`
+}
+
+// Features lists the features supported by the runner. GitLab only hands out
+// jobs which can be run with the supported features.
+type Features struct {
+	Variables               bool `json:"variables"`
+	Image                   bool `json:"image"`
+	Services                bool `json:"services"`
+	Artifacts               bool `json:"artifacts"`
+	Cache                   bool `json:"cache"`
+	Shared                  bool `json:"shared"`
+	UploadMultipleArtifacts bool `json:"upload_multiple_artifacts"`
+	UploadRawArtifacts      bool `json:"upload_raw_artifacts"`
+	Session                 bool `json:"session"`
+	Terminal                bool `json:"terminal"`
+	Refspecs                bool `json:"refspecs"`
+	Masking                 bool `json:"masking"`
+	Proxy                   bool `json:"proxy"`
+	RawVariables            bool `json:"raw_variables"`
+	ArtifactsExclude        bool `json:"artifacts_exclude"`
+	MultiBuildSteps         bool `json:"multi_build_steps"`
+	TraceReset              bool `json:"trace_reset"`
+	TraceChecksum           bool `json:"trace_checksum"`
+	TraceSize               bool `json:"trace_size"`
+	VaultSecrets            bool `json:"vault_secrets"`
+	Cancelable              bool `json:"cancelable"`
+	ReturnExitCode          bool `json:"return_exit_code"`
+}
+
+// RequestJobOptions represents the available RequestJob() options.
+type RequestJobOptions struct {
+	Info *Info `json:"info,omitempty"`
+
+	// LastUpdate is the value of the LastUpdate field of the previous
+	// RequestJobResult. It allows GitLab to answer quickly when nothing
+	// changed since the previous request.
+	LastUpdate string `json:"last_update,omitempty"`
+
+	// SystemID identifies the machine the runner is running on.
+	SystemID string `json:"system_id,omitempty"`
+}
+
+// Job represents a job handed out to a runner.
+type Job struct {
+	ID            int           `json:"id"`
+	Token         string        `json:"token"`
+	AllowGitFetch bool          `json:"allow_git_fetch"`
+	JobInfo       JobInfo       `json:"job_info"`
+	GitInfo       GitInfo       `json:"git_info"`
+	RunnerInfo    RunnerInfo    `json:"runner_info"`
+	Variables     []*Variable   `json:"variables"`
+	Steps         []*Step       `json:"steps"`
+	Image         *Image        `json:"image"`
+	Services      []*Image      `json:"services"`
+	Artifacts     []*Artifact   `json:"artifacts"`
+	Cache         []*Cache      `json:"cache"`
+	Credentials   []*Credential `json:"credentials"`
+	Dependencies  []*Dependency `json:"dependencies"`
+}
+
+// JobInfo contains general information about a job.
+type JobInfo struct {
+	ID          int    `json:"id"`
+	Name        string `json:"name"`
+	Stage       string `json:"stage"`
+	ProjectID   int    `json:"project_id"`
+	ProjectName string `json:"project_name"`
+}
+
+// GitInfo describes the revision of the repository a job runs for.
+type GitInfo struct {
+	RepoURL   string   `json:"repo_url"`
+	Ref       string   `json:"ref"`
+	SHA       string   `json:"sha"`
+	BeforeSHA string   `json:"before_sha"`
+	RefType   string   `json:"ref_type"`
+	Refspecs  []string `json:"refspecs"`
+	Depth     int      `json:"depth"`
+}
+
+// RunnerInfo contains the settings of the runner for a job.
+type RunnerInfo struct {
+	Timeout          int    `json:"timeout"`
+	RunnerSessionURL string `json:"runner_session_url"`
+}
+
+// Variable represents a CI/CD variable of a job.
+type Variable struct {
+	Key    string `json:"key"`
+	Value  string `json:"value"`
+	Public bool   `json:"public"`
+	Masked bool   `json:"masked"`
+	File   bool   `json:"file"`
+	Raw    bool   `json:"raw"`
+}
+
+// Step represents a step of a job, like the script or the after_script.
+type Step struct {
+	Name         string   `json:"name"`
+	Script       []string `json:"script"`
+	Timeout      int      `json:"timeout"`
+	When         string   `json:"when"`
+	AllowFailure bool     `json:"allow_failure"`
+}
+
+// Image represents the image or a service of a job.
+type Image struct {
+	Name       string   `json:"name"`
+	Alias      string   `json:"alias"`
+	Entrypoint []string `json:"entrypoint"`
+	Command    []string `json:"command"`
+}
+
+// Artifact represents the artifacts configuration of a job.
+type Artifact struct {
+	Name         string   `json:"name"`
+	Untracked    bool     `json:"untracked"`
+	Paths        []string `json:"paths"`
+	Exclude      []string `json:"exclude"`
+	When         string   `json:"when"`
+	ArtifactType string   `json:"artifact_type"`
+	Format       string   `json:"artifact_format"`
+	ExpireIn     string   `json:"expire_in"`
+}
+
+// Cache represents the cache configuration of a job.
+type Cache struct {
+	Key       string   `json:"key"`
+	Untracked bool     `json:"untracked"`
+	Policy    string   `json:"policy"`
+	Paths     []string `json:"paths"`
+	When      string   `json:"when"`
+}
+
+// Credential represents credentials a job can use, like the credentials of
+// the container registry.
+type Credential struct {
+	Type     string `json:"type"`
+	URL      string `json:"url"`
+	Username string `json:"username"`
+	Password string `json:"password"`
+}
+
+// Dependency represents a job whose artifacts are needed by a job.
+type Dependency struct {
+	ID            int    `json:"id"`
+	Token         string `json:"token"`
+	Name          string `json:"name"`
+	ArtifactsFile struct {
+		Filename string `json:"filename"`
+		Size     int    `json:"size"`
+	} `json:"artifacts_file"`
+}
+
+// RequestJobResult represents the result of a job request.
+type RequestJobResult struct {
+	// Job is nil if there is no job for the runner.
+	Job *Job
+
+	// LastUpdate should be passed to the next job request.
+	LastUpdate string
+}
+
+// RequestJob asks GitLab for a job to run. If there is no job for the
+// runner, the returned result has no job.
+//
+// GitLab API docs:
+// https://gitlab.com/gitlab-org/gitlab/-/blob/master/lib/api/ci/runner.rb
+func (c *Client) RequestJob(opt *RequestJobOptions, options ...gitlab.RequestOptionFunc) (*RequestJobResult, *gitlab.Response, error) {
+	body := struct {
+		*RequestJobOptions
+		Token string `json:"token"`
+	}{opt, c.token}
+	if opt == nil {
+		body.RequestJobOptions = new(RequestJobOptions)
+	}
+
+	req, err := c.client.NewRequest(http.MethodPost, "jobs/request", body, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var buf bytes.Buffer
+	resp, err := c.client.Do(req, &buf)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	r := &RequestJobResult{LastUpdate: resp.Header.Get("X-GitLab-Last-Update")}
+	if resp.StatusCode == http.StatusNoContent || buf.Len() == 0 {
+		return r, resp, nil
+	}
+
+	r.Job = new(Job)
+	if err := json.Unmarshal(buf.Bytes(), r.Job); err != nil {
+		return nil, resp, err
+	}
+
+	return r, resp, nil
+}
+
+// JobState represents the state of a job reported by a runner.
+type JobState string
+
+// List of available job states.
+const (
+	JobRunning JobState = "running"
+	JobSuccess JobState = "success"
+	JobFailed  JobState = "failed"
+)
+
+// UpdateJobOptions represents the available UpdateJob() options.
+type UpdateJobOptions struct {
+	State         JobState    `json:"state,omitempty"`
+	FailureReason string      `json:"failure_reason,omitempty"`
+	ExitCode      *int        `json:"exit_code,omitempty"`
+	Checksum      string      `json:"checksum,omitempty"`
+	Output        *TraceState `json:"output,omitempty"`
+}
+
+// TraceState describes the trace sent for a job so far, so GitLab can
+// verify it received the complete trace.
+type TraceState struct {
+	Checksum string `json:"checksum,omitempty"`
+	Bytesize int    `json:"bytesize,omitempty"`
+}
+
+// UpdateJobResult represents the result of a job update or trace patch.
+type UpdateJobResult struct {
+	// JobStatus is the status of the job according to GitLab. It is
+	// "canceled" when the job was canceled while it was running.
+	JobStatus string
+
+	// UpdateInterval is the interval GitLab would like to receive updates
+	// in, if it was returned.
+	UpdateInterval time.Duration
+}
+
+func newUpdateJobResult(resp *gitlab.Response) *UpdateJobResult {
+	r := &UpdateJobResult{JobStatus: resp.Header.Get("Job-Status")}
+	if v, err := strconv.Atoi(resp.Header.Get("X-GitLab-Trace-Update-Interval")); err == nil {
+		r.UpdateInterval = time.Duration(v) * time.Second
+	}
+	return r
+}
+
+// UpdateJob updates the state of a job, using the token of the job.
+//
+// GitLab API docs:
+// https://gitlab.com/gitlab-org/gitlab/-/blob/master/lib/api/ci/runner.rb
+func (c *Client) UpdateJob(id int, jobToken string, opt *UpdateJobOptions, options ...gitlab.RequestOptionFunc) (*UpdateJobResult, *gitlab.Response, error) {
+	body := struct {
+		*UpdateJobOptions
+		Token string `json:"token"`
+	}{opt, jobToken}
+	if opt == nil {
+		body.UpdateJobOptions = new(UpdateJobOptions)
+	}
+
+	u := fmt.Sprintf("jobs/%d", id)
+
+	req, err := c.client.NewRequest(http.MethodPut, u, body, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	resp, err := c.client.Do(req, nil)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return newUpdateJobResult(resp), resp, nil
+}
+
+// PatchTraceResult represents the result of a trace patch.
+type PatchTraceResult struct {
+	UpdateJobResult
+
+	// Offset is the number of bytes of the trace GitLab has received. The
+	// next patch must start at this offset.
+	Offset int
+}
+
+// PatchTrace appends a part of the trace of a job, starting at the given
+// offset. When the offset does not match the size of the trace GitLab has
+// received so far, GitLab responds with 416 and the result contains the
+// offset to continue from.
+//
+// GitLab API docs:
+// https://gitlab.com/gitlab-org/gitlab/-/blob/master/lib/api/ci/runner.rb
+func (c *Client) PatchTrace(id int, jobToken string, content []byte, offset int, options ...gitlab.RequestOptionFunc) (*PatchTraceResult, *gitlab.Response, error) {
+	u := fmt.Sprintf("jobs/%d/trace", id)
+
+	req, err := c.client.NewRequest(http.MethodPatch, u, nil, options)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := req.SetBody(content); err != nil {
+		return nil, nil, err
+	}
+	req.Header.Set("Content-Type", "text/plain")
+	req.Header.Set("Content-Range", fmt.Sprintf("%d-%d", offset, offset+len(content)-1))
+	req.Header.Set("JOB-TOKEN", jobToken)
+
+	resp, err := c.client.Do(req, nil)
+	if resp == nil {
+		return nil, nil, err
+	}
+
+	r := &PatchTraceResult{UpdateJobResult: *newUpdateJobResult(resp)}
+	if _, end, ok := strings.Cut(resp.Header.Get("Range"), "-"); ok {
+		if n, cerr := strconv.Atoi(end); cerr == nil {
+			r.Offset = n
+		}
+	}
+	if err != nil {
+		return r, resp, err
+	}
+
+	return r, resp, nil
+}
@@ -0,0 +1,129 @@
+package runner
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gitlab "github.com/xanzy/go-gitlab"
+)
+
+func setup(t *testing.T) (*http.ServeMux, *Client) {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	client, err := gitlab.NewClient("", gitlab.WithBaseURL(server.URL), gitlab.WithoutRetries())
+	require.NoError(t, err)
+
+	return mux, NewClient(client, "runner-token")
+}
+
+func TestRequestJob(t *testing.T) {
+	mux, client := setup(t)
+
+	requests := 0
+	mux.HandleFunc("/api/v4/jobs/request", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+		requests++
+
+		var body map[string]interface{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, "runner-token", body["token"])
+
+		if requests == 1 {
+			assert.Equal(t, "custom", body["info"].(map[string]interface{})["executor"])
+			w.Header().Set("X-GitLab-Last-Update", "abc")
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+
+		assert.Equal(t, "abc", body["last_update"])
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{
+			"id": 1,
+			"token": "job-token",
+			"job_info": {"name": "test", "stage": "test", "project_id": 2},
+			"git_info": {"repo_url": "https://gitlab.example.com/group/project.git", "sha": "abc123"},
+			"steps": [{"name": "script", "script": ["make test"], "timeout": 3600, "when": "on_success"}],
+			"variables": [{"key": "CI", "value": "true", "public": true}]
+		}`)
+	})
+
+	r, _, err := client.RequestJob(&RequestJobOptions{Info: &Info{Name: "my-runner", Executor: "custom"}})
+	require.NoError(t, err)
+	assert.Nil(t, r.Job)
+	assert.Equal(t, "abc", r.LastUpdate)
+
+	r, _, err = client.RequestJob(&RequestJobOptions{LastUpdate: r.LastUpdate})
+	require.NoError(t, err)
+	require.NotNil(t, r.Job)
+	assert.Equal(t, 1, r.Job.ID)
+	assert.Equal(t, "job-token", r.Job.Token)
+	assert.Equal(t, 2, r.Job.JobInfo.ProjectID)
+	assert.Equal(t, []string{"make test"}, r.Job.Steps[0].Script)
+	assert.Equal(t, "CI", r.Job.Variables[0].Key)
+}
+
+func TestUpdateJob(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/jobs/1", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPut, r.Method)
+
+		b, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"token": "job-token", "state": "failed", "failure_reason": "script_failure", "exit_code": 1}`, string(b))
+
+		w.Header().Set("Job-Status", "failed")
+		w.Header().Set("X-GitLab-Trace-Update-Interval", "30")
+	})
+
+	r, _, err := client.UpdateJob(1, "job-token", &UpdateJobOptions{
+		State:         JobFailed,
+		FailureReason: "script_failure",
+		ExitCode:      gitlab.Ptr(1),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "failed", r.JobStatus)
+	assert.Equal(t, 30*time.Second, r.UpdateInterval)
+}
+
+func TestPatchTrace(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/jobs/1/trace", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPatch, r.Method)
+		assert.Equal(t, "job-token", r.Header.Get("JOB-TOKEN"))
+
+		if r.Header.Get("Content-Range") != "5-10" {
+			w.Header().Set("Range", "0-5")
+			w.WriteHeader(http.StatusRequestedRangeNotSatisfiable)
+			return
+		}
+
+		b, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		assert.Equal(t, "output", string(b))
+
+		w.Header().Set("Range", "0-11")
+		w.Header().Set("Job-Status", "running")
+		w.WriteHeader(http.StatusAccepted)
+	})
+
+	r, _, err := client.PatchTrace(1, "job-token", []byte("output"), 5)
+	require.NoError(t, err)
+	assert.Equal(t, 11, r.Offset)
+	assert.Equal(t, "running", r.JobStatus)
+
+	r, resp, err := client.PatchTrace(1, "job-token", []byte("output"), 0)
+	require.Error(t, err)
+	assert.Equal(t, http.StatusRequestedRangeNotSatisfiable, resp.StatusCode)
+	assert.Equal(t, 5, r.Offset)
+}
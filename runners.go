@@ -153,7 +153,7 @@ func (s *RunnersService) GetRunnerDetails(rid interface{}, options ...RequestOpt
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("runners/%s", runner)
+	u := fmt.Sprintf("runners/%s", PathEscape(runner))
 
 	req, err := s.client.NewRequest(http.MethodGet, u, nil, options)
 	if err != nil {
@@ -196,7 +196,7 @@ func (s *RunnersService) UpdateRunnerDetails(rid interface{}, opt *UpdateRunnerD
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("runners/%s", runner)
+	u := fmt.Sprintf("runners/%s", PathEscape(runner))
 
 	req, err := s.client.NewRequest(http.MethodPut, u, opt, options)
 	if err != nil {
@@ -221,7 +221,7 @@ func (s *RunnersService) RemoveRunner(rid interface{}, options ...RequestOptionF
 	if err != nil {
 		return nil, err
 	}
-	u := fmt.Sprintf("runners/%s", runner)
+	u := fmt.Sprintf("runners/%s", PathEscape(runner))
 
 	req, err := s.client.NewRequest(http.MethodDelete, u, nil, options)
 	if err != nil {
@@ -252,7 +252,7 @@ func (s *RunnersService) ListRunnerJobs(rid interface{}, opt *ListRunnerJobsOpti
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("runners/%s/jobs", runner)
+	u := fmt.Sprintf("runners/%s/jobs", PathEscape(runner))
 
 	req, err := s.client.NewRequest(http.MethodGet, u, opt, options)
 	if err != nil {
@@ -280,7 +280,7 @@ type ListProjectRunnersOptions ListRunnersOptions
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/runners.html#list-projects-runners
 func (s *RunnersService) ListProjectRunners(pid interface{}, opt *ListProjectRunnersOptions, options ...RequestOptionFunc) ([]*Runner, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -314,7 +314,7 @@ type EnableProjectRunnerOptions struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/runners.html#enable-a-runner-in-project
 func (s *RunnersService) EnableProjectRunner(pid interface{}, opt *EnableProjectRunnerOptions, options ...RequestOptionFunc) (*Runner, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -339,7 +339,7 @@ func (s *RunnersService) EnableProjectRunner(pid interface{}, opt *EnableProject
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/runners.html#disable-a-runner-from-project
 func (s *RunnersService) DisableProjectRunner(pid interface{}, runner int, options ...RequestOptionFunc) (*Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, err
 	}
@@ -371,7 +371,7 @@ type ListGroupsRunnersOptions struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/runners.html#list-groups-runners
 func (s *RunnersService) ListGroupsRunners(gid interface{}, opt *ListGroupsRunnersOptions, options ...RequestOptionFunc) ([]*Runner, *Response, error) {
-	group, err := parseID(gid)
+	group, err := parseGroupID(gid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -499,6 +499,10 @@ func (s *RunnersService) VerifyRegisteredRunner(opt *VerifyRegisteredRunnerOptio
 	return s.client.Do(req, nil)
 }
 
+// RunnerRegistrationToken represents a runner registration token.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/runners.html#reset-instances-runner-registration-token
 type RunnerRegistrationToken struct {
 	Token          *string    `url:"token" json:"token"`
 	TokenExpiresAt *time.Time `url:"token_expires_at" json:"token_expires_at"`
@@ -529,7 +533,7 @@ func (s *RunnersService) ResetInstanceRunnerRegistrationToken(options ...Request
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/runners.html#reset-groups-runner-registration-token
 func (s *RunnersService) ResetGroupRunnerRegistrationToken(gid interface{}, options ...RequestOptionFunc) (*RunnerRegistrationToken, *Response, error) {
-	group, err := parseID(gid)
+	group, err := parseGroupID(gid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -549,12 +553,13 @@ func (s *RunnersService) ResetGroupRunnerRegistrationToken(gid interface{}, opti
 	return r, resp, nil
 }
 
-// ResetGroupRunnerRegistrationToken resets a projects's runner registration token.
+// ResetProjectRunnerRegistrationToken resets a projects's runner registration
+// token.
 //
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/runners.html#reset-projects-runner-registration-token
 func (s *RunnersService) ResetProjectRunnerRegistrationToken(pid interface{}, options ...RequestOptionFunc) (*RunnerRegistrationToken, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -573,6 +578,10 @@ func (s *RunnersService) ResetProjectRunnerRegistrationToken(pid interface{}, op
 	return r, resp, nil
 }
 
+// RunnerAuthenticationToken represents a runner authentication token.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/runners.html#reset-runners-authentication-token-by-using-the-runner-id
 type RunnerAuthenticationToken struct {
 	Token          *string    `url:"token" json:"token"`
 	TokenExpiresAt *time.Time `url:"token_expires_at" json:"token_expires_at"`
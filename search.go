@@ -325,7 +325,7 @@ func (s *SearchService) search(scope, query string, result interface{}, opt *Sea
 }
 
 func (s *SearchService) searchByGroup(gid interface{}, scope, query string, result interface{}, opt *SearchOptions, options ...RequestOptionFunc) (*Response, error) {
-	group, err := parseID(gid)
+	group, err := parseGroupID(gid)
 	if err != nil {
 		return nil, err
 	}
@@ -342,7 +342,7 @@ func (s *SearchService) searchByGroup(gid interface{}, scope, query string, resu
 }
 
 func (s *SearchService) searchByProject(pid interface{}, scope, query string, result interface{}, opt *SearchOptions, options ...RequestOptionFunc) (*Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, err
 	}
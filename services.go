@@ -67,7 +67,7 @@ type Service struct {
 //
 // GitLab API docs: https://docs.gitlab.com/ee/api/integrations.html#list-all-active-integrations
 func (s *ServicesService) ListServices(pid interface{}, options ...RequestOptionFunc) ([]*Service, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -111,7 +111,7 @@ type CustomIssueTrackerServiceProperties struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/integrations.html#get-custom-issue-tracker-settings
 func (s *ServicesService) GetCustomIssueTrackerService(pid interface{}, options ...RequestOptionFunc) (*CustomIssueTrackerService, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -147,7 +147,7 @@ type SetCustomIssueTrackerServiceOptions struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/integrations.html#set-up-a-custom-issue-tracker
 func (s *ServicesService) SetCustomIssueTrackerService(pid interface{}, opt *SetCustomIssueTrackerServiceOptions, options ...RequestOptionFunc) (*CustomIssueTrackerService, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -172,7 +172,7 @@ func (s *ServicesService) SetCustomIssueTrackerService(pid interface{}, opt *Set
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/integrations.html#disable-a-custom-issue-tracker
 func (s *ServicesService) DeleteCustomIssueTrackerService(pid interface{}, options ...RequestOptionFunc) (*Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, err
 	}
@@ -213,7 +213,7 @@ type DataDogServiceProperties struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/integrations.html#get-datadog-settings
 func (s *ServicesService) GetDataDogService(pid interface{}, options ...RequestOptionFunc) (*DataDogService, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -253,7 +253,7 @@ type SetDataDogServiceOptions struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/integrations.html#set-up-datadog
 func (s *ServicesService) SetDataDogService(pid interface{}, opt *SetDataDogServiceOptions, options ...RequestOptionFunc) (*DataDogService, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -278,7 +278,7 @@ func (s *ServicesService) SetDataDogService(pid interface{}, opt *SetDataDogServ
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/integrations.html#disable-datadog
 func (s *ServicesService) DeleteDataDogService(pid interface{}, options ...RequestOptionFunc) (*Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, err
 	}
@@ -315,7 +315,7 @@ type DiscordServiceProperties struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/integrations.html#get-discord-notifications-settings
 func (s *ServicesService) GetDiscordService(pid interface{}, options ...RequestOptionFunc) (*DiscordService, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -375,7 +375,7 @@ type SetDiscordServiceOptions struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/integrations.html#set-up-discord-notifications
 func (s *ServicesService) SetDiscordService(pid interface{}, opt *SetDiscordServiceOptions, options ...RequestOptionFunc) (*DiscordService, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -400,7 +400,7 @@ func (s *ServicesService) SetDiscordService(pid interface{}, opt *SetDiscordServ
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/integrations.html#disable-discord-notifications
 func (s *ServicesService) DeleteDiscordService(pid interface{}, options ...RequestOptionFunc) (*Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, err
 	}
@@ -437,7 +437,7 @@ type DroneCIServiceProperties struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/integrations.html#get-drone-settings
 func (s *ServicesService) GetDroneCIService(pid interface{}, options ...RequestOptionFunc) (*DroneCIService, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -476,7 +476,7 @@ type SetDroneCIServiceOptions struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/integrations.html#set-up-drone
 func (s *ServicesService) SetDroneCIService(pid interface{}, opt *SetDroneCIServiceOptions, options ...RequestOptionFunc) (*DroneCIService, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -501,7 +501,7 @@ func (s *ServicesService) SetDroneCIService(pid interface{}, opt *SetDroneCIServ
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/integrations.html#disable-drone
 func (s *ServicesService) DeleteDroneCIService(pid interface{}, options ...RequestOptionFunc) (*Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, err
 	}
@@ -542,7 +542,7 @@ type EmailsOnPushServiceProperties struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/integrations.html#get-emails-on-push-integration-settings
 func (s *ServicesService) GetEmailsOnPushService(pid interface{}, options ...RequestOptionFunc) (*EmailsOnPushService, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -581,7 +581,7 @@ type SetEmailsOnPushServiceOptions struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/integrations.html#set-up-emails-on-push
 func (s *ServicesService) SetEmailsOnPushService(pid interface{}, opt *SetEmailsOnPushServiceOptions, options ...RequestOptionFunc) (*EmailsOnPushService, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -606,7 +606,7 @@ func (s *ServicesService) SetEmailsOnPushService(pid interface{}, opt *SetEmails
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/integrations.html#disable-emails-on-push
 func (s *ServicesService) DeleteEmailsOnPushService(pid interface{}, options ...RequestOptionFunc) (*Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, err
 	}
@@ -642,7 +642,7 @@ type ExternalWikiServiceProperties struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/integrations.html#get-external-wiki-settings
 func (s *ServicesService) GetExternalWikiService(pid interface{}, options ...RequestOptionFunc) (*ExternalWikiService, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -676,7 +676,7 @@ type SetExternalWikiServiceOptions struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/integrations.html#set-up-an-external-wiki
 func (s *ServicesService) SetExternalWikiService(pid interface{}, opt *SetExternalWikiServiceOptions, options ...RequestOptionFunc) (*ExternalWikiService, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -701,7 +701,7 @@ func (s *ServicesService) SetExternalWikiService(pid interface{}, opt *SetExtern
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/integrations.html#disable-an-external-wiki
 func (s *ServicesService) DeleteExternalWikiService(pid interface{}, options ...RequestOptionFunc) (*Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, err
 	}
@@ -738,7 +738,7 @@ type GithubServiceProperties struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/integrations.html#get-github-settings
 func (s *ServicesService) GetGithubService(pid interface{}, options ...RequestOptionFunc) (*GithubService, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -774,7 +774,7 @@ type SetGithubServiceOptions struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/integrations.html#set-up-github
 func (s *ServicesService) SetGithubService(pid interface{}, opt *SetGithubServiceOptions, options ...RequestOptionFunc) (*GithubService, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -799,7 +799,7 @@ func (s *ServicesService) SetGithubService(pid interface{}, opt *SetGithubServic
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/integrations.html#disable-github
 func (s *ServicesService) DeleteGithubService(pid interface{}, options ...RequestOptionFunc) (*Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, err
 	}
@@ -839,7 +839,7 @@ type HarborServiceProperties struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/integrations.html#get-harbor-settings
 func (s *ServicesService) GetHarborService(pid interface{}, options ...RequestOptionFunc) (*HarborService, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -877,7 +877,7 @@ type SetHarborServiceOptions struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/integrations.html#set-up-harbor
 func (s *ServicesService) SetHarborService(pid interface{}, opt *SetHarborServiceOptions, options ...RequestOptionFunc) (*HarborService, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -902,7 +902,7 @@ func (s *ServicesService) SetHarborService(pid interface{}, opt *SetHarborServic
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/integrations.html#disable-harbor
 func (s *ServicesService) DeleteHarborService(pid interface{}, options ...RequestOptionFunc) (*Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, err
 	}
@@ -959,7 +959,7 @@ type SlackApplicationProperties struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/integrations.html#get-gitlab-for-slack-app-settings
 func (s *ServicesService) GetSlackApplication(pid interface{}, options ...RequestOptionFunc) (*SlackApplication, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -1027,7 +1027,7 @@ type SetSlackApplicationOptions struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/integrations.html#set-up-gitlab-for-slack-app
 func (s *ServicesService) SetSlackApplication(pid interface{}, opt *SetSlackApplicationOptions, options ...RequestOptionFunc) (*SlackApplication, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -1052,7 +1052,7 @@ func (s *ServicesService) SetSlackApplication(pid interface{}, opt *SetSlackAppl
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/integrations.html#disable-gitlab-for-slack-app
 func (s *ServicesService) DisableSlackApplication(pid interface{}, options ...RequestOptionFunc) (*Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, err
 	}
@@ -1081,7 +1081,7 @@ type SetGitLabCIServiceOptions struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/integrations.html#edit-gitlab-ci-service
 func (s *ServicesService) SetGitLabCIService(pid interface{}, opt *SetGitLabCIServiceOptions, options ...RequestOptionFunc) (*Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, err
 	}
@@ -1100,7 +1100,7 @@ func (s *ServicesService) SetGitLabCIService(pid interface{}, opt *SetGitLabCISe
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/integrations.html#delete-gitlab-ci-service
 func (s *ServicesService) DeleteGitLabCIService(pid interface{}, options ...RequestOptionFunc) (*Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, err
 	}
@@ -1129,7 +1129,7 @@ type SetHipChatServiceOptions struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/integrations.html#edit-hipchat-service
 func (s *ServicesService) SetHipChatService(pid interface{}, opt *SetHipChatServiceOptions, options ...RequestOptionFunc) (*Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, err
 	}
@@ -1148,7 +1148,7 @@ func (s *ServicesService) SetHipChatService(pid interface{}, opt *SetHipChatServ
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/integrations.html#delete-hipchat-service
 func (s *ServicesService) DeleteHipChatService(pid interface{}, options ...RequestOptionFunc) (*Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, err
 	}
@@ -1187,7 +1187,7 @@ type JenkinsCIServiceProperties struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/integrations.html#get-jenkins-settings
 func (s *ServicesService) GetJenkinsCIService(pid interface{}, options ...RequestOptionFunc) (*JenkinsCIService, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -1228,7 +1228,7 @@ type SetJenkinsCIServiceOptions struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/integrations.html#set-up-jenkins
 func (s *ServicesService) SetJenkinsCIService(pid interface{}, opt *SetJenkinsCIServiceOptions, options ...RequestOptionFunc) (*JenkinsCIService, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -1253,7 +1253,7 @@ func (s *ServicesService) SetJenkinsCIService(pid interface{}, opt *SetJenkinsCI
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/integrations.html#disable-jenkins
 func (s *ServicesService) DeleteJenkinsCIService(pid interface{}, options ...RequestOptionFunc) (*Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, err
 	}
@@ -1337,7 +1337,7 @@ func (p *JiraServiceProperties) UnmarshalJSON(b []byte) error {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/integrations.html#get-jira-service-settings
 func (s *ServicesService) GetJiraService(pid interface{}, options ...RequestOptionFunc) (*JiraService, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -1389,7 +1389,7 @@ type SetJiraServiceOptions struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/integrations.html#edit-jira-service
 func (s *ServicesService) SetJiraService(pid interface{}, opt *SetJiraServiceOptions, options ...RequestOptionFunc) (*JiraService, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -1414,7 +1414,7 @@ func (s *ServicesService) SetJiraService(pid interface{}, opt *SetJiraServiceOpt
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/integrations.html#delete-jira-service
 func (s *ServicesService) DeleteJiraService(pid interface{}, options ...RequestOptionFunc) (*Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, err
 	}
@@ -1464,7 +1464,7 @@ type MattermostServiceProperties struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/integrations.html#get-slack-service-settings
 func (s *ServicesService) GetMattermostService(pid interface{}, options ...RequestOptionFunc) (*MattermostService, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -1520,7 +1520,7 @@ type SetMattermostServiceOptions struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/integrations.html#createedit-mattermost-notifications-service
 func (s *ServicesService) SetMattermostService(pid interface{}, opt *SetMattermostServiceOptions, options ...RequestOptionFunc) (*MattermostService, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -1545,7 +1545,7 @@ func (s *ServicesService) SetMattermostService(pid interface{}, opt *SetMattermo
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/integrations.html#delete-mattermost-notifications-service
 func (s *ServicesService) DeleteMattermostService(pid interface{}, options ...RequestOptionFunc) (*Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, err
 	}
@@ -1582,7 +1582,7 @@ type MattermostSlashCommandsProperties struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/integrations.html#get-mattermost-slash-command-integration-settings
 func (s *ServicesService) GetMattermostSlashCommandsService(pid interface{}, options ...RequestOptionFunc) (*MattermostSlashCommandsService, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -1617,7 +1617,7 @@ type SetMattermostSlashCommandsServiceOptions struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/integrations.html#createedit-mattermost-slash-command-integration
 func (s *ServicesService) SetMattermostSlashCommandsService(pid interface{}, opt *SetMattermostSlashCommandsServiceOptions, options ...RequestOptionFunc) (*MattermostSlashCommandsService, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -1642,7 +1642,7 @@ func (s *ServicesService) SetMattermostSlashCommandsService(pid interface{}, opt
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/integrations.html#disable-mattermost-slash-command-integration
 func (s *ServicesService) DeleteMattermostSlashCommandsService(pid interface{}, options ...RequestOptionFunc) (*Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, err
 	}
@@ -1688,7 +1688,7 @@ type MicrosoftTeamsServiceProperties struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/integrations.html#get-microsoft-teams-service-settings
 func (s *ServicesService) GetMicrosoftTeamsService(pid interface{}, options ...RequestOptionFunc) (*MicrosoftTeamsService, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -1733,7 +1733,7 @@ type SetMicrosoftTeamsServiceOptions struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/integrations.html#create-edit-microsoft-teams-service
 func (s *ServicesService) SetMicrosoftTeamsService(pid interface{}, opt *SetMicrosoftTeamsServiceOptions, options ...RequestOptionFunc) (*MicrosoftTeamsService, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -1758,7 +1758,7 @@ func (s *ServicesService) SetMicrosoftTeamsService(pid interface{}, opt *SetMicr
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/integrations.html#delete-microsoft-teams-service
 func (s *ServicesService) DeleteMicrosoftTeamsService(pid interface{}, options ...RequestOptionFunc) (*Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, err
 	}
@@ -1797,7 +1797,7 @@ type PipelinesEmailProperties struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/integrations.html#get-pipeline-emails-service-settings
 func (s *ServicesService) GetPipelinesEmailService(pid interface{}, options ...RequestOptionFunc) (*PipelinesEmailService, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -1836,7 +1836,7 @@ type SetPipelinesEmailServiceOptions struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/integrations.html#pipeline-emails
 func (s *ServicesService) SetPipelinesEmailService(pid interface{}, opt *SetPipelinesEmailServiceOptions, options ...RequestOptionFunc) (*PipelinesEmailService, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -1861,7 +1861,7 @@ func (s *ServicesService) SetPipelinesEmailService(pid interface{}, opt *SetPipe
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/integrations.html#delete-pipeline-emails-service
 func (s *ServicesService) DeletePipelinesEmailService(pid interface{}, options ...RequestOptionFunc) (*Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, err
 	}
@@ -1899,7 +1899,7 @@ type PrometheusServiceProperties struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/integrations.html#get-prometheus-service-settings
 func (s *ServicesService) GetPrometheusService(pid interface{}, options ...RequestOptionFunc) (*PrometheusService, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -1935,7 +1935,7 @@ type SetPrometheusServiceOptions struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/integrations.html#createedit-prometheus-service
 func (s *ServicesService) SetPrometheusService(pid interface{}, opt *SetPrometheusServiceOptions, options ...RequestOptionFunc) (*PrometheusService, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -1960,7 +1960,7 @@ func (s *ServicesService) SetPrometheusService(pid interface{}, opt *SetPromethe
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/integrations.html#delete-prometheus-service
 func (s *ServicesService) DeletePrometheusService(pid interface{}, options ...RequestOptionFunc) (*Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, err
 	}
@@ -1999,7 +1999,7 @@ type RedmineServiceProperties struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/integrations.html#get-redmine-settings
 func (s *ServicesService) GetRedmineService(pid interface{}, options ...RequestOptionFunc) (*RedmineService, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -2036,7 +2036,7 @@ type SetRedmineServiceOptions struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/integrations.html#set-up-redmine
 func (s *ServicesService) SetRedmineService(pid interface{}, opt *SetRedmineServiceOptions, options ...RequestOptionFunc) (*RedmineService, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -2061,7 +2061,7 @@ func (s *ServicesService) SetRedmineService(pid interface{}, opt *SetRedmineServ
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/integrations.html#disable-redmine
 func (s *ServicesService) DeleteRedmineService(pid interface{}, options ...RequestOptionFunc) (*Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, err
 	}
@@ -2114,7 +2114,7 @@ type SlackServiceProperties struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/integrations.html#get-slack-service-settings
 func (s *ServicesService) GetSlackService(pid interface{}, options ...RequestOptionFunc) (*SlackService, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -2175,7 +2175,7 @@ type SetSlackServiceOptions struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/integrations.html#edit-slack-service
 func (s *ServicesService) SetSlackService(pid interface{}, opt *SetSlackServiceOptions, options ...RequestOptionFunc) (*SlackService, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -2200,7 +2200,7 @@ func (s *ServicesService) SetSlackService(pid interface{}, opt *SetSlackServiceO
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/integrations.html#delete-slack-service
 func (s *ServicesService) DeleteSlackService(pid interface{}, options ...RequestOptionFunc) (*Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, err
 	}
@@ -2236,7 +2236,7 @@ type SlackSlashCommandsProperties struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/integrations.html#get-slack-slash-command-integration-settings
 func (s *ServicesService) GetSlackSlashCommandsService(pid interface{}, options ...RequestOptionFunc) (*SlackSlashCommandsService, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -2270,7 +2270,7 @@ type SetSlackSlashCommandsServiceOptions struct {
 // GitLab API docs:
 // https://docs.gitlab.com/13.12/ee/api/integrations.html#createedit-slack-slash-command-service
 func (s *ServicesService) SetSlackSlashCommandsService(pid interface{}, opt *SetSlackSlashCommandsServiceOptions, options ...RequestOptionFunc) (*SlackSlashCommandsService, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -2295,7 +2295,7 @@ func (s *ServicesService) SetSlackSlashCommandsService(pid interface{}, opt *Set
 // GitLab API docs:
 // https://docs.gitlab.com/13.12/ee/api/integrations.html#delete-slack-slash-command-service
 func (s *ServicesService) DeleteSlackSlashCommandsService(pid interface{}, options ...RequestOptionFunc) (*Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, err
 	}
@@ -2333,7 +2333,7 @@ type TelegramServiceProperties struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/integrations.html#get-telegram-settings
 func (s *ServicesService) GetTelegramService(pid interface{}, options ...RequestOptionFunc) (*TelegramService, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -2379,7 +2379,7 @@ type SetTelegramServiceOptions struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/integrations.html#set-up-telegram
 func (s *ServicesService) SetTelegramService(pid interface{}, opt *SetTelegramServiceOptions, options ...RequestOptionFunc) (*TelegramService, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -2404,7 +2404,7 @@ func (s *ServicesService) SetTelegramService(pid interface{}, opt *SetTelegramSe
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/integrations.html#disable-telegram
 func (s *ServicesService) DeleteTelegramService(pid interface{}, options ...RequestOptionFunc) (*Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, err
 	}
@@ -2443,7 +2443,7 @@ type YouTrackServiceProperties struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/integrations.html#get-youtrack-service-settings
 func (s *ServicesService) GetYouTrackService(pid interface{}, options ...RequestOptionFunc) (*YouTrackService, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -2480,7 +2480,7 @@ type SetYouTrackServiceOptions struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/integrations.html#createedit-youtrack-service
 func (s *ServicesService) SetYouTrackService(pid interface{}, opt *SetYouTrackServiceOptions, options ...RequestOptionFunc) (*YouTrackService, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -2505,7 +2505,7 @@ func (s *ServicesService) SetYouTrackService(pid interface{}, opt *SetYouTrackSe
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/integrations.html#delete-youtrack-service
 func (s *ServicesService) DeleteYouTrackService(pid interface{}, options ...RequestOptionFunc) (*Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,206 @@
+package gitlab
+
+// RateLimitThrottle represents the settings of one of the request throttles
+// of GitLab.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/administration/settings/user_and_ip_rate_limits.html
+type RateLimitThrottle struct {
+	Enabled           bool
+	RequestsPerPeriod int
+	PeriodInSeconds   int
+}
+
+// RateLimitSettings represents the rate limit related application settings.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/security/rate_limits.html
+type RateLimitSettings struct {
+	AuthenticatedAPI             RateLimitThrottle
+	AuthenticatedDeprecatedAPI   RateLimitThrottle
+	AuthenticatedFilesAPI        RateLimitThrottle
+	AuthenticatedGitLFS          RateLimitThrottle
+	AuthenticatedPackagesAPI     RateLimitThrottle
+	AuthenticatedWeb             RateLimitThrottle
+	ProtectedPaths               RateLimitThrottle
+	UnauthenticatedAPI           RateLimitThrottle
+	UnauthenticatedDeprecatedAPI RateLimitThrottle
+	UnauthenticatedFilesAPI      RateLimitThrottle
+	UnauthenticatedGitLFS        RateLimitThrottle
+	UnauthenticatedPackagesAPI   RateLimitThrottle
+	UnauthenticatedWeb           RateLimitThrottle
+
+	// Rate limits of specific endpoints, in requests per minute unless
+	// documented otherwise. 0 disables a limit.
+	GroupDownloadExportLimit            int
+	GroupExportLimit                    int
+	GroupImportLimit                    int
+	IssuesCreateLimit                   int
+	NotesCreateLimit                    int
+	PipelineLimitPerProjectUserSha      int
+	ProjectDownloadExportLimit          int
+	ProjectExportLimit                  int
+	ProjectImportLimit                  int
+	ProjectJobsAPIRateLimit             int
+	ProjectsAPIRateLimitUnauthenticated int
+	RawBlobRequestLimit                 int
+	SearchRateLimit                     int
+	SearchRateLimitUnauthenticated      int
+	UsersGetByIDLimit                   int
+
+	// RateLimitingResponseText is the body of throttled responses.
+	RateLimitingResponseText string
+}
+
+// RateLimits returns the rate limit related settings.
+func (s *Settings) RateLimits() *RateLimitSettings {
+	return &RateLimitSettings{
+		AuthenticatedAPI:                    RateLimitThrottle{s.ThrottleAuthenticatedAPIEnabled, s.ThrottleAuthenticatedAPIRequestsPerPeriod, s.ThrottleAuthenticatedAPIPeriodInSeconds},
+		AuthenticatedDeprecatedAPI:          RateLimitThrottle{s.ThrottleAuthenticatedDeprecatedAPIEnabled, s.ThrottleAuthenticatedDeprecatedAPIRequestsPerPeriod, s.ThrottleAuthenticatedDeprecatedAPIPeriodInSeconds},
+		AuthenticatedFilesAPI:               RateLimitThrottle{s.ThrottleAuthenticatedFilesAPIEnabled, s.ThrottleAuthenticatedFilesAPIRequestsPerPeriod, s.ThrottleAuthenticatedFilesAPIPeriodInSeconds},
+		AuthenticatedGitLFS:                 RateLimitThrottle{s.ThrottleAuthenticatedGitLFSEnabled, s.ThrottleAuthenticatedGitLFSRequestsPerPeriod, s.ThrottleAuthenticatedGitLFSPeriodInSeconds},
+		AuthenticatedPackagesAPI:            RateLimitThrottle{s.ThrottleAuthenticatedPackagesAPIEnabled, s.ThrottleAuthenticatedPackagesAPIRequestsPerPeriod, s.ThrottleAuthenticatedPackagesAPIPeriodInSeconds},
+		AuthenticatedWeb:                    RateLimitThrottle{s.ThrottleAuthenticatedWebEnabled, s.ThrottleAuthenticatedWebRequestsPerPeriod, s.ThrottleAuthenticatedWebPeriodInSeconds},
+		ProtectedPaths:                      RateLimitThrottle{s.ThrottleProtectedPathsEnabled, s.ThrottleProtectedPathsRequestsPerPeriod, s.ThrottleProtectedPathsPeriodInSeconds},
+		UnauthenticatedAPI:                  RateLimitThrottle{s.ThrottleUnauthenticatedAPIEnabled, s.ThrottleUnauthenticatedAPIRequestsPerPeriod, s.ThrottleUnauthenticatedAPIPeriodInSeconds},
+		UnauthenticatedDeprecatedAPI:        RateLimitThrottle{s.ThrottleUnauthenticatedDeprecatedAPIEnabled, s.ThrottleUnauthenticatedDeprecatedAPIRequestsPerPeriod, s.ThrottleUnauthenticatedDeprecatedAPIPeriodInSeconds},
+		UnauthenticatedFilesAPI:             RateLimitThrottle{s.ThrottleUnauthenticatedFilesAPIEnabled, s.ThrottleUnauthenticatedFilesAPIRequestsPerPeriod, s.ThrottleUnauthenticatedFilesAPIPeriodInSeconds},
+		UnauthenticatedGitLFS:               RateLimitThrottle{s.ThrottleUnauthenticatedGitLFSEnabled, s.ThrottleUnauthenticatedGitLFSRequestsPerPeriod, s.ThrottleUnauthenticatedGitLFSPeriodInSeconds},
+		UnauthenticatedPackagesAPI:          RateLimitThrottle{s.ThrottleUnauthenticatedPackagesAPIEnabled, s.ThrottleUnauthenticatedPackagesAPIRequestsPerPeriod, s.ThrottleUnauthenticatedPackagesAPIPeriodInSeconds},
+		UnauthenticatedWeb:                  RateLimitThrottle{s.ThrottleUnauthenticatedWebEnabled, s.ThrottleUnauthenticatedWebRequestsPerPeriod, s.ThrottleUnauthenticatedWebPeriodInSeconds},
+		GroupDownloadExportLimit:            s.GroupDownloadExportLimit,
+		GroupExportLimit:                    s.GroupExportLimit,
+		GroupImportLimit:                    s.GroupImportLimit,
+		IssuesCreateLimit:                   s.IssuesCreateLimit,
+		NotesCreateLimit:                    s.NotesCreateLimit,
+		PipelineLimitPerProjectUserSha:      s.PipelineLimitPerProjectUserSha,
+		ProjectDownloadExportLimit:          s.ProjectDownloadExportLimit,
+		ProjectExportLimit:                  s.ProjectExportLimit,
+		ProjectImportLimit:                  s.ProjectImportLimit,
+		ProjectJobsAPIRateLimit:             s.ProjectJobsAPIRateLimit,
+		ProjectsAPIRateLimitUnauthenticated: s.ProjectsAPIRateLimitUnauthenticated,
+		RawBlobRequestLimit:                 s.RawBlobRequestLimit,
+		SearchRateLimit:                     s.SearchRateLimit,
+		SearchRateLimitUnauthenticated:      s.SearchRateLimitUnauthenticated,
+		UsersGetByIDLimit:                   s.UsersGetByIDLimit,
+		RateLimitingResponseText:            s.RateLimitingResponseText,
+	}
+}
+
+// GetRateLimitSettings gets the rate limit related application settings.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/settings.html#get-current-application-settings
+func (s *SettingsService) GetRateLimitSettings(options ...RequestOptionFunc) (*RateLimitSettings, *Response, error) {
+	as, resp, err := s.GetSettings(options...)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return as.RateLimits(), resp, nil
+}
+
+// RateLimitThrottleOptions represents the updates of a request throttle.
+type RateLimitThrottleOptions struct {
+	Enabled           *bool
+	RequestsPerPeriod *int
+	PeriodInSeconds   *int
+}
+
+// UpdateRateLimitSettingsOptions represents the available
+// UpdateRateLimitSettings() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/settings.html#change-application-settings
+type UpdateRateLimitSettingsOptions struct {
+	AuthenticatedAPI             *RateLimitThrottleOptions
+	AuthenticatedDeprecatedAPI   *RateLimitThrottleOptions
+	AuthenticatedFilesAPI        *RateLimitThrottleOptions
+	AuthenticatedGitLFS          *RateLimitThrottleOptions
+	AuthenticatedPackagesAPI     *RateLimitThrottleOptions
+	AuthenticatedWeb             *RateLimitThrottleOptions
+	ProtectedPaths               *RateLimitThrottleOptions
+	UnauthenticatedAPI           *RateLimitThrottleOptions
+	UnauthenticatedDeprecatedAPI *RateLimitThrottleOptions
+	UnauthenticatedFilesAPI      *RateLimitThrottleOptions
+	UnauthenticatedGitLFS        *RateLimitThrottleOptions
+	UnauthenticatedPackagesAPI   *RateLimitThrottleOptions
+	UnauthenticatedWeb           *RateLimitThrottleOptions
+
+	GroupDownloadExportLimit            *int
+	GroupExportLimit                    *int
+	GroupImportLimit                    *int
+	IssuesCreateLimit                   *int
+	NotesCreateLimit                    *int
+	PipelineLimitPerProjectUserSha      *int
+	ProjectDownloadExportLimit          *int
+	ProjectExportLimit                  *int
+	ProjectImportLimit                  *int
+	ProjectJobsAPIRateLimit             *int
+	ProjectsAPIRateLimitUnauthenticated *int
+	RawBlobRequestLimit                 *int
+	SearchRateLimit                     *int
+	SearchRateLimitUnauthenticated      *int
+	UsersGetByIDLimit                   *int
+	RateLimitingResponseText            *string
+}
+
+// UpdateRateLimitSettings updates only the rate limit related application
+// settings, leaving all other settings untouched. It returns the resulting
+// rate limit settings.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/settings.html#change-application-settings
+func (s *SettingsService) UpdateRateLimitSettings(opt *UpdateRateLimitSettingsOptions, options ...RequestOptionFunc) (*RateLimitSettings, *Response, error) {
+	if opt == nil {
+		opt = new(UpdateRateLimitSettingsOptions)
+	}
+
+	uopt := &UpdateSettingsOptions{
+		GroupDownloadExportLimit:            opt.GroupDownloadExportLimit,
+		GroupExportLimit:                    opt.GroupExportLimit,
+		GroupImportLimit:                    opt.GroupImportLimit,
+		IssuesCreateLimit:                   opt.IssuesCreateLimit,
+		NotesCreateLimit:                    opt.NotesCreateLimit,
+		PipelineLimitPerProjectUserSha:      opt.PipelineLimitPerProjectUserSha,
+		ProjectDownloadExportLimit:          opt.ProjectDownloadExportLimit,
+		ProjectExportLimit:                  opt.ProjectExportLimit,
+		ProjectImportLimit:                  opt.ProjectImportLimit,
+		ProjectJobsAPIRateLimit:             opt.ProjectJobsAPIRateLimit,
+		ProjectsAPIRateLimitUnauthenticated: opt.ProjectsAPIRateLimitUnauthenticated,
+		RawBlobRequestLimit:                 opt.RawBlobRequestLimit,
+		SearchRateLimit:                     opt.SearchRateLimit,
+		SearchRateLimitUnauthenticated:      opt.SearchRateLimitUnauthenticated,
+		UsersGetByIDLimit:                   opt.UsersGetByIDLimit,
+		RateLimitingResponseText:            opt.RateLimitingResponseText,
+	}
+	opt.AuthenticatedAPI.apply(&uopt.ThrottleAuthenticatedAPIEnabled, &uopt.ThrottleAuthenticatedAPIRequestsPerPeriod, &uopt.ThrottleAuthenticatedAPIPeriodInSeconds)
+	opt.AuthenticatedDeprecatedAPI.apply(&uopt.ThrottleAuthenticatedDeprecatedAPIEnabled, &uopt.ThrottleAuthenticatedDeprecatedAPIRequestsPerPeriod, &uopt.ThrottleAuthenticatedDeprecatedAPIPeriodInSeconds)
+	opt.AuthenticatedFilesAPI.apply(&uopt.ThrottleAuthenticatedFilesAPIEnabled, &uopt.ThrottleAuthenticatedFilesAPIRequestsPerPeriod, &uopt.ThrottleAuthenticatedFilesAPIPeriodInSeconds)
+	opt.AuthenticatedGitLFS.apply(&uopt.ThrottleAuthenticatedGitLFSEnabled, &uopt.ThrottleAuthenticatedGitLFSRequestsPerPeriod, &uopt.ThrottleAuthenticatedGitLFSPeriodInSeconds)
+	opt.AuthenticatedPackagesAPI.apply(&uopt.ThrottleAuthenticatedPackagesAPIEnabled, &uopt.ThrottleAuthenticatedPackagesAPIRequestsPerPeriod, &uopt.ThrottleAuthenticatedPackagesAPIPeriodInSeconds)
+	opt.AuthenticatedWeb.apply(&uopt.ThrottleAuthenticatedWebEnabled, &uopt.ThrottleAuthenticatedWebRequestsPerPeriod, &uopt.ThrottleAuthenticatedWebPeriodInSeconds)
+	opt.ProtectedPaths.apply(&uopt.ThrottleProtectedPathsEnabled, &uopt.ThrottleProtectedPathsRequestsPerPeriod, &uopt.ThrottleProtectedPathsPeriodInSeconds)
+	opt.UnauthenticatedAPI.apply(&uopt.ThrottleUnauthenticatedAPIEnabled, &uopt.ThrottleUnauthenticatedAPIRequestsPerPeriod, &uopt.ThrottleUnauthenticatedAPIPeriodInSeconds)
+	opt.UnauthenticatedDeprecatedAPI.apply(&uopt.ThrottleUnauthenticatedDeprecatedAPIEnabled, &uopt.ThrottleUnauthenticatedDeprecatedAPIRequestsPerPeriod, &uopt.ThrottleUnauthenticatedDeprecatedAPIPeriodInSeconds)
+	opt.UnauthenticatedFilesAPI.apply(&uopt.ThrottleUnauthenticatedFilesAPIEnabled, &uopt.ThrottleUnauthenticatedFilesAPIRequestsPerPeriod, &uopt.ThrottleUnauthenticatedFilesAPIPeriodInSeconds)
+	opt.UnauthenticatedGitLFS.apply(&uopt.ThrottleUnauthenticatedGitLFSEnabled, &uopt.ThrottleUnauthenticatedGitLFSRequestsPerPeriod, &uopt.ThrottleUnauthenticatedGitLFSPeriodInSeconds)
+	opt.UnauthenticatedPackagesAPI.apply(&uopt.ThrottleUnauthenticatedPackagesAPIEnabled, &uopt.ThrottleUnauthenticatedPackagesAPIRequestsPerPeriod, &uopt.ThrottleUnauthenticatedPackagesAPIPeriodInSeconds)
+	opt.UnauthenticatedWeb.apply(&uopt.ThrottleUnauthenticatedWebEnabled, &uopt.ThrottleUnauthenticatedWebRequestsPerPeriod, &uopt.ThrottleUnauthenticatedWebPeriodInSeconds)
+
+	as, resp, err := s.UpdateSettings(uopt, options...)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return as.RateLimits(), resp, nil
+}
+
+func (o *RateLimitThrottleOptions) apply(enabled **bool, requestsPerPeriod, periodInSeconds **int) {
+	if o == nil {
+		return
+	}
+	*enabled = o.Enabled
+	*requestsPerPeriod = o.RequestsPerPeriod
+	*periodInSeconds = o.PeriodInSeconds
+}
@@ -0,0 +1,72 @@
+package gitlab
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestGetRateLimitSettings(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/application/settings", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{
+			"default_projects_limit": 100000,
+			"throttle_authenticated_api_enabled": true,
+			"throttle_authenticated_api_requests_per_period": 7200,
+			"throttle_authenticated_api_period_in_seconds": 3600,
+			"search_rate_limit": 30
+		}`)
+	})
+
+	limits, _, err := client.Settings.GetRateLimitSettings()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := &RateLimitSettings{
+		AuthenticatedAPI: RateLimitThrottle{Enabled: true, RequestsPerPeriod: 7200, PeriodInSeconds: 3600},
+		SearchRateLimit:  30,
+	}
+	if !reflect.DeepEqual(limits, want) {
+		t.Errorf("Settings.GetRateLimitSettings returned %+v, want %+v", limits, want)
+	}
+}
+
+func TestUpdateRateLimitSettings(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/application/settings", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		testBody(t, r, `{"search_rate_limit":60,"search_rate_limit_unauthenticated":10,"throttle_unauthenticated_web_enabled":false,"throttle_unauthenticated_web_requests_per_period":100}`)
+		fmt.Fprint(w, `{
+			"search_rate_limit": 60,
+			"search_rate_limit_unauthenticated": 10,
+			"throttle_unauthenticated_web_requests_per_period": 100,
+			"throttle_unauthenticated_web_period_in_seconds": 60
+		}`)
+	})
+
+	limits, _, err := client.Settings.UpdateRateLimitSettings(&UpdateRateLimitSettingsOptions{
+		SearchRateLimit:                Ptr(60),
+		SearchRateLimitUnauthenticated: Ptr(10),
+		UnauthenticatedWeb: &RateLimitThrottleOptions{
+			Enabled:           Ptr(false),
+			RequestsPerPeriod: Ptr(100),
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := &RateLimitSettings{
+		UnauthenticatedWeb:             RateLimitThrottle{RequestsPerPeriod: 100, PeriodInSeconds: 60},
+		SearchRateLimit:                60,
+		SearchRateLimitUnauthenticated: 10,
+	}
+	if !reflect.DeepEqual(limits, want) {
+		t.Errorf("Settings.UpdateRateLimitSettings returned %+v, want %+v", limits, want)
+	}
+}
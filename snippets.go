@@ -20,6 +20,7 @@ import (
 	"bytes"
 	"fmt"
 	"net/http"
+	"net/url"
 	"time"
 )
 
@@ -137,7 +138,7 @@ func (s *SnippetsService) SnippetContent(snippet int, options ...RequestOptionFu
 // https://docs.gitlab.com/ee/api/snippets.html#snippet-repository-file-content
 func (s *SnippetsService) SnippetFileContent(snippet int, ref, filename string, options ...RequestOptionFunc) ([]byte, *Response, error) {
 	filepath := PathEscape(filename)
-	u := fmt.Sprintf("snippets/%d/files/%s/%s/raw", snippet, ref, filepath)
+	u := fmt.Sprintf("snippets/%d/files/%s/%s/raw", snippet, url.PathEscape(ref), filepath)
 
 	req, err := s.client.NewRequest(http.MethodGet, u, nil, options)
 	if err != nil {
@@ -153,6 +154,70 @@ func (s *SnippetsService) SnippetFileContent(snippet int, ref, filename string,
 	return b.Bytes(), resp, err
 }
 
+// SnippetBlob represents a file in the repository of a snippet.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/graphql/reference/#snippetblob
+type SnippetBlob struct {
+	Name    string `json:"name"`
+	Path    string `json:"path"`
+	RawPath string `json:"rawPath"`
+	Size    int    `json:"size"`
+	Binary  bool   `json:"binary"`
+	Mode    string `json:"mode"`
+}
+
+// ListSnippetFiles gets the files in the repository of a personal snippet,
+// including their sizes.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/graphql/reference/#snippetblobs
+func (s *SnippetsService) ListSnippetFiles(snippet int, options ...RequestOptionFunc) ([]*SnippetBlob, *Response, error) {
+	return s.client.listSnippetBlobs(globalID("PersonalSnippet", snippet), options)
+}
+
+const snippetBlobsFields = `blobs { nodes { name path rawPath size binary mode } }`
+
+// snippetBlobsConnection represents the snippets returned by a GraphQL query
+// for the files of a single snippet.
+type snippetBlobsConnection struct {
+	Nodes []struct {
+		Blobs struct {
+			Nodes []*SnippetBlob `json:"nodes"`
+		} `json:"blobs"`
+	} `json:"nodes"`
+}
+
+// blobs returns the files of the snippet, or ErrNotFound if the query did
+// not return the snippet.
+func (c *snippetBlobsConnection) blobs() ([]*SnippetBlob, error) {
+	if len(c.Nodes) == 0 {
+		return nil, ErrNotFound
+	}
+	return c.Nodes[0].Blobs.Nodes, nil
+}
+
+// listSnippetBlobs gets the files of the snippet with the given global ID.
+func (c *Client) listSnippetBlobs(snippet string, options []RequestOptionFunc) ([]*SnippetBlob, *Response, error) {
+	q := GraphQLQuery{
+		Query: `query($ids: [SnippetID!]) {
+			snippets(ids: $ids) { nodes { ` + snippetBlobsFields + ` } }
+		}`,
+		Variables: map[string]interface{}{"ids": []string{snippet}},
+	}
+
+	var data struct {
+		Snippets snippetBlobsConnection `json:"snippets"`
+	}
+	resp, err := c.GraphQL(q, &data, options...)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	blobs, err := data.Snippets.blobs()
+	return blobs, resp, err
+}
+
 // CreateSnippetFileOptions represents the create snippet file options.
 //
 // GitLab API docs:
@@ -1,6 +1,7 @@
 package gitlab
 
 import (
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"testing"
@@ -146,3 +147,40 @@ func TestSnippetsService_ListAllSnippets(t *testing.T) {
 	want := []*Snippet{{ID: 113, Title: "Internal Snippet"}, {ID: 114, Title: "Private Snippet"}}
 	require.Equal(t, want, ss)
 }
+
+func TestSnippetsService_SnippetFileContent(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/snippets/1/files/main/config/app.yml/raw", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, "key: value")
+	})
+
+	b, _, err := client.Snippets.SnippetFileContent(1, "main", "config/app.yml")
+	require.NoError(t, err)
+	require.Equal(t, []byte("key: value"), b)
+}
+
+func TestSnippetsService_ListSnippetFiles(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/graphql", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		var q GraphQLQuery
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&q))
+		require.Equal(t, []interface{}{"gid://gitlab/PersonalSnippet/1"}, q.Variables["ids"])
+		fmt.Fprint(w, `{"data": {"snippets": {"nodes": [{"blobs": {"nodes": [
+			{"name": "app.yml", "path": "config/app.yml", "rawPath": "/-/snippets/1/raw/main/config/app.yml", "size": 10, "binary": false, "mode": "100644"}
+		]}}]}}}`)
+	})
+
+	files, _, err := client.Snippets.ListSnippetFiles(1)
+	require.NoError(t, err)
+	require.Equal(t, []*SnippetBlob{{
+		Name:    "app.yml",
+		Path:    "config/app.yml",
+		RawPath: "/-/snippets/1/raw/main/config/app.yml",
+		Size:    10,
+		Mode:    "100644",
+	}}, files)
+}
@@ -0,0 +1,255 @@
+package gitlab
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// DecodingReport describes the differences between a decoded response and
+// the type it was decoded into. Field paths are dotted JSON names, with []
+// denoting the elements of an array.
+type DecodingReport struct {
+	Method string
+	URL    string
+
+	// UnknownFields contains the fields returned by GitLab which are not
+	// present in the type the response was decoded into.
+	UnknownFields []string
+
+	// MissingFields contains the fields of the type the response was decoded
+	// into which are not tagged with omitempty, but were not returned.
+	MissingFields []string
+}
+
+// Empty reports whether the response matched the type exactly.
+func (r *DecodingReport) Empty() bool {
+	return len(r.UnknownFields) == 0 && len(r.MissingFields) == 0
+}
+
+func (r *DecodingReport) String() string {
+	var parts []string
+	if len(r.UnknownFields) > 0 {
+		parts = append(parts, "unknown fields: "+strings.Join(r.UnknownFields, ", "))
+	}
+	if len(r.MissingFields) > 0 {
+		parts = append(parts, "missing fields: "+strings.Join(r.MissingFields, ", "))
+	}
+	return strings.Join(parts, "; ")
+}
+
+// DecodingError is returned when strict decoding is enabled and a response
+// does not match the type it was decoded into. The response is still
+// decoded, as far as possible.
+type DecodingError struct {
+	Response *http.Response
+	Report   *DecodingReport
+}
+
+func (e *DecodingError) Error() string {
+	return fmt.Sprintf("%s %s: response does not match schema: %s", e.Report.Method, e.Report.URL, e.Report)
+}
+
+// decodeResponse decodes a JSON response into v. When strict decoding or
+// decoding reports are enabled, the response is compared with the type of v.
+func (c *Client) decodeResponse(resp *Response, v interface{}) error {
+	if !c.strictDecoding && c.decodingReportHandler == nil {
+		return json.NewDecoder(resp.Body).Decode(v)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(body, v); err != nil {
+		return err
+	}
+
+	var doc interface{}
+	d := json.NewDecoder(bytes.NewReader(body))
+	d.UseNumber()
+	if err := d.Decode(&doc); err != nil {
+		return err
+	}
+
+	report := &DecodingReport{
+		Method: resp.Request.Method,
+		URL:    redactURL(resp.Request.URL),
+	}
+	s := &schemaCheck{unknown: make(map[string]bool), missing: make(map[string]bool)}
+	s.check("", doc, reflect.TypeOf(v))
+	report.UnknownFields = sortedKeys(s.unknown)
+	report.MissingFields = sortedKeys(s.missing)
+
+	if report.Empty() {
+		return nil
+	}
+	resp.DecodingReport = report
+
+	if c.decodingReportHandler != nil {
+		c.decodingReportHandler(report)
+	}
+	if c.strictDecoding {
+		return &DecodingError{Response: resp.Response, Report: report}
+	}
+	return nil
+}
+
+var (
+	jsonUnmarshalerType = reflect.TypeOf((*json.Unmarshaler)(nil)).Elem()
+	textUnmarshalerType = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+)
+
+// schemaCheck compares a decoded JSON document with a Go type. Types that
+// decode themselves are not inspected.
+type schemaCheck struct {
+	unknown map[string]bool
+	missing map[string]bool
+}
+
+func (s *schemaCheck) check(path string, doc interface{}, t reflect.Type) {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if doc == nil || reflect.PointerTo(t).Implements(jsonUnmarshalerType) ||
+		reflect.PointerTo(t).Implements(textUnmarshalerType) {
+		return
+	}
+
+	switch t.Kind() {
+	case reflect.Struct:
+		obj, ok := doc.(map[string]interface{})
+		if !ok {
+			return
+		}
+		fields := jsonFields(t)
+		for key, value := range obj {
+			f, ok := fields[key]
+			if !ok {
+				f, ok = foldedField(fields, key)
+			}
+			if !ok {
+				s.unknown[joinPath(path, key)] = true
+				continue
+			}
+			s.check(joinPath(path, key), value, f.typ)
+		}
+		for name, f := range fields {
+			if f.omitEmpty {
+				continue
+			}
+			if _, ok := obj[name]; !ok {
+				if _, ok := foldedKey(obj, name); !ok {
+					s.missing[joinPath(path, name)] = true
+				}
+			}
+		}
+	case reflect.Slice, reflect.Array:
+		elems, ok := doc.([]interface{})
+		if !ok {
+			return
+		}
+		for _, e := range elems {
+			s.check(path+"[]", e, t.Elem())
+		}
+	case reflect.Map:
+		obj, ok := doc.(map[string]interface{})
+		if !ok {
+			return
+		}
+		for _, value := range obj {
+			s.check(joinPath(path, "*"), value, t.Elem())
+		}
+	}
+}
+
+type jsonField struct {
+	typ       reflect.Type
+	omitEmpty bool
+}
+
+// jsonFields returns the fields of a struct by JSON name, including the
+// fields of embedded structs, the same way encoding/json would.
+func jsonFields(t reflect.Type) map[string]jsonField {
+	fields := make(map[string]jsonField)
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		tag := sf.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name, opts, _ := strings.Cut(tag, ",")
+
+		if sf.Anonymous && name == "" {
+			ft := sf.Type
+			if ft.Kind() == reflect.Pointer {
+				ft = ft.Elem()
+			}
+			if ft.Kind() == reflect.Struct {
+				for n, f := range jsonFields(ft) {
+					if _, ok := fields[n]; !ok {
+						fields[n] = f
+					}
+				}
+				continue
+			}
+		}
+		if !sf.IsExported() {
+			continue
+		}
+
+		if name == "" {
+			name = sf.Name
+		}
+		fields[name] = jsonField{
+			typ:       sf.Type,
+			omitEmpty: strings.Contains(","+opts+",", ",omitempty,"),
+		}
+	}
+	return fields
+}
+
+// foldedField finds a field using a case-insensitive match, like
+// encoding/json does when there is no exact match.
+func foldedField(fields map[string]jsonField, key string) (jsonField, bool) {
+	for name, f := range fields {
+		if strings.EqualFold(name, key) {
+			return f, true
+		}
+	}
+	return jsonField{}, false
+}
+
+func foldedKey(obj map[string]interface{}, name string) (interface{}, bool) {
+	for key, value := range obj {
+		if strings.EqualFold(name, key) {
+			return value, true
+		}
+	}
+	return nil, false
+}
+
+func joinPath(path, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
+
+func sortedKeys(m map[string]bool) []string {
+	if len(m) == 0 {
+		return nil
+	}
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,85 @@
+package gitlab
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type strictDecodingTestItem struct {
+	ListOptions
+	ID        int                               `json:"id"`
+	Name      string                            `json:"name"`
+	Optional  string                            `json:"optional,omitempty"`
+	CreatedAt *time.Time                        `json:"created_at"`
+	Owner     *struct{ ID int }                 `json:"owner"`
+	Children  []*strictDecodingTestItem         `json:"children,omitempty"`
+	Labels    map[string]*strictDecodingTestTag `json:"labels,omitempty"`
+	Extra     interface{}                       `json:"extra,omitempty"`
+}
+
+type strictDecodingTestTag struct {
+	Color string `json:"color"`
+}
+
+func TestSchemaCheck(t *testing.T) {
+	doc := map[string]interface{}{
+		"id":         1,
+		"new_field":  true,
+		"created_at": "2024-01-01T00:00:00Z",
+		"owner":      map[string]interface{}{"id": 1, "username": "root"},
+		"page":       1,
+		"per_page":   20,
+		"children": []interface{}{
+			map[string]interface{}{"id": 2, "name": "child", "created_at": nil, "owner": nil, "page": 1, "per_page": 20, "stars": 1},
+		},
+		"labels": map[string]interface{}{"bug": map[string]interface{}{"colour": "red"}},
+		"extra":  map[string]interface{}{"anything": "goes"},
+	}
+
+	s := &schemaCheck{unknown: make(map[string]bool), missing: make(map[string]bool)}
+	s.check("", doc, reflect.TypeOf(&strictDecodingTestItem{}))
+
+	assert.Equal(t, []string{"children[].stars", "labels.*.colour", "new_field", "owner.username"}, sortedKeys(s.unknown))
+	assert.Equal(t, []string{"labels.*.color", "name"}, sortedKeys(s.missing))
+}
+
+func TestWithStrictDecoding(t *testing.T) {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	mux.HandleFunc("/api/v4/projects/1/repository/tags/v1.0.0", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"name": "v1.0.0", "brand_new_field": 1}`)
+	})
+
+	var reports []*DecodingReport
+	client, err := NewClient("", WithBaseURL(server.URL), WithDecodingReports(func(r *DecodingReport) {
+		reports = append(reports, r)
+	}))
+	require.NoError(t, err)
+
+	tag, resp, err := client.Tags.GetTag(1, "v1.0.0")
+	require.NoError(t, err)
+	assert.Equal(t, "v1.0.0", tag.Name)
+	require.NotNil(t, resp.DecodingReport)
+	assert.Contains(t, resp.DecodingReport.UnknownFields, "brand_new_field")
+	assert.Len(t, reports, 1)
+
+	client, err = NewClient("", WithBaseURL(server.URL), WithStrictDecoding())
+	require.NoError(t, err)
+
+	_, _, err = client.Tags.GetTag(1, "v1.0.0")
+
+	var decodingErr *DecodingError
+	require.True(t, errors.As(err, &decodingErr))
+	assert.Contains(t, decodingErr.Report.UnknownFields, "brand_new_field")
+	assert.Contains(t, err.Error(), "GET "+server.URL+"/api/v4/projects/1/repository/tags/v1.0.0")
+}
@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"net/http"
 	"net/url"
+	"time"
 )
 
 // TagsService handles communication with the tags related methods
@@ -40,6 +41,14 @@ type Tag struct {
 	Message   string       `json:"message"`
 	Protected bool         `json:"protected"`
 	Target    string       `json:"target"`
+	CreatedAt *time.Time   `json:"created_at"`
+}
+
+// Annotated reports whether the tag is an annotated tag. The target of an
+// annotated tag is the tag object, while the target of a lightweight tag is
+// the tagged commit.
+func (t *Tag) Annotated() bool {
+	return t.Commit != nil && t.Target != "" && t.Target != t.Commit.ID
 }
 
 // ReleaseNote represents a GitLab version release.
@@ -71,7 +80,7 @@ type ListTagsOptions struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/tags.html#list-project-repository-tags
 func (s *TagsService) ListTags(pid interface{}, opt *ListTagsOptions, options ...RequestOptionFunc) ([]*Tag, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -97,7 +106,7 @@ func (s *TagsService) ListTags(pid interface{}, opt *ListTagsOptions, options ..
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/tags.html#get-a-single-repository-tag
 func (s *TagsService) GetTag(pid interface{}, tag string, options ...RequestOptionFunc) (*Tag, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -117,6 +126,76 @@ func (s *TagsService) GetTag(pid interface{}, tag string, options ...RequestOpti
 	return t, resp, nil
 }
 
+// TagExists reports whether a repository tag exists, without retrieving the
+// tag.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/tags.html#get-a-single-repository-tag
+func (s *TagsService) TagExists(pid interface{}, tag string, options ...RequestOptionFunc) (bool, *Response, error) {
+	project, err := parseProjectID(pid)
+	if err != nil {
+		return false, nil, err
+	}
+	u := fmt.Sprintf("projects/%s/repository/tags/%s", PathEscape(project), url.PathEscape(tag))
+
+	return s.client.exists(u, nil, options)
+}
+
+// TagSignature represents the signature of a signed tag.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/tags.html#get-x509-signature-of-a-tag
+type TagSignature struct {
+	SignatureType      string           `json:"signature_type"`
+	VerificationStatus string           `json:"verification_status"`
+	X509Certificate    *X509Certificate `json:"x509_certificate"`
+}
+
+// X509Certificate represents an X.509 certificate used to sign a tag.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/tags.html#get-x509-signature-of-a-tag
+type X509Certificate struct {
+	ID                   int    `json:"id"`
+	Subject              string `json:"subject"`
+	SubjectKeyIdentifier string `json:"subject_key_identifier"`
+	Email                string `json:"email"`
+	SerialNumber         string `json:"serial_number"`
+	CertificateStatus    string `json:"certificate_status"`
+	X509Issuer           *struct {
+		ID                   int    `json:"id"`
+		Subject              string `json:"subject"`
+		SubjectKeyIdentifier string `json:"subject_key_identifier"`
+		CRLURL               string `json:"crl_url"`
+	} `json:"x509_issuer"`
+}
+
+// GetTagSignature gets the signature of a signed annotated tag. It returns
+// 404 if the tag is not signed.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/tags.html#get-x509-signature-of-a-tag
+func (s *TagsService) GetTagSignature(pid interface{}, tag string, options ...RequestOptionFunc) (*TagSignature, *Response, error) {
+	project, err := parseProjectID(pid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("projects/%s/repository/tags/%s/signature", PathEscape(project), url.PathEscape(tag))
+
+	req, err := s.client.NewRequest(http.MethodGet, u, nil, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	sig := new(TagSignature)
+	resp, err := s.client.Do(req, sig)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return sig, resp, nil
+}
+
 // CreateTagOptions represents the available CreateTag() options.
 //
 // GitLab API docs:
@@ -124,10 +203,13 @@ func (s *TagsService) GetTag(pid interface{}, tag string, options ...RequestOpti
 type CreateTagOptions struct {
 	TagName *string `url:"tag_name,omitempty" json:"tag_name,omitempty"`
 	Ref     *string `url:"ref,omitempty" json:"ref,omitempty"`
+
+	// Message creates an annotated tag with the message. Without a message,
+	// a lightweight tag is created.
 	Message *string `url:"message,omitempty" json:"message,omitempty"`
 
 	// Deprecated: Use the Releases API instead. (Deprecated in GitLab 11.7)
-	ReleaseDescription *string `url:"release_description:omitempty" json:"release_description,omitempty"`
+	ReleaseDescription *string `url:"release_description,omitempty" json:"release_description,omitempty"`
 }
 
 // CreateTag creates a new tag in the repository that points to the supplied ref.
@@ -135,7 +217,7 @@ type CreateTagOptions struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/tags.html#create-a-new-tag
 func (s *TagsService) CreateTag(pid interface{}, opt *CreateTagOptions, options ...RequestOptionFunc) (*Tag, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -160,7 +242,7 @@ func (s *TagsService) CreateTag(pid interface{}, opt *CreateTagOptions, options
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/tags.html#delete-a-tag
 func (s *TagsService) DeleteTag(pid interface{}, tag string, options ...RequestOptionFunc) (*Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, err
 	}
@@ -192,7 +274,7 @@ type CreateReleaseNoteOptions struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/tags.html#create-a-new-release
 func (s *TagsService) CreateReleaseNote(pid interface{}, tag string, opt *CreateReleaseNoteOptions, options ...RequestOptionFunc) (*ReleaseNote, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -227,7 +309,7 @@ type UpdateReleaseNoteOptions struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/tags.html#update-a-release
 func (s *TagsService) UpdateReleaseNote(pid interface{}, tag string, opt *UpdateReleaseNoteOptions, options ...RequestOptionFunc) (*ReleaseNote, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
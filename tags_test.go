@@ -108,3 +108,70 @@ func TestTagsService_UpdateReleaseNote(t *testing.T) {
 		t.Errorf("Tags.UpdateRelease returned %+v, want %+v", release, want)
 	}
 }
+
+func TestTagsService_CreateAnnotatedTag(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/repository/tags", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testBody(t, r, `{"tag_name":"v1.0.0","ref":"main","message":"Release v1.0.0"}`)
+		fmt.Fprint(w, `{
+			"name": "v1.0.0",
+			"message": "Release v1.0.0",
+			"target": "ea17f9ad1b5b6ef4ab7d5bb2df0c1b8ff7217b91",
+			"commit": {"id": "2695effb5807a22ff3d138d593fd856244e155e7"},
+			"protected": true
+		}`)
+	})
+
+	tag, _, err := client.Tags.CreateTag(1, &CreateTagOptions{
+		TagName: Ptr("v1.0.0"),
+		Ref:     Ptr("main"),
+		Message: Ptr("Release v1.0.0"),
+	})
+	if err != nil {
+		t.Fatalf("Tags.CreateTag returned error: %v", err)
+	}
+	if !tag.Annotated() {
+		t.Errorf("expected tag %+v to be annotated", tag)
+	}
+	if !tag.Protected {
+		t.Errorf("expected tag %+v to be protected", tag)
+	}
+
+	lightweight := &Tag{Target: "2695effb", Commit: &Commit{ID: "2695effb"}}
+	if lightweight.Annotated() {
+		t.Errorf("expected tag %+v to be lightweight", lightweight)
+	}
+}
+
+func TestTagsService_GetTagSignature(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/repository/tags/v1.0.0/signature", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{
+			"signature_type": "X509",
+			"verification_status": "verified",
+			"x509_certificate": {
+				"id": 1,
+				"subject": "CN=gitlab@example.org,OU=Example,O=World",
+				"email": "gitlab@example.org",
+				"serial_number": "278969561018901340486471282831158785578",
+				"certificate_status": "good",
+				"x509_issuer": {"id": 1, "subject": "CN=PKI,OU=Example,O=World", "crl_url": "http://example.com/pki.crl"}
+			}
+		}`)
+	})
+
+	sig, _, err := client.Tags.GetTagSignature(1, "v1.0.0")
+	if err != nil {
+		t.Fatalf("Tags.GetTagSignature returned error: %v", err)
+	}
+	if sig.SignatureType != "X509" || sig.VerificationStatus != "verified" {
+		t.Errorf("unexpected signature %+v", sig)
+	}
+	if sig.X509Certificate == nil || sig.X509Certificate.X509Issuer == nil || sig.X509Certificate.X509Issuer.CRLURL != "http://example.com/pki.crl" {
+		t.Errorf("unexpected certificate %+v", sig.X509Certificate)
+	}
+}
@@ -55,7 +55,7 @@ type SetTimeEstimateOptions struct {
 //
 // GitLab docs: https://docs.gitlab.com/ee/workflow/time_tracking.html
 func (s *timeStatsService) setTimeEstimate(pid interface{}, entity string, issue int, opt *SetTimeEstimateOptions, options ...RequestOptionFunc) (*TimeStats, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -79,7 +79,7 @@ func (s *timeStatsService) setTimeEstimate(pid interface{}, entity string, issue
 //
 // GitLab docs: https://docs.gitlab.com/ee/workflow/time_tracking.html
 func (s *timeStatsService) resetTimeEstimate(pid interface{}, entity string, issue int, options ...RequestOptionFunc) (*TimeStats, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -111,7 +111,7 @@ type AddSpentTimeOptions struct {
 //
 // GitLab docs: https://docs.gitlab.com/ee/workflow/time_tracking.html
 func (s *timeStatsService) addSpentTime(pid interface{}, entity string, issue int, opt *AddSpentTimeOptions, options ...RequestOptionFunc) (*TimeStats, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -135,7 +135,7 @@ func (s *timeStatsService) addSpentTime(pid interface{}, entity string, issue in
 //
 // GitLab docs: https://docs.gitlab.com/ee/workflow/time_tracking.html
 func (s *timeStatsService) resetSpentTime(pid interface{}, entity string, issue int, options ...RequestOptionFunc) (*TimeStats, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -159,7 +159,7 @@ func (s *timeStatsService) resetSpentTime(pid interface{}, entity string, issue
 //
 // GitLab docs: https://docs.gitlab.com/ee/workflow/time_tracking.html
 func (s *timeStatsService) getTimeSpent(pid interface{}, entity string, issue int, options ...RequestOptionFunc) (*TimeStats, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -0,0 +1,122 @@
+package gitlab
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// TokenPrescan configures the check of the personal access token run by
+// NewClient when using WithTokenPrescan.
+type TokenPrescan struct {
+	// RequiredScopes are the scopes the token must have, like "api" or
+	// "read_repository". Scopes implied by other scopes, like "read_api" by
+	// "api", are satisfied by those scopes.
+	RequiredScopes []string
+
+	// Report, if set, is called with the details of the token, including
+	// its scopes and expiry date, once it is verified.
+	Report func(*PersonalAccessToken)
+}
+
+// impliedTokenScopes maps scopes to the scopes they include.
+var impliedTokenScopes = map[string][]string{
+	"api":              {"read_api"},
+	"write_repository": {"read_repository"},
+	"write_registry":   {"read_registry"},
+}
+
+// TokenInactiveError is returned when a personal access token is revoked or
+// has expired.
+type TokenInactiveError struct {
+	// Token is the inactive token. It is nil when GitLab rejected the token
+	// without returning it, which it does for most revoked and expired
+	// tokens.
+	Token *PersonalAccessToken
+
+	// Err is the error of the request rejected by GitLab, if any.
+	Err error
+}
+
+func (e *TokenInactiveError) Error() string {
+	switch {
+	case e.Token == nil:
+		return fmt.Sprintf("personal access token is revoked, expired or invalid: %v", e.Err)
+	case e.Token.Revoked:
+		return fmt.Sprintf("personal access token %q is revoked", e.Token.Name)
+	case e.Token.ExpiresAt != nil:
+		return fmt.Sprintf("personal access token %q expired on %s", e.Token.Name, e.Token.ExpiresAt)
+	default:
+		return fmt.Sprintf("personal access token %q is not active", e.Token.Name)
+	}
+}
+
+// Unwrap returns the error of the request rejected by GitLab, if any.
+func (e *TokenInactiveError) Unwrap() error {
+	return e.Err
+}
+
+// TokenScopeError is returned when a personal access token lacks scopes
+// which are required.
+type TokenScopeError struct {
+	Token   *PersonalAccessToken
+	Missing []string
+}
+
+func (e *TokenScopeError) Error() string {
+	return fmt.Sprintf("personal access token %q is missing required scopes: %s", e.Token.Name, strings.Join(e.Missing, ", "))
+}
+
+// PrescanToken gets the personal access token used to authenticate, and
+// verifies it is active and has the required scopes. It returns a
+// *TokenInactiveError if the token is revoked or expired, and a
+// *TokenScopeError if it lacks required scopes. The token is returned with
+// these errors, unless GitLab rejected the token altogether.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/personal_access_tokens.html#using-a-request-header
+func (s *PersonalAccessTokensService) PrescanToken(requiredScopes []string, options ...RequestOptionFunc) (*PersonalAccessToken, *Response, error) {
+	t, resp, err := s.GetSinglePersonalAccessToken(options...)
+	if errors.Is(err, ErrUnauthorized) {
+		return nil, resp, &TokenInactiveError{Err: err}
+	}
+	if err != nil {
+		return nil, resp, err
+	}
+
+	if t.Revoked || !t.Active || tokenExpired(t, time.Now()) {
+		return t, resp, &TokenInactiveError{Token: t}
+	}
+
+	granted := make(map[string]bool)
+	for _, scope := range t.Scopes {
+		granted[scope] = true
+		for _, implied := range impliedTokenScopes[scope] {
+			granted[implied] = true
+		}
+	}
+
+	var missing []string
+	for _, scope := range requiredScopes {
+		if !granted[scope] {
+			missing = append(missing, scope)
+		}
+	}
+	if len(missing) > 0 {
+		return t, resp, &TokenScopeError{Token: t, Missing: missing}
+	}
+
+	return t, resp, nil
+}
+
+// tokenExpired reports whether the token has expired at the given time. A
+// token expires at the start of its expiry date.
+func tokenExpired(t *PersonalAccessToken, now time.Time) bool {
+	if t.ExpiresAt == nil {
+		return false
+	}
+	d := time.Time(*t.ExpiresAt)
+	expiry := time.Date(d.Year(), d.Month(), d.Day(), 0, 0, 0, 0, time.UTC)
+	return !now.Before(expiry)
+}
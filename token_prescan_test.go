@@ -0,0 +1,91 @@
+package gitlab
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPersonalAccessTokensService_PrescanToken(t *testing.T) {
+	mux, client := setup(t)
+
+	token := `{"id": 42, "name": "backport-bot", "revoked": false, "active": true, "scopes": ["api", "write_repository"], "expires_at": "2999-01-01"}`
+	mux.HandleFunc("/api/v4/personal_access_tokens/self", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, token)
+	})
+
+	pat, _, err := client.PersonalAccessTokens.PrescanToken([]string{"read_api", "read_repository", "write_repository"})
+	require.NoError(t, err)
+	require.Equal(t, []string{"api", "write_repository"}, pat.Scopes)
+
+	_, _, err = client.PersonalAccessTokens.PrescanToken([]string{"api", "sudo", "admin_mode"})
+	var scopeErr *TokenScopeError
+	require.ErrorAs(t, err, &scopeErr)
+	require.Equal(t, []string{"sudo", "admin_mode"}, scopeErr.Missing)
+	require.EqualError(t, err, `personal access token "backport-bot" is missing required scopes: sudo, admin_mode`)
+
+	token = `{"id": 42, "name": "backport-bot", "revoked": true, "active": false, "scopes": ["api"]}`
+	pat, _, err = client.PersonalAccessTokens.PrescanToken(nil)
+	var inactiveErr *TokenInactiveError
+	require.ErrorAs(t, err, &inactiveErr)
+	require.Equal(t, 42, pat.ID)
+	require.EqualError(t, err, `personal access token "backport-bot" is revoked`)
+}
+
+func TestPersonalAccessTokensService_PrescanTokenUnauthorized(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/personal_access_tokens/self", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+		fmt.Fprint(w, `{"message": "401 Unauthorized"}`)
+	})
+
+	pat, _, err := client.PersonalAccessTokens.PrescanToken(nil)
+	require.Nil(t, pat)
+	var inactiveErr *TokenInactiveError
+	require.ErrorAs(t, err, &inactiveErr)
+	require.Nil(t, inactiveErr.Token)
+	require.ErrorIs(t, err, ErrUnauthorized)
+}
+
+func TestTokenExpired(t *testing.T) {
+	expiresAt := ISOTime(time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC))
+	pat := &PersonalAccessToken{ExpiresAt: &expiresAt}
+
+	require.False(t, tokenExpired(pat, time.Date(2024, time.February, 29, 23, 59, 0, 0, time.UTC)))
+	require.True(t, tokenExpired(pat, time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC)))
+	require.False(t, tokenExpired(&PersonalAccessToken{}, time.Now()))
+}
+
+func TestNewClientWithTokenPrescan(t *testing.T) {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	mux.HandleFunc("/api/v4/personal_access_tokens/self", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		require.Equal(t, "secret", r.Header.Get("PRIVATE-TOKEN"))
+		fmt.Fprint(w, `{"id": 42, "name": "ci", "active": true, "scopes": ["read_api"], "expires_at": "2999-01-01"}`)
+	})
+
+	var reported *PersonalAccessToken
+	client, err := NewClient("secret", WithBaseURL(server.URL), WithTokenPrescan(TokenPrescan{
+		RequiredScopes: []string{"read_api"},
+		Report:         func(pat *PersonalAccessToken) { reported = pat },
+	}))
+	require.NoError(t, err)
+	require.NotNil(t, client)
+	require.Equal(t, "ci", reported.Name)
+
+	_, err = NewClient("secret", WithBaseURL(server.URL), WithTokenPrescan(TokenPrescan{
+		RequiredScopes: []string{"api"},
+	}))
+	var scopeErr *TokenScopeError
+	require.ErrorAs(t, err, &scopeErr)
+	require.Equal(t, []string{"api"}, scopeErr.Missing)
+}
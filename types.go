@@ -317,6 +317,55 @@ func DeploymentStatus(v DeploymentStatusValue) *DeploymentStatusValue {
 	return Ptr(v)
 }
 
+// DetailedMergeStatusValue represents the detailed merge status of a merge
+// request, which describes whether it can be merged, or why it cannot.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/merge_requests.html#merge-status
+type DetailedMergeStatusValue string
+
+// These constants represent all valid detailed merge statuses.
+const (
+	DetailedMergeStatusApprovalsSyncing         DetailedMergeStatusValue = "approvals_syncing"
+	DetailedMergeStatusBlockedStatus            DetailedMergeStatusValue = "blocked_status"
+	DetailedMergeStatusBrokenStatus             DetailedMergeStatusValue = "broken_status"
+	DetailedMergeStatusChecking                 DetailedMergeStatusValue = "checking"
+	DetailedMergeStatusCIMustPass               DetailedMergeStatusValue = "ci_must_pass"
+	DetailedMergeStatusCIStillRunning           DetailedMergeStatusValue = "ci_still_running"
+	DetailedMergeStatusCommitsStatus            DetailedMergeStatusValue = "commits_status"
+	DetailedMergeStatusConflict                 DetailedMergeStatusValue = "conflict"
+	DetailedMergeStatusDiscussionsNotResolved   DetailedMergeStatusValue = "discussions_not_resolved"
+	DetailedMergeStatusDraftStatus              DetailedMergeStatusValue = "draft_status"
+	DetailedMergeStatusExternalStatusChecks     DetailedMergeStatusValue = "external_status_checks"
+	DetailedMergeStatusJiraAssociationMissing   DetailedMergeStatusValue = "jira_association_missing"
+	DetailedMergeStatusLockedLFSFiles           DetailedMergeStatusValue = "locked_lfs_files"
+	DetailedMergeStatusLockedPaths              DetailedMergeStatusValue = "locked_paths"
+	DetailedMergeStatusMergeable                DetailedMergeStatusValue = "mergeable"
+	DetailedMergeStatusMergeRequestBlocked      DetailedMergeStatusValue = "merge_request_blocked"
+	DetailedMergeStatusMergeTime                DetailedMergeStatusValue = "merge_time"
+	DetailedMergeStatusNeedRebase               DetailedMergeStatusValue = "need_rebase"
+	DetailedMergeStatusNotApproved              DetailedMergeStatusValue = "not_approved"
+	DetailedMergeStatusNotOpen                  DetailedMergeStatusValue = "not_open"
+	DetailedMergeStatusPoliciesDenied           DetailedMergeStatusValue = "policies_denied"
+	DetailedMergeStatusPreparing                DetailedMergeStatusValue = "preparing"
+	DetailedMergeStatusRequestedChanges         DetailedMergeStatusValue = "requested_changes"
+	DetailedMergeStatusSecurityPolicyViolations DetailedMergeStatusValue = "security_policy_violations"
+	DetailedMergeStatusStatusChecksMustPass     DetailedMergeStatusValue = "status_checks_must_pass"
+	DetailedMergeStatusUnchecked                DetailedMergeStatusValue = "unchecked"
+)
+
+// Final reports whether the status is the result of the mergeability
+// checks. Merge requests with other statuses are still being checked or
+// prepared, and should be requested again later.
+func (s DetailedMergeStatusValue) Final() bool {
+	switch s {
+	case DetailedMergeStatusApprovalsSyncing, DetailedMergeStatusChecking,
+		DetailedMergeStatusPreparing, DetailedMergeStatusUnchecked:
+		return false
+	}
+	return true
+}
+
 // DORAMetricType represents all valid DORA metrics types.
 //
 // GitLab API docs: https://docs.gitlab.com/ee/api/dora/metrics.html
@@ -440,6 +489,33 @@ func GenericPackageStatus(v GenericPackageStatusValue) *GenericPackageStatusValu
 	return Ptr(v)
 }
 
+// GroupID represents a group identifier, which is either the numeric ID or
+// the full path of a group. It can be used for all gid parameters; using it
+// as a project identifier returns an error.
+type GroupID struct {
+	id string
+}
+
+// NewGroupID is a helper routine that creates a new GroupID from a numeric ID
+// or a full path. Passing any other type is a compile time error.
+func NewGroupID[T int | string](id T) GroupID {
+	return GroupID{id: formatID(id)}
+}
+
+// ParseGroupID converts a group identifier of an unknown type into a GroupID.
+func ParseGroupID(gid interface{}) (GroupID, error) {
+	id, err := parseGroupID(gid)
+	if err != nil {
+		return GroupID{}, err
+	}
+	return GroupID{id: id}, nil
+}
+
+// String returns the unescaped group identifier.
+func (g GroupID) String() string {
+	return g.id
+}
+
 // GroupHookTrigger represents the type of event to trigger for a group
 // hook test.
 type GroupHookTrigger string
@@ -722,6 +798,45 @@ func ProjectCreationLevel(v ProjectCreationLevelValue) *ProjectCreationLevelValu
 	return Ptr(v)
 }
 
+// ProjectID represents a project identifier, which is either the numeric ID
+// or the full path of a project. It can be used for all pid parameters; using
+// it as a group identifier returns an error.
+type ProjectID struct {
+	id string
+}
+
+// NewProjectID is a helper routine that creates a new ProjectID from a numeric
+// ID or a full path. Passing any other type is a compile time error.
+func NewProjectID[T int | string](id T) ProjectID {
+	return ProjectID{id: formatID(id)}
+}
+
+// ParseProjectID converts a project identifier of an unknown type into a
+// ProjectID.
+func ParseProjectID(pid interface{}) (ProjectID, error) {
+	id, err := parseProjectID(pid)
+	if err != nil {
+		return ProjectID{}, err
+	}
+	return ProjectID{id: id}, nil
+}
+
+// String returns the unescaped project identifier.
+func (p ProjectID) String() string {
+	return p.id
+}
+
+// formatID formats a numeric ID or a full path as an identifier.
+func formatID[T int | string](id T) string {
+	switch v := any(id).(type) {
+	case int:
+		return strconv.Itoa(v)
+	case string:
+		return v
+	}
+	return ""
+}
+
 // ProjectHookEvent represents a project hook event.
 //
 // GitLab API docs: https://docs.gitlab.com/ee/api/projects.html#hook-events
@@ -72,3 +72,64 @@ func TestBoolValue(t *testing.T) {
 		})
 	}
 }
+
+func TestProjectID(t *testing.T) {
+	testCases := []struct {
+		name     string
+		pid      ProjectID
+		expected string
+	}{
+		{
+			name:     "numeric ID",
+			pid:      NewProjectID(42),
+			expected: "42",
+		},
+		{
+			name:     "full path",
+			pid:      NewProjectID("diaspora/diaspora"),
+			expected: "diaspora/diaspora",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			id, err := parseProjectID(testCase.pid)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if id != testCase.expected {
+				t.Fatalf("Expected %s but got %s", testCase.expected, id)
+			}
+		})
+	}
+
+	if _, err := parseProjectID(ProjectID{}); err == nil {
+		t.Fatal("Expected an error for an empty ProjectID")
+	}
+	if _, err := parseGroupID(NewProjectID(42)); err == nil {
+		t.Fatal("Expected an error for a ProjectID used as a group ID")
+	}
+	if _, err := parseID(NewProjectID(42)); err == nil {
+		t.Fatal("Expected an error for a ProjectID used as a plain ID")
+	}
+}
+
+func TestParseGroupID(t *testing.T) {
+	gid, err := ParseGroupID("group/subgroup")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if gid != NewGroupID("group/subgroup") {
+		t.Fatalf("Expected %v but got %v", NewGroupID("group/subgroup"), gid)
+	}
+
+	if _, err := ParseGroupID(3.14); err == nil {
+		t.Fatal("Expected an error for an invalid ID type")
+	}
+	if _, err := ParseGroupID(NewProjectID(42)); err == nil {
+		t.Fatal("Expected an error for a ProjectID")
+	}
+	if _, err := ParseProjectID(NewGroupID(42)); err == nil {
+		t.Fatal("Expected an error for a GroupID")
+	}
+}
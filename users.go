@@ -541,7 +541,7 @@ func (s *UsersService) ListSSHKeysForUser(uid interface{}, opt *ListSSHKeysForUs
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("users/%s/keys", user)
+	u := fmt.Sprintf("users/%s/keys", PathEscape(user))
 
 	req, err := s.client.NewRequest(http.MethodGet, u, opt, options)
 	if err != nil {
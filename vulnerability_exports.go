@@ -0,0 +1,157 @@
+package gitlab
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+// VulnerabilityExportsService handles communication with the vulnerability
+// export related methods of the GitLab API.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/vulnerability_exports.html
+type VulnerabilityExportsService struct {
+	client *Client
+}
+
+// VulnerabilityExportStatusValue represents the status of a vulnerability
+// export.
+type VulnerabilityExportStatusValue string
+
+// List of available vulnerability export status values.
+const (
+	VulnerabilityExportCreated  VulnerabilityExportStatusValue = "created"
+	VulnerabilityExportRunning  VulnerabilityExportStatusValue = "running"
+	VulnerabilityExportFinished VulnerabilityExportStatusValue = "finished"
+	VulnerabilityExportFailed   VulnerabilityExportStatusValue = "failed"
+)
+
+// Done reports whether the status is final.
+func (v VulnerabilityExportStatusValue) Done() bool {
+	return v == VulnerabilityExportFinished || v == VulnerabilityExportFailed
+}
+
+// VulnerabilityExport represents a GitLab vulnerability export.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/vulnerability_exports.html
+type VulnerabilityExport struct {
+	ID         int                            `json:"id"`
+	ProjectID  int                            `json:"project_id"`
+	GroupID    int                            `json:"group_id"`
+	Format     string                         `json:"format"`
+	Status     VulnerabilityExportStatusValue `json:"status"`
+	CreatedAt  *time.Time                     `json:"created_at"`
+	StartedAt  *time.Time                     `json:"started_at"`
+	FinishedAt *time.Time                     `json:"finished_at"`
+	Links      VulnerabilityExportLinks       `json:"_links"`
+}
+
+// VulnerabilityExportLinks represents the links of a vulnerability export.
+type VulnerabilityExportLinks struct {
+	Self     string `json:"self"`
+	Download string `json:"download"`
+}
+
+// CreateGroupVulnerabilityExport creates a new CSV export of the
+// vulnerabilities of all projects in a group and its subgroups.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/vulnerability_exports.html#create-a-group-level-vulnerability-export
+func (s *VulnerabilityExportsService) CreateGroupVulnerabilityExport(gid interface{}, options ...RequestOptionFunc) (*VulnerabilityExport, *Response, error) {
+	group, err := parseGroupID(gid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("security/groups/%s/vulnerability_exports", PathEscape(group))
+
+	return s.create(u, options)
+}
+
+// CreateProjectVulnerabilityExport creates a new CSV export of the
+// vulnerabilities of a project.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/vulnerability_exports.html#create-a-project-level-vulnerability-export
+func (s *VulnerabilityExportsService) CreateProjectVulnerabilityExport(pid interface{}, options ...RequestOptionFunc) (*VulnerabilityExport, *Response, error) {
+	project, err := parseProjectID(pid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("security/projects/%s/vulnerability_exports", PathEscape(project))
+
+	return s.create(u, options)
+}
+
+func (s *VulnerabilityExportsService) create(u string, options []RequestOptionFunc) (*VulnerabilityExport, *Response, error) {
+	req, err := s.client.NewRequest(http.MethodPost, u, nil, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	e := new(VulnerabilityExport)
+	resp, err := s.client.Do(req, e)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return e, resp, nil
+}
+
+// GetVulnerabilityExport gets a single vulnerability export.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/vulnerability_exports.html#get-single-vulnerability-export
+func (s *VulnerabilityExportsService) GetVulnerabilityExport(id int, options ...RequestOptionFunc) (*VulnerabilityExport, *Response, error) {
+	u := fmt.Sprintf("security/vulnerability_exports/%d", id)
+
+	req, err := s.client.NewRequest(http.MethodGet, u, nil, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	e := new(VulnerabilityExport)
+	resp, err := s.client.Do(req, e)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return e, resp, nil
+}
+
+// WaitForVulnerabilityExport polls a vulnerability export using the given
+// interval until it has finished or failed, or the context is done, and
+// returns the final state of the export. A non-positive interval defaults to
+// five seconds.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/vulnerability_exports.html#get-single-vulnerability-export
+func (s *VulnerabilityExportsService) WaitForVulnerabilityExport(ctx context.Context, id int, interval time.Duration, options ...RequestOptionFunc) (*VulnerabilityExport, *Response, error) {
+	get := func(options ...RequestOptionFunc) (*VulnerabilityExport, *Response, error) {
+		return s.GetVulnerabilityExport(id, options...)
+	}
+	done := func(e *VulnerabilityExport) bool {
+		return e.Status.Done()
+	}
+	return pollUntil(ctx, interval, get, done, options)
+}
+
+// DownloadVulnerabilityExport streams the CSV of a finished vulnerability
+// export into the given writer, which avoids keeping large exports in
+// memory.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/vulnerability_exports.html#download-vulnerability-export
+func (s *VulnerabilityExportsService) DownloadVulnerabilityExport(id int, w io.Writer, options ...RequestOptionFunc) (*Response, error) {
+	u := fmt.Sprintf("security/vulnerability_exports/%d/download", id)
+
+	req, err := s.client.NewRequest(http.MethodGet, u, nil, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, w)
+}
@@ -0,0 +1,96 @@
+package gitlab
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestVulnerabilityExportsService_CreateGroupVulnerabilityExport(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/security/groups/7/vulnerability_exports", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		fmt.Fprint(w, `{
+			"id": 2,
+			"group_id": 7,
+			"format": "csv",
+			"status": "created",
+			"_links": {
+				"self": "https://gitlab.example.com/api/v4/security/vulnerability_exports/2",
+				"download": "https://gitlab.example.com/api/v4/security/vulnerability_exports/2/download"
+			}
+		}`)
+	})
+
+	e, _, err := client.VulnerabilityExports.CreateGroupVulnerabilityExport(7)
+	require.NoError(t, err)
+	require.Equal(t, &VulnerabilityExport{
+		ID:      2,
+		GroupID: 7,
+		Format:  "csv",
+		Status:  VulnerabilityExportCreated,
+		Links: VulnerabilityExportLinks{
+			Self:     "https://gitlab.example.com/api/v4/security/vulnerability_exports/2",
+			Download: "https://gitlab.example.com/api/v4/security/vulnerability_exports/2/download",
+		},
+	}, e)
+}
+
+func TestVulnerabilityExportsService_CreateProjectVulnerabilityExport(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/security/projects/1/vulnerability_exports", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		fmt.Fprint(w, `{"id": 3, "project_id": 1, "format": "csv", "status": "created"}`)
+	})
+
+	e, _, err := client.VulnerabilityExports.CreateProjectVulnerabilityExport(1)
+	require.NoError(t, err)
+	require.Equal(t, 3, e.ID)
+	require.Equal(t, 1, e.ProjectID)
+}
+
+func TestVulnerabilityExportsService_WaitForVulnerabilityExport(t *testing.T) {
+	mux, client := setup(t)
+
+	calls := 0
+	mux.HandleFunc("/api/v4/security/vulnerability_exports/2", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		calls++
+		if calls < 3 {
+			w.WriteHeader(http.StatusAccepted)
+			fmt.Fprint(w, `{"id": 2, "status": "running"}`)
+			return
+		}
+		fmt.Fprint(w, `{"id": 2, "status": "finished"}`)
+	})
+
+	e, _, err := client.VulnerabilityExports.WaitForVulnerabilityExport(context.Background(), 2, time.Millisecond)
+	require.NoError(t, err)
+	require.Equal(t, VulnerabilityExportFinished, e.Status)
+	require.Equal(t, 3, calls)
+
+	_, resp, err := client.VulnerabilityExports.WaitForVulnerabilityExport(context.Background(), 4, time.Millisecond)
+	require.ErrorIs(t, err, ErrNotFound)
+	require.Equal(t, http.StatusNotFound, resp.StatusCode)
+}
+
+func TestVulnerabilityExportsService_DownloadVulnerabilityExport(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/security/vulnerability_exports/2/download", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, "Group Name,Project Name,Tool,Scanner Name,Status\n")
+	})
+
+	var b bytes.Buffer
+	_, err := client.VulnerabilityExports.DownloadVulnerabilityExport(2, &b)
+	require.NoError(t, err)
+	require.Equal(t, "Group Name,Project Name,Tool,Scanner Name,Status\n", b.String())
+}
@@ -0,0 +1,221 @@
+package gitlab
+
+import (
+	"context"
+	"net/http"
+	"time"
+)
+
+const (
+	defaultWatchInterval = time.Minute
+	defaultPollInterval  = 5 * time.Second
+)
+
+// WatchOptions represents the available options of the Watch helpers.
+type WatchOptions struct {
+	// Interval is the time to wait between polls. Defaults to one minute.
+	Interval time.Duration
+
+	// Since only reports items updated after the given time. Defaults to the
+	// time the watch is started.
+	Since time.Time
+}
+
+// WatchEvent is emitted by the Watch helpers for every created or updated
+// item, or when polling failed. Polling continues after an error.
+type WatchEvent[T any] struct {
+	Item T
+	Err  error
+}
+
+// watchFetchFunc retrieves a single page of items updated after the given
+// time, ordered by the time they were last updated (oldest first).
+type watchFetchFunc[T any] func(updatedAfter time.Time, page int, options ...RequestOptionFunc) ([]T, *Response, error)
+
+// watchKeyFunc returns the ID and the last update time of an item.
+type watchKeyFunc[T any] func(T) (int, *time.Time)
+
+// watch polls a list endpoint using an updated_after cursor until the context
+// is done. To save bandwidth, the ETag of the first page is used to make
+// conditional requests as long as the cursor did not change.
+func watch[T any](ctx context.Context, opt *WatchOptions, fetch watchFetchFunc[T], key watchKeyFunc[T], options []RequestOptionFunc) <-chan WatchEvent[T] {
+	interval := defaultWatchInterval
+	cursor := time.Now()
+	if opt != nil {
+		if opt.Interval > 0 {
+			interval = opt.Interval
+		}
+		if !opt.Since.IsZero() {
+			cursor = opt.Since
+		}
+	}
+
+	events := make(chan WatchEvent[T])
+
+	go func() {
+		defer close(events)
+
+		// GitLab includes items updated exactly at the cursor, so remember
+		// which items were already reported for the current cursor.
+		seen := make(map[int]bool)
+		var etag string
+
+		emit := func(e WatchEvent[T]) bool {
+			select {
+			case events <- e:
+				return true
+			case <-ctx.Done():
+				return false
+			}
+		}
+
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		baseOptions := append([]RequestOptionFunc{WithContext(ctx)}, options...)
+
+		for {
+			next, nextSeen := cursor, seen
+
+			for page := 1; page != 0; {
+				reqOptions := baseOptions
+				if page == 1 && etag != "" {
+					// Only the first page is requested conditionally.
+					reqOptions = append(reqOptions[:len(reqOptions):len(reqOptions)], WithHeader("If-None-Match", etag))
+				}
+
+				items, resp, err := fetch(cursor, page, reqOptions...)
+				if resp != nil && resp.StatusCode == http.StatusNotModified {
+					break
+				}
+				if err != nil {
+					if ctx.Err() != nil {
+						return
+					}
+					if !emit(WatchEvent[T]{Err: err}) {
+						return
+					}
+					break
+				}
+				if page == 1 {
+					etag = resp.Header.Get("ETag")
+				}
+
+				for _, item := range items {
+					id, updatedAt := key(item)
+					if updatedAt == nil || seen[id] && !updatedAt.After(cursor) {
+						continue
+					}
+					if updatedAt.After(next) {
+						next, nextSeen = *updatedAt, make(map[int]bool)
+					}
+					if updatedAt.Equal(next) {
+						nextSeen[id] = true
+					}
+					if !emit(WatchEvent[T]{Item: item}) {
+						return
+					}
+				}
+
+				page = resp.NextPage
+			}
+
+			if next.After(cursor) {
+				etag = ""
+			}
+			cursor, seen = next, nextSeen
+
+			select {
+			case <-ticker.C:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return events
+}
+
+// WatchProjectIssues polls the issues of a project and emits an event for
+// every issue that is created or updated, until the context is done. The
+// given list options can be used to filter the issues, but their pagination,
+// ordering and updated_after options are overwritten.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/issues.html#list-project-issues
+func (s *IssuesService) WatchProjectIssues(ctx context.Context, pid interface{}, opt *ListProjectIssuesOptions, wopt *WatchOptions, options ...RequestOptionFunc) <-chan WatchEvent[*Issue] {
+	var base ListProjectIssuesOptions
+	if opt != nil {
+		base = *opt
+	}
+
+	fetch := func(updatedAfter time.Time, page int, options ...RequestOptionFunc) ([]*Issue, *Response, error) {
+		o := base
+		o.Page, o.PerPage = page, 100
+		o.OrderBy, o.Sort = Ptr("updated_at"), Ptr("asc")
+		o.UpdatedAfter = &updatedAfter
+		return s.ListProjectIssues(pid, &o, options...)
+	}
+	key := func(i *Issue) (int, *time.Time) {
+		return i.ID, i.UpdatedAt
+	}
+
+	return watch(ctx, wopt, fetch, key, options)
+}
+
+// WatchProjectMergeRequests polls the merge requests of a project and emits
+// an event for every merge request that is created or updated, until the
+// context is done. The given list options can be used to filter the merge
+// requests, but their pagination, ordering and updated_after options are
+// overwritten.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/merge_requests.html#list-project-merge-requests
+func (s *MergeRequestsService) WatchProjectMergeRequests(ctx context.Context, pid interface{}, opt *ListProjectMergeRequestsOptions, wopt *WatchOptions, options ...RequestOptionFunc) <-chan WatchEvent[*MergeRequest] {
+	var base ListProjectMergeRequestsOptions
+	if opt != nil {
+		base = *opt
+	}
+
+	fetch := func(updatedAfter time.Time, page int, options ...RequestOptionFunc) ([]*MergeRequest, *Response, error) {
+		o := base
+		o.Page, o.PerPage = page, 100
+		o.OrderBy, o.Sort = Ptr("updated_at"), Ptr("asc")
+		o.UpdatedAfter = &updatedAfter
+		return s.ListProjectMergeRequests(pid, &o, options...)
+	}
+	key := func(mr *MergeRequest) (int, *time.Time) {
+		return mr.ID, mr.UpdatedAt
+	}
+
+	return watch(ctx, wopt, fetch, key, options)
+}
+
+// pollUntil calls get, waiting the given interval between calls, until done
+// reports true for the result or the context is done. A non-positive
+// interval defaults to five seconds.
+func pollUntil[T any](ctx context.Context, interval time.Duration, get func(options ...RequestOptionFunc) (T, *Response, error), done func(T) bool, options []RequestOptionFunc) (T, *Response, error) {
+	if interval <= 0 {
+		interval = defaultPollInterval
+	}
+	options = append([]RequestOptionFunc{WithContext(ctx)}, options...)
+
+	for {
+		v, resp, err := get(options...)
+		if err != nil {
+			var zero T
+			return zero, resp, err
+		}
+		if done(v) {
+			return v, resp, nil
+		}
+
+		timer := time.NewTimer(interval)
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			var zero T
+			return zero, resp, ctx.Err()
+		}
+	}
+}
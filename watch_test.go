@@ -0,0 +1,91 @@
+package gitlab
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWatchProjectIssues(t *testing.T) {
+	mux, client := setup(t)
+
+	since := time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+	calls := 0
+	mux.HandleFunc("/api/v4/projects/1/issues", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		assert.Equal(t, "updated_at", r.URL.Query().Get("order_by"))
+		assert.Equal(t, "asc", r.URL.Query().Get("sort"))
+		assert.Equal(t, "opened", r.URL.Query().Get("state"))
+
+		calls++
+		switch calls {
+		case 1:
+			assert.Equal(t, "2024-01-01T00:00:00Z", r.URL.Query().Get("updated_after"))
+			w.Header().Set("ETag", `W/"a"`)
+			fmt.Fprint(w, `[
+				{"id": 1, "updated_at": "2024-01-02T00:00:00Z"},
+				{"id": 2, "updated_at": "2024-01-03T00:00:00Z"}
+			]`)
+		case 2:
+			// The cursor moved, so the request is not conditional.
+			assert.Equal(t, "2024-01-03T00:00:00Z", r.URL.Query().Get("updated_after"))
+			assert.Empty(t, r.Header.Get("If-None-Match"))
+			w.Header().Set("ETag", `W/"b"`)
+			fmt.Fprint(w, `[{"id": 2, "updated_at": "2024-01-03T00:00:00Z"}]`)
+		case 3:
+			assert.Equal(t, `W/"b"`, r.Header.Get("If-None-Match"))
+			w.WriteHeader(http.StatusNotModified)
+		default:
+			fmt.Fprint(w, `[
+				{"id": 2, "updated_at": "2024-01-03T00:00:00Z"},
+				{"id": 3, "updated_at": "2024-01-04T00:00:00Z"}
+			]`)
+		}
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	events := client.Issues.WatchProjectIssues(ctx, 1,
+		&ListProjectIssuesOptions{State: Ptr("opened")},
+		&WatchOptions{Interval: time.Millisecond, Since: since},
+	)
+
+	var ids []int
+	for e := range events {
+		require.NoError(t, e.Err)
+		ids = append(ids, e.Item.ID)
+		if len(ids) == 3 {
+			cancel()
+		}
+	}
+
+	assert.Equal(t, []int{1, 2, 3}, ids)
+	assert.GreaterOrEqual(t, calls, 4)
+}
+
+func TestWatchProjectMergeRequestsError(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/merge_requests", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		w.WriteHeader(http.StatusForbidden)
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	events := client.MergeRequests.WatchProjectMergeRequests(ctx, 1, nil, &WatchOptions{Interval: time.Millisecond})
+
+	e := <-events
+	assert.ErrorIs(t, e.Err, ErrForbidden)
+
+	cancel()
+	for range events {
+	}
+}
@@ -58,7 +58,7 @@ type ListWikisOptions struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/wikis.html#list-wiki-pages
 func (s *WikisService) ListWikis(pid interface{}, opt *ListWikisOptions, options ...RequestOptionFunc) ([]*Wiki, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -92,7 +92,7 @@ type GetWikiPageOptions struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/wikis.html#get-a-wiki-page
 func (s *WikisService) GetWikiPage(pid interface{}, slug string, opt *GetWikiPageOptions, options ...RequestOptionFunc) (*Wiki, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -128,7 +128,7 @@ type CreateWikiPageOptions struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/wikis.html#create-a-new-wiki-page
 func (s *WikisService) CreateWikiPage(pid interface{}, opt *CreateWikiPageOptions, options ...RequestOptionFunc) (*Wiki, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -164,7 +164,7 @@ type EditWikiPageOptions struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/wikis.html#edit-an-existing-wiki-page
 func (s *WikisService) EditWikiPage(pid interface{}, slug string, opt *EditWikiPageOptions, options ...RequestOptionFunc) (*Wiki, *Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -189,7 +189,7 @@ func (s *WikisService) EditWikiPage(pid interface{}, slug string, opt *EditWikiP
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/wikis.html#delete-a-wiki-page
 func (s *WikisService) DeleteWikiPage(pid interface{}, slug string, options ...RequestOptionFunc) (*Response, error) {
-	project, err := parseID(pid)
+	project, err := parseProjectID(pid)
 	if err != nil {
 		return nil, err
 	}